@@ -0,0 +1,155 @@
+package bdb
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CheckConstraint is a CHECK constraint as read from the database. Column is
+// the column the constraint was declared on, drivers that don't know it leave
+// it empty.
+type CheckConstraint struct {
+	Name   string
+	Column string
+	Clause string
+}
+
+// Check holds the limits put on the values of a column by its simple CHECK
+// constraints: ranges of numbers and lists of allowed values. Constraints
+// that are anything else are ignored.
+type Check struct {
+	Min          float64
+	Max          float64
+	HasMin       bool
+	HasMax       bool
+	ExclusiveMin bool
+	ExclusiveMax bool
+	In           []string
+}
+
+// IsZero returns true if the check puts no limit on the column
+func (c Check) IsZero() bool {
+	return !c.HasMin && !c.HasMax && len(c.In) == 0
+}
+
+var (
+	rgxCheckKeyword = regexp.MustCompile(`(?i)^\s*check\s*`)
+	rgxCheckCast    = regexp.MustCompile(`::(?:character varying|double precision|timestamp with(?:out)? time zone|\w+)(?:\[\])?`)
+	rgxCheckCharset = regexp.MustCompile(`(^|[\s(,=])_\w+'`)
+	rgxCheckAnyList = regexp.MustCompile(`(?i)\s*=\s*any\s*\(+\s*array\s*\[([^\]]*)\]\s*\)+`)
+	rgxCheckInList  = regexp.MustCompile(`(?i)\s+in\s*\(([^)]*)\)`)
+	rgxCheckBetween = regexp.MustCompile(`(?i)(\S+) between (\S+) and (\S+)`)
+	rgxCheckAnd     = regexp.MustCompile(`(?i) and `)
+	rgxCheckOr      = regexp.MustCompile(`(?i) or `)
+	rgxCheckCompare = regexp.MustCompile(`^(.+?)\s*(>=|<=|<>|!=|>|<|=)\s*(.+)$`)
+	rgxCheckListRef = regexp.MustCompile(`^(\S+) in @(\d+)$`)
+)
+
+// ParseCheck reads the limits a CHECK constraint clause puts on a column
+// into check. The clause must be a conjunction of comparisons of the column
+// with numbers, BETWEEN or IN lists, or a disjunction of equalities, in any
+// of the forms postgres, mysql and mssql print them in. It returns false
+// and leaves check alone if the clause is anything else.
+func ParseCheck(column, clause string, check *Check) bool {
+	s := rgxCheckKeyword.ReplaceAllString(clause, "")
+	s = rgxCheckCast.ReplaceAllString(s, "")
+	s = rgxCheckCharset.ReplaceAllString(s, "${1}'")
+	s = strings.NewReplacer("`", "", `"`, "", "["+column+"]", column).Replace(s)
+
+	// Lists are taken out before the parentheses are dropped
+	var lists []string
+	takeList := func(rgx *regexp.Regexp) func(string) string {
+		return func(match string) string {
+			lists = append(lists, rgx.FindStringSubmatch(match)[1])
+			return fmt.Sprintf(" in @%d", len(lists)-1)
+		}
+	}
+	s = rgxCheckAnyList.ReplaceAllStringFunc(s, takeList(rgxCheckAnyList))
+	s = rgxCheckInList.ReplaceAllStringFunc(s, takeList(rgxCheckInList))
+
+	s = strings.NewReplacer("(", " ", ")", " ").Replace(s)
+	s = strings.Join(strings.Fields(s), " ")
+	s = rgxCheckBetween.ReplaceAllString(s, "$1 >= $2 and $1 <= $3")
+
+	var c Check
+	if rgxCheckOr.MatchString(s) {
+		for _, term := range rgxCheckOr.Split(s, -1) {
+			m := rgxCheckCompare.FindStringSubmatch(term)
+			if m == nil || m[2] != "=" || !strings.EqualFold(m[1], column) {
+				return false
+			}
+			c.In = append(c.In, checkLiteral(m[3]))
+		}
+		mergeCheck(check, c)
+		return true
+	}
+
+	for _, term := range rgxCheckAnd.Split(s, -1) {
+		if m := rgxCheckListRef.FindStringSubmatch(term); m != nil {
+			if !strings.EqualFold(m[1], column) {
+				return false
+			}
+			i, _ := strconv.Atoi(m[2])
+			for _, v := range strings.Split(lists[i], ",") {
+				c.In = append(c.In, checkLiteral(v))
+			}
+			continue
+		}
+
+		m := rgxCheckCompare.FindStringSubmatch(term)
+		if m == nil || !strings.EqualFold(m[1], column) {
+			return false
+		}
+
+		op, literal := m[2], checkLiteral(m[3])
+		if op == "=" {
+			c.In = append(c.In, literal)
+			continue
+		}
+
+		n, err := strconv.ParseFloat(literal, 64)
+		if err != nil {
+			return false
+		}
+
+		switch op {
+		case ">", ">=":
+			c.HasMin, c.Min, c.ExclusiveMin = true, n, op == ">"
+		case "<", "<=":
+			c.HasMax, c.Max, c.ExclusiveMax = true, n, op == "<"
+		default:
+			return false
+		}
+	}
+
+	mergeCheck(check, c)
+	return true
+}
+
+// mergeCheck adds the limits of c to check, the narrower limit wins
+func mergeCheck(check *Check, c Check) {
+	if c.HasMin && (!check.HasMin || c.Min > check.Min || (c.Min == check.Min && c.ExclusiveMin)) {
+		check.HasMin, check.Min, check.ExclusiveMin = true, c.Min, c.ExclusiveMin
+	}
+	if c.HasMax && (!check.HasMax || c.Max < check.Max || (c.Max == check.Max && c.ExclusiveMax)) {
+		check.HasMax, check.Max, check.ExclusiveMax = true, c.Max, c.ExclusiveMax
+	}
+	if len(c.In) != 0 {
+		check.In = c.In
+	}
+}
+
+// checkLiteral unquotes a string literal, other literals are returned as is
+func checkLiteral(s string) string {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "N'") {
+		s = s[1:]
+	}
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.Replace(s[1:len(s)-1], "''", "'", -1)
+	}
+
+	return s
+}
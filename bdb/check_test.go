@@ -0,0 +1,64 @@
+package bdb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Column string
+		Clause string
+		OK     bool
+		Check  Check
+	}{
+		// postgres
+		{"price", "CHECK ((price > (0)::numeric))", true, Check{HasMin: true, ExclusiveMin: true}},
+		{"size", "CHECK (((size >= 1) AND (size <= 10)))", true, Check{HasMin: true, Min: 1, HasMax: true, Max: 10}},
+		{"size", "CHECK ((size > '-5'::integer))", true, Check{HasMin: true, Min: -5, ExclusiveMin: true}},
+		{"status", "CHECK ((status = ANY (ARRAY['new'::text, 'done'::text])))", true, Check{In: []string{"new", "done"}}},
+		{"status", "CHECK ((status = ANY (ARRAY['new'::text, 'in_progress'::text])))", true, Check{In: []string{"new", "in_progress"}}},
+		{"color", "CHECK (((color)::text = ANY ((ARRAY['red'::character varying, 'blue'::character varying])::text[])))", true, Check{In: []string{"red", "blue"}}},
+		{"size", "CHECK (((size > 0) OR (other > 0)))", false, Check{}},
+		{"size", "CHECK ((size <> 3))", false, Check{}},
+		{"size", "CHECK ((length(name) > 3))", false, Check{}},
+		// mysql
+		{"size", "(`size` between 1 and 10)", true, Check{HasMin: true, Min: 1, HasMax: true, Max: 10}},
+		{"status", "(`status` in (_utf8mb4'new',_utf8mb4'it''s'))", true, Check{In: []string{"new", "it's"}}},
+		{"status", "(`status` in (_utf8mb4'new', _utf8mb4'in_progress'))", true, Check{In: []string{"new", "in_progress"}}},
+		{"status", "(`status` = _utf8mb4'in_progress')", true, Check{In: []string{"in_progress"}}},
+		{"size", "((`size` >= 1) and (`other` <= 10))", false, Check{}},
+		// mssql
+		{"size", "([size]>=(1) AND [size]<(10.5))", true, Check{HasMin: true, Min: 1, HasMax: true, Max: 10.5, ExclusiveMax: true}},
+		{"status", "([status]=N'done' OR [status]='new')", true, Check{In: []string{"done", "new"}}},
+		{"status", "([status]='new' OR [status]='in_progress')", true, Check{In: []string{"new", "in_progress"}}},
+	}
+
+	for i, test := range tests {
+		var check Check
+		ok := ParseCheck(test.Column, test.Clause, &check)
+		if ok != test.OK {
+			t.Errorf("%d) want ok: %t, got: %t", i, test.OK, ok)
+			continue
+		}
+		if !reflect.DeepEqual(check, test.Check) {
+			t.Errorf("%d) want: %#v\ngot: %#v", i, test.Check, check)
+		}
+	}
+}
+
+func TestParseCheckMerge(t *testing.T) {
+	t.Parallel()
+
+	var check Check
+	ParseCheck("size", "CHECK ((size >= 1))", &check)
+	ParseCheck("size", "CHECK ((size > 5))", &check)
+	ParseCheck("size", "CHECK ((size <= 10))", &check)
+
+	want := Check{HasMin: true, Min: 5, ExclusiveMin: true, HasMax: true, Max: 10}
+	if !reflect.DeepEqual(check, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, check)
+	}
+}
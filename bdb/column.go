@@ -16,6 +16,19 @@ type Column struct {
 	Nullable  bool
 	Unique    bool
 	Validated bool
+	// Comment is the comment on the column in the database, if any
+	Comment string
+
+	// MaxLength is the declared length of a character column, zero if it
+	// has none.
+	MaxLength int
+	// Precision and Scale are the declared number of digits of a numeric
+	// column and how many of them follow the decimal point, zero if the
+	// column has none.
+	Precision int
+	Scale     int
+	// Check holds the limits of the simple CHECK constraints on the column
+	Check Check
 
 	// Postgres only extension bits
 	// ArrType is the underlying data type of the Postgres
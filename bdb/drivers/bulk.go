@@ -0,0 +1,139 @@
+package drivers
+
+import (
+	"database/sql"
+
+	"github.com/curvegrid/sqlboiler/bdb"
+)
+
+// scanPrimaryKeys reads rows of table name, constraint name and column name,
+// ordered by table and column position, into primary keys keyed by table.
+func scanPrimaryKeys(rows *sql.Rows) (map[string]*bdb.PrimaryKey, error) {
+	defer rows.Close()
+
+	pkeys := make(map[string]*bdb.PrimaryKey)
+	for rows.Next() {
+		var tableName, name, column string
+		if err := rows.Scan(&tableName, &name, &column); err != nil {
+			return nil, err
+		}
+
+		pkey, ok := pkeys[tableName]
+		if !ok {
+			pkey = &bdb.PrimaryKey{Name: name}
+			pkeys[tableName] = pkey
+		}
+		pkey.Columns = append(pkey.Columns, column)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return pkeys, nil
+}
+
+// scanForeignKeys reads rows of constraint name, table, column, foreign
+// schema, foreign table and foreign column into foreign keys keyed by table.
+// The rows of a composite key must be adjacent and in column order, they are
+// merged into a single foreign key.
+func scanForeignKeys(rows *sql.Rows) (map[string][]bdb.ForeignKey, error) {
+	defer rows.Close()
+
+	fkeys := make(map[string][]bdb.ForeignKey)
+	for rows.Next() {
+		var fkey bdb.ForeignKey
+		if err := rows.Scan(&fkey.Name, &fkey.Table, &fkey.Column, &fkey.ForeignSchemaName, &fkey.ForeignTable, &fkey.ForeignColumn); err != nil {
+			return nil, err
+		}
+
+		tableKeys := fkeys[fkey.Table]
+		if ln := len(tableKeys); ln != 0 && tableKeys[ln-1].Name == fkey.Name {
+			last := &tableKeys[ln-1]
+			last.Columns = append(last.Columns, fkey.Column)
+			last.ForeignColumns = append(last.ForeignColumns, fkey.ForeignColumn)
+			continue
+		}
+
+		fkey.Columns = []string{fkey.Column}
+		fkey.ForeignColumns = []string{fkey.ForeignColumn}
+		fkeys[fkey.Table] = append(tableKeys, fkey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return fkeys, nil
+}
+
+// scanUniqueKeys reads rows of table name, key name and column name, ordered
+// by table, key and column position, into unique keys keyed by table.
+func scanUniqueKeys(rows *sql.Rows) (map[string][]bdb.UniqueKey, error) {
+	defer rows.Close()
+
+	ukeys := make(map[string][]bdb.UniqueKey)
+	for rows.Next() {
+		var tableName, name, column string
+		if err := rows.Scan(&tableName, &name, &column); err != nil {
+			return nil, err
+		}
+
+		tableKeys := ukeys[tableName]
+		if ln := len(tableKeys); ln != 0 && tableKeys[ln-1].Name == name {
+			tableKeys[ln-1].Columns = append(tableKeys[ln-1].Columns, column)
+			continue
+		}
+
+		ukeys[tableName] = append(tableKeys, bdb.UniqueKey{Name: name, Columns: []string{column}})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ukeys, nil
+}
+
+// scanCheckConstraints reads rows of table name, constraint name, column
+// name and clause into check constraints keyed by table.
+func scanCheckConstraints(rows *sql.Rows) (map[string][]bdb.CheckConstraint, error) {
+	defer rows.Close()
+
+	checks := make(map[string][]bdb.CheckConstraint)
+	for rows.Next() {
+		var tableName string
+		var check bdb.CheckConstraint
+		if err := rows.Scan(&tableName, &check.Name, &check.Column, &check.Clause); err != nil {
+			return nil, err
+		}
+		checks[tableName] = append(checks[tableName], check)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// scanComments reads rows of table name and comment into comments keyed by
+// table name.
+func scanComments(rows *sql.Rows) (map[string]string, error) {
+	defer rows.Close()
+
+	comments := make(map[string]string)
+	for rows.Next() {
+		var table, comment string
+		if err := rows.Scan(&table, &comment); err != nil {
+			return nil, err
+		}
+		comments[table] = comment
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
@@ -8,13 +8,48 @@ import (
 // MockDriver is a mock implementation of the bdb driver Interface
 type MockDriver struct{}
 
-// TableNames returns a list of mock table names
+// TableNames returns a list of mock table names. The billing schema has a
+// pilots table of its own and invoices referring to both pilots tables.
 func (m *MockDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	if schema == "billing" {
+		return mockNames([]string{"invoices", "pilots"}, whitelist, blacklist), nil
+	}
+
+	tables := []string{"pilots", "jets", "airports", "licenses", "hangars", "hangar_spots", "parkings", "languages", "pilot_languages"}
+	return mockNames(tables, whitelist, blacklist), nil
+}
+
+// ViewNames returns a list of mock view names
+func (m *MockDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	if schema == "billing" {
+		return nil, nil
+	}
+	return mockNames([]string{"pilot_jet_counts"}, whitelist, blacklist), nil
+}
+
+// MaterializedViewNames returns a list of mock materialized view names
+func (m *MockDriver) MaterializedViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	if schema == "billing" {
+		return nil, nil
+	}
+	return mockNames([]string{"airport_jet_counts"}, whitelist, blacklist), nil
+}
+
+// mockNames filters names with the whitelist or blacklist
+func mockNames(names, whitelist, blacklist []string) []string {
 	if len(whitelist) > 0 {
-		return whitelist, nil
+		return strmangle.SetComplement(names, strmangle.SetComplement(names, whitelist))
 	}
-	tables := []string{"pilots", "jets", "airports", "licenses", "hangars", "languages", "pilot_languages"}
-	return strmangle.SetComplement(tables, blacklist), nil
+	return strmangle.SetComplement(names, blacklist)
+}
+
+// mockKey is the key of a table in the mock data, tables outside the public
+// schema are prefixed with their schema
+func mockKey(schema, tableName string) string {
+	if schema == "billing" {
+		return schema + "." + tableName
+	}
+	return tableName
 }
 
 // Columns returns a list of mock columns
@@ -22,7 +57,7 @@ func (m *MockDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 	return map[string][]bdb.Column{
 		"pilots": {
 			{Name: "id", Type: "int", DBType: "integer"},
-			{Name: "name", Type: "string", DBType: "character"},
+			{Name: "name", Type: "string", DBType: "character", Comment: "The name the pilot flies under", MaxLength: 50},
 		},
 		"airports": {
 			{Name: "id", Type: "int", DBType: "integer"},
@@ -32,7 +67,7 @@ func (m *MockDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 			{Name: "id", Type: "int", DBType: "integer"},
 			{Name: "pilot_id", Type: "int", DBType: "integer", Nullable: true, Unique: true},
 			{Name: "airport_id", Type: "int", DBType: "integer"},
-			{Name: "name", Type: "string", DBType: "character", Nullable: false},
+			{Name: "name", Type: "string", DBType: "character", Nullable: false, MaxLength: 100},
 			{Name: "color", Type: "null.String", DBType: "character", Nullable: true},
 			{Name: "uuid", Type: "string", DBType: "uuid", Nullable: true},
 			{Name: "identifier", Type: "string", DBType: "uuid", Nullable: false},
@@ -47,6 +82,15 @@ func (m *MockDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 			{Name: "id", Type: "int", DBType: "integer"},
 			{Name: "name", Type: "string", DBType: "character", Nullable: true, Unique: true},
 		},
+		"hangar_spots": {
+			{Name: "hangar_id", Type: "int", DBType: "integer"},
+			{Name: "spot", Type: "int", DBType: "integer"},
+		},
+		"parkings": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "hangar_id", Type: "int", DBType: "integer", Nullable: true},
+			{Name: "spot", Type: "int", DBType: "integer", Nullable: true},
+		},
 		"languages": {
 			{Name: "id", Type: "int", DBType: "integer"},
 			{Name: "language", Type: "string", DBType: "character", Nullable: false, Unique: true},
@@ -55,7 +99,32 @@ func (m *MockDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 			{Name: "pilot_id", Type: "int", DBType: "integer"},
 			{Name: "language_id", Type: "int", DBType: "integer"},
 		},
-	}[tableName], nil
+		"pilot_jet_counts": {
+			{Name: "pilot_id", Type: "int", DBType: "integer"},
+			{Name: "jet_count", Type: "int64", DBType: "bigint"},
+		},
+		"airport_jet_counts": {
+			{Name: "airport_id", Type: "int", DBType: "integer"},
+			{Name: "jet_count", Type: "int64", DBType: "bigint"},
+		},
+		"billing.pilots": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "account", Type: "string", DBType: "character"},
+		},
+		"billing.invoices": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "pilot_id", Type: "int", DBType: "integer"},
+			{Name: "payer_id", Type: "null.Int", DBType: "integer", Nullable: true},
+		},
+	}[mockKey(schema, tableName)], nil
+}
+
+// TableComments returns a list of mock table comments
+func (m *MockDriver) TableComments(schema string) (map[string]string, error) {
+	if schema == "billing" {
+		return map[string]string{"invoices": "Invoices sent to pilots"}, nil
+	}
+	return map[string]string{"pilots": "Pilots licensed to fly jets.\nRetired pilots are kept."}, nil
 }
 
 // ForeignKeyInfo returns a list of mock foreignkeys
@@ -68,11 +137,61 @@ func (m *MockDriver) ForeignKeyInfo(schema, tableName string) ([]bdb.ForeignKey,
 		"licenses": {
 			{Table: "licenses", Name: "licenses_pilot_id_fk", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
 		},
+		"hangar_spots": {
+			{Table: "hangar_spots", Name: "hangar_spots_hangar_id_fk", Column: "hangar_id", ForeignTable: "hangars", ForeignColumn: "id"},
+		},
+		"parkings": {
+			{
+				Table: "parkings", Name: "parkings_hangar_spot_fk",
+				Columns: []string{"hangar_id", "spot"}, ForeignTable: "hangar_spots", ForeignColumns: []string{"hangar_id", "spot"},
+			},
+		},
 		"pilot_languages": {
 			{Table: "pilot_languages", Name: "pilot_id_fk", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
 			{Table: "pilot_languages", Name: "jet_id_fk", Column: "language_id", ForeignTable: "languages", ForeignColumn: "id"},
 		},
-	}[tableName], nil
+		"billing.invoices": {
+			{Table: "invoices", Name: "invoices_pilot_id_fk", Column: "pilot_id", ForeignSchemaName: "public", ForeignTable: "pilots", ForeignColumn: "id"},
+			{Table: "invoices", Name: "invoices_payer_id_fk", Column: "payer_id", ForeignSchemaName: "billing", ForeignTable: "pilots", ForeignColumn: "id"},
+		},
+	}[mockKey(schema, tableName)], nil
+}
+
+// AllUniqueKeyInfo returns mock unique keys for the tables of the schema
+func (m *MockDriver) AllUniqueKeyInfo(schema string) (map[string][]bdb.UniqueKey, error) {
+	if schema == "billing" {
+		return nil, nil
+	}
+
+	return map[string][]bdb.UniqueKey{
+		"jets": {
+			{Name: "jets_airport_id_name_key", Columns: []string{"airport_id", "name"}},
+			{Name: "jets_manifest_key", Columns: []string{"manifest"}},
+			{Name: "jets_pilot_id_key", Columns: []string{"pilot_id"}},
+		},
+		"hangars": {
+			{Name: "hangars_name_key", Columns: []string{"name"}},
+		},
+		"languages": {
+			{Name: "languages_language_key", Columns: []string{"language"}},
+		},
+	}, nil
+}
+
+// AllCheckConstraints returns mock check constraints for the tables of the schema
+func (m *MockDriver) AllCheckConstraints(schema string) (map[string][]bdb.CheckConstraint, error) {
+	if schema == "billing" {
+		return nil, nil
+	}
+
+	return map[string][]bdb.CheckConstraint{
+		"airports": {
+			{Name: "airports_size_check", Column: "size", Clause: "CHECK (((size >= 1) AND (size <= 100)))"},
+		},
+		"jets": {
+			{Name: "jets_color_check", Column: "color", Clause: "CHECK (((color)::text = ANY ((ARRAY['red'::character varying, 'blue'::character varying])::text[])))"},
+		},
+	}, nil
 }
 
 // TranslateColumnType converts a column to its "null." form if it is nullable
@@ -104,6 +223,14 @@ func (m *MockDriver) PrimaryKeyInfo(schema, tableName string) (*bdb.PrimaryKey,
 			Name:    "hangar_id_pkey",
 			Columns: []string{"id"},
 		},
+		"hangar_spots": {
+			Name:    "hangar_spots_pkey",
+			Columns: []string{"hangar_id", "spot"},
+		},
+		"parkings": {
+			Name:    "parking_id_pkey",
+			Columns: []string{"id"},
+		},
 		"languages": {
 			Name:    "language_id_pkey",
 			Columns: []string{"id"},
@@ -112,7 +239,15 @@ func (m *MockDriver) PrimaryKeyInfo(schema, tableName string) (*bdb.PrimaryKey,
 			Name:    "pilot_languages_pkey",
 			Columns: []string{"pilot_id", "language_id"},
 		},
-	}[tableName], nil
+		"billing.pilots": {
+			Name:    "pilots_pkey",
+			Columns: []string{"id"},
+		},
+		"billing.invoices": {
+			Name:    "invoices_pkey",
+			Columns: []string{"id"},
+		},
+	}[mockKey(schema, tableName)], nil
 }
 
 // UseLastInsertID returns a database mock LastInsertID compatibility flag
@@ -77,14 +77,31 @@ func (m *MSSQLDriver) UseTopClause() bool {
 // retrieves all table names from the information_schema where the
 // table schema is schema. It uses a whitelist and blacklist.
 func (m *MSSQLDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return m.names("BASE TABLE", schema, whitelist, blacklist)
+}
+
+// ViewNames retrieves the names of the views in the schema.
+func (m *MSSQLDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return m.names("VIEW", schema, whitelist, blacklist)
+}
+
+// MaterializedViewNames returns nothing, indexed views are the closest thing
+// MSSQL has and they are listed as regular views.
+func (m *MSSQLDriver) MaterializedViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return nil, nil
+}
+
+// names retrieves the names of the tables of the given table_type in the
+// schema. It uses a whitelist and blacklist.
+func (m *MSSQLDriver) names(tableType, schema string, whitelist, blacklist []string) ([]string, error) {
 	var names []string
 
 	query := `
 		SELECT table_name
 		FROM   information_schema.tables
-		WHERE  table_schema = ? AND table_type = 'BASE TABLE'`
+		WHERE  table_schema = ? AND table_type = ?`
 
-	args := []interface{}{schema}
+	args := []interface{}{schema, tableType}
 	if len(whitelist) > 0 {
 		query += fmt.Sprintf(" AND table_name IN (%s);", strings.Repeat(",?", len(whitelist))[1:])
 		for _, w := range whitelist {
@@ -120,10 +137,27 @@ func (m *MSSQLDriver) TableNames(schema string, whitelist, blacklist []string) (
 // and column types and returns those as a []Column after TranslateColumnType()
 // converts the SQL types to Go types, for example: "varchar" to "string"
 func (m *MSSQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
-	var columns []bdb.Column
+	columns, err := m.columns(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return columns[tableName], nil
+}
+
+// AllColumns retrieves the columns of every table in the schema at once.
+func (m *MSSQLDriver) AllColumns(schema string) (map[string][]bdb.Column, error) {
+	return m.columns(schema, "")
+}
+
+// columns retrieves the columns of a table keyed by table name, or of every
+// table in the schema if tableName is empty.
+func (m *MSSQLDriver) columns(schema, tableName string) (map[string][]bdb.Column, error) {
+	columns := make(map[string][]bdb.Column)
 
 	rows, err := m.dbConn.Query(`
-	SELECT column_name,
+	SELECT c.table_name,
+       column_name,
        CASE
          WHEN character_maximum_length IS NULL THEN data_type
          ELSE data_type + '(' + CAST(character_maximum_length AS VARCHAR) + ')'
@@ -151,9 +185,28 @@ func (m *MSSQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
                              AND   constraint_name = tc.constraint_name) = 1) THEN 1
          ELSE 0
        END AS is_unique,
-	   COLUMNPROPERTY(object_id($1 + '.' + $2), c.column_name, 'IsIdentity') as is_identity
+	   COLUMNPROPERTY(object_id(c.table_schema + '.' + c.table_name), c.column_name, 'IsIdentity') as is_identity,
+	   ISNULL((SELECT CAST(ep.value AS NVARCHAR(MAX))
+	           FROM sys.extended_properties ep
+	           WHERE ep.class = 1
+	           AND   ep.name = 'MS_Description'
+	           AND   ep.major_id = object_id(c.table_schema + '.' + c.table_name)
+	           AND   ep.minor_id = COLUMNPROPERTY(object_id(c.table_schema + '.' + c.table_name), c.column_name, 'ColumnId')), '') AS column_comment,
+       CASE
+         WHEN data_type IN ('char', 'varchar', 'nchar', 'nvarchar') AND character_maximum_length > 0 THEN character_maximum_length
+         ELSE 0
+       END AS max_length,
+       CASE
+         WHEN data_type IN ('decimal', 'numeric') THEN CAST(numeric_precision AS INT)
+         ELSE 0
+       END AS numeric_precision,
+       CASE
+         WHEN data_type IN ('decimal', 'numeric') THEN CAST(numeric_scale AS INT)
+         ELSE 0
+       END AS numeric_scale
 	FROM information_schema.columns c
-	WHERE table_schema = $1 AND table_name = $2;
+	WHERE table_schema = $1 AND ($2 = '' OR table_name = $2)
+	ORDER BY c.table_name, c.ordinal_position;
 	`, schema, tableName)
 
 	if err != nil {
@@ -162,10 +215,11 @@ func (m *MSSQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 	defer rows.Close()
 
 	for rows.Next() {
-		var colName, colType, colFullType string
+		var table, colName, colType, colFullType, comment string
 		var nullable, unique, identity, auto bool
 		var defaultValue *string
-		if err := rows.Scan(&colName, &colFullType, &colType, &defaultValue, &nullable, &unique, &identity); err != nil {
+		var maxLength, precision, scale int
+		if err := rows.Scan(&table, &colName, &colFullType, &colType, &defaultValue, &nullable, &unique, &identity, &comment, &maxLength, &precision, &scale); err != nil {
 			return nil, errors.Wrapf(err, "unable to scan for table %s", tableName)
 		}
 
@@ -178,6 +232,10 @@ func (m *MSSQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 			Nullable:      nullable,
 			Unique:        unique,
 			AutoGenerated: auto,
+			Comment:       comment,
+			MaxLength:     maxLength,
+			Precision:     precision,
+			Scale:         scale,
 		}
 
 		if defaultValue != nil && *defaultValue != "NULL" {
@@ -185,104 +243,158 @@ func (m *MSSQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 		} else if identity || auto {
 			column.Default = "auto"
 		}
-		columns = append(columns, column)
+		columns[table] = append(columns[table], column)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
 	return columns, nil
 }
 
+// TableComments retrieves the MS_Description of the tables and views in the
+// schema
+func (m *MSSQLDriver) TableComments(schema string) (map[string]string, error) {
+	rows, err := m.dbConn.Query(`
+	SELECT o.name, CAST(ep.value AS NVARCHAR(MAX))
+	FROM sys.extended_properties ep
+	INNER JOIN sys.objects o ON o.object_id = ep.major_id
+	WHERE ep.class = 1
+	  AND ep.minor_id = 0
+	  AND ep.name = 'MS_Description'
+	  AND schema_name(o.schema_id) = ?
+	`, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanComments(rows)
+}
+
 // PrimaryKeyInfo looks up the primary key for a table.
 func (m *MSSQLDriver) PrimaryKeyInfo(schema, tableName string) (*bdb.PrimaryKey, error) {
-	pkey := &bdb.PrimaryKey{}
-	var err error
-
-	query := `
-	SELECT constraint_name
-	FROM   information_schema.table_constraints
-	WHERE  table_name = ? AND constraint_type = 'PRIMARY KEY' AND table_schema = ?;`
-
-	row := m.dbConn.QueryRow(query, tableName, schema)
-	if err = row.Scan(&pkey.Name); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
+	pkeys, err := m.primaryKeyInfo(schema, tableName)
+	if err != nil {
 		return nil, err
 	}
 
-	queryColumns := `
-	SELECT column_name
-	FROM   information_schema.key_column_usage
-	WHERE  table_name = ? AND constraint_name = ? AND table_schema = ?;`
+	return pkeys[tableName], nil
+}
+
+// AllPrimaryKeyInfo looks up the primary keys of every table in the schema.
+func (m *MSSQLDriver) AllPrimaryKeyInfo(schema string) (map[string]*bdb.PrimaryKey, error) {
+	return m.primaryKeyInfo(schema, "")
+}
 
-	var rows *sql.Rows
-	if rows, err = m.dbConn.Query(queryColumns, tableName, pkey.Name, schema); err != nil {
+// primaryKeyInfo looks up the primary key of a table keyed by table name, or
+// of every table in the schema if tableName is empty.
+func (m *MSSQLDriver) primaryKeyInfo(schema, tableName string) (map[string]*bdb.PrimaryKey, error) {
+	query := `
+	SELECT tc.table_name, tc.constraint_name, kcu.column_name
+	FROM   information_schema.table_constraints tc
+	INNER JOIN information_schema.key_column_usage kcu
+	        ON kcu.constraint_name = tc.constraint_name
+	       AND kcu.table_schema = tc.table_schema
+	       AND kcu.table_name = tc.table_name
+	WHERE  (? = '' OR tc.table_name = ?) AND tc.constraint_type = 'PRIMARY KEY' AND tc.table_schema = ?
+	ORDER BY tc.table_name, kcu.ordinal_position;`
+
+	rows, err := m.dbConn.Query(query, tableName, tableName, schema)
+	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var columns []string
-	for rows.Next() {
-		var column string
+	return scanPrimaryKeys(rows)
+}
 
-		err = rows.Scan(&column)
-		if err != nil {
-			return nil, err
-		}
+// AllUniqueKeyInfo retrieves the unique constraints and unique indexes of
+// every table in the schema. Filtered indexes are left out.
+func (m *MSSQLDriver) AllUniqueKeyInfo(schema string) (map[string][]bdb.UniqueKey, error) {
+	query := `
+	SELECT t.name AS table_name, i.name AS index_name, c.name AS column_name
+	FROM sys.indexes i
+	INNER JOIN sys.tables t ON t.object_id = i.object_id
+	INNER JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id
+	INNER JOIN sys.columns c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+	WHERE schema_name(t.schema_id) = ?
+	  AND i.is_unique = 1
+	  AND i.is_primary_key = 0
+	  AND i.has_filter = 0
+	  AND ic.is_included_column = 0
+	ORDER BY t.name, i.name, ic.key_ordinal
+	`
 
-		columns = append(columns, column)
+	rows, err := m.dbConn.Query(query, schema)
+	if err != nil {
+		return nil, err
 	}
 
-	if err = rows.Err(); err != nil {
+	return scanUniqueKeys(rows)
+}
+
+// AllCheckConstraints retrieves the check constraints of every table in the
+// schema, the column is only known for constraints declared on a column.
+func (m *MSSQLDriver) AllCheckConstraints(schema string) (map[string][]bdb.CheckConstraint, error) {
+	query := `
+	SELECT t.name AS table_name, cc.name AS constraint_name, ISNULL(c.name, '') AS column_name, cc.definition
+	FROM sys.check_constraints cc
+	INNER JOIN sys.tables t ON t.object_id = cc.parent_object_id
+	LEFT JOIN sys.columns c ON c.object_id = cc.parent_object_id AND c.column_id = cc.parent_column_id
+	WHERE schema_name(t.schema_id) = ?
+	ORDER BY t.name, cc.name
+	`
+
+	rows, err := m.dbConn.Query(query, schema)
+	if err != nil {
 		return nil, err
 	}
 
-	pkey.Columns = columns
-
-	return pkey, nil
+	return scanCheckConstraints(rows)
 }
 
 // ForeignKeyInfo retrieves the foreign keys for a given table name.
 func (m *MSSQLDriver) ForeignKeyInfo(schema, tableName string) ([]bdb.ForeignKey, error) {
-	var fkeys []bdb.ForeignKey
-
-	query := `
-	SELECT ccu.constraint_name ,
-		ccu.table_name AS local_table ,
-		ccu.column_name AS local_column ,
-		kcu.table_name AS foreign_table ,
-		kcu.column_name AS foreign_column
-	FROM information_schema.constraint_column_usage ccu
-	INNER JOIN information_schema.referential_constraints rc ON ccu.constraint_name = rc.constraint_name
-	INNER JOIN information_schema.key_column_usage kcu ON kcu.constraint_name = rc.unique_constraint_name
-	WHERE ccu.table_schema = ?
-	  AND ccu.constraint_schema = ?
-	  AND ccu.table_name = ?
-	`
-
-	var rows *sql.Rows
-	var err error
-	if rows, err = m.dbConn.Query(query, schema, schema, tableName); err != nil {
+	fkeys, err := m.foreignKeyInfo(schema, tableName)
+	if err != nil {
 		return nil, err
 	}
 
-	for rows.Next() {
-		var fkey bdb.ForeignKey
-		var sourceTable string
+	return fkeys[tableName], nil
+}
 
-		fkey.Table = tableName
-		err = rows.Scan(&fkey.Name, &sourceTable, &fkey.Column, &fkey.ForeignTable, &fkey.ForeignColumn)
-		if err != nil {
-			return nil, err
-		}
+// AllForeignKeyInfo retrieves the foreign keys of every table in the schema.
+func (m *MSSQLDriver) AllForeignKeyInfo(schema string) (map[string][]bdb.ForeignKey, error) {
+	return m.foreignKeyInfo(schema, "")
+}
 
-		fkeys = append(fkeys, fkey)
-	}
+// foreignKeyInfo retrieves the foreign keys of a table keyed by table name,
+// or of every table in the schema if tableName is empty.
+func (m *MSSQLDriver) foreignKeyInfo(schema, tableName string) (map[string][]bdb.ForeignKey, error) {
+	query := `
+	SELECT fk.name AS constraint_name ,
+		lt.name AS local_table ,
+		lc.name AS local_column ,
+		schema_name(ft.schema_id) AS foreign_schema ,
+		ft.name AS foreign_table ,
+		fc.name AS foreign_column
+	FROM sys.foreign_keys fk
+	INNER JOIN sys.foreign_key_columns fkc ON fkc.constraint_object_id = fk.object_id
+	INNER JOIN sys.tables lt ON lt.object_id = fkc.parent_object_id
+	INNER JOIN sys.columns lc ON lc.object_id = fkc.parent_object_id AND lc.column_id = fkc.parent_column_id
+	INNER JOIN sys.tables ft ON ft.object_id = fkc.referenced_object_id
+	INNER JOIN sys.columns fc ON fc.object_id = fkc.referenced_object_id AND fc.column_id = fkc.referenced_column_id
+	WHERE schema_name(lt.schema_id) = ?
+	  AND (? = '' OR lt.name = ?)
+	ORDER BY lt.name, fk.name, fkc.constraint_column_id
+	`
 
-	if err = rows.Err(); err != nil {
+	rows, err := m.dbConn.Query(query, schema, tableName, tableName)
+	if err != nil {
 		return nil, err
 	}
 
-	return fkeys, nil
+	return scanForeignKeys(rows)
 }
 
 // TranslateColumnType converts postgres database types to Go types, for example
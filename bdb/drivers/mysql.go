@@ -90,10 +90,26 @@ func (m *MySQLDriver) UseTopClause() bool {
 // retrieves all table names from the information_schema where the
 // table schema is public.
 func (m *MySQLDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return m.names("BASE TABLE", schema, whitelist, blacklist)
+}
+
+// ViewNames retrieves the names of the views in the schema.
+func (m *MySQLDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return m.names("VIEW", schema, whitelist, blacklist)
+}
+
+// MaterializedViewNames returns nothing, MySQL has no materialized views.
+func (m *MySQLDriver) MaterializedViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return nil, nil
+}
+
+// names retrieves the names of the tables of the given table_type in the
+// schema. It uses a whitelist and blacklist.
+func (m *MySQLDriver) names(tableType, schema string, whitelist, blacklist []string) ([]string, error) {
 	var names []string
 
-	query := fmt.Sprintf(`select table_name from information_schema.tables where table_schema = ? and table_type = 'BASE TABLE'`)
-	args := []interface{}{schema}
+	query := `select table_name from information_schema.tables where table_schema = ? and table_type = ?`
+	args := []interface{}{schema, tableType}
 	if len(whitelist) > 0 {
 		query += fmt.Sprintf(" and table_name in (%s);", strings.Repeat(",?", len(whitelist))[1:])
 		for _, w := range whitelist {
@@ -129,10 +145,27 @@ func (m *MySQLDriver) TableNames(schema string, whitelist, blacklist []string) (
 // and column types and returns those as a []Column after TranslateColumnType()
 // converts the SQL types to Go types, for example: "varchar" to "string"
 func (m *MySQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
-	var columns []bdb.Column
+	columns, err := m.columns(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return columns[tableName], nil
+}
+
+// AllColumns retrieves the columns of every table in the schema at once.
+func (m *MySQLDriver) AllColumns(schema string) (map[string][]bdb.Column, error) {
+	return m.columns(schema, "")
+}
+
+// columns retrieves the columns of a table keyed by table name, or of every
+// table in the schema if tableName is empty.
+func (m *MySQLDriver) columns(schema, tableName string) (map[string][]bdb.Column, error) {
+	columns := make(map[string][]bdb.Column)
 
 	rows, err := m.dbConn.Query(`
 	select
+	c.table_name,
 	c.column_name,
 	c.column_type,
 	if(c.data_type = 'enum', c.column_type, c.data_type),
@@ -146,10 +179,15 @@ func (m *MySQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 			where c.column_name = kcu.column_name and tc.table_name = c.table_name and
 				(tc.constraint_type = 'PRIMARY KEY' or tc.constraint_type = 'UNIQUE') and
 				(select count(*) from information_schema.key_column_usage where table_schema = kcu.table_schema and table_name = tc.table_name and constraint_name = tc.constraint_name) = 1
-		) as is_unique
+		) as is_unique,
+	c.column_comment,
+	if(c.data_type in ('char', 'varchar'), c.character_maximum_length, 0),
+	if(c.data_type = 'decimal', c.numeric_precision, 0),
+	if(c.data_type = 'decimal', c.numeric_scale, 0)
 	from information_schema.columns as c
-	where table_name = ? and table_schema = ? and c.extra not like '%VIRTUAL%';
-	`, tableName, schema)
+	where (? = '' or c.table_name = ?) and c.table_schema = ? and c.extra not like '%VIRTUAL%'
+	order by c.table_name, c.ordinal_position;
+	`, tableName, tableName, schema)
 
 	if err != nil {
 		return nil, err
@@ -157,10 +195,11 @@ func (m *MySQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 	defer rows.Close()
 
 	for rows.Next() {
-		var colName, colType, colFullType string
+		var table, colName, colType, colFullType, comment string
 		var nullable, unique bool
 		var defaultValue *string
-		if err := rows.Scan(&colName, &colFullType, &colType, &defaultValue, &nullable, &unique); err != nil {
+		var maxLength, precision, scale int
+		if err := rows.Scan(&table, &colName, &colFullType, &colType, &defaultValue, &nullable, &unique, &comment, &maxLength, &precision, &scale); err != nil {
 			return nil, errors.Wrapf(err, "unable to scan for table %s", tableName)
 		}
 
@@ -170,102 +209,161 @@ func (m *MySQLDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
 			DBType:     colType,
 			Nullable:   nullable,
 			Unique:     unique,
+			Comment:    comment,
+			MaxLength:  maxLength,
+			Precision:  precision,
+			Scale:      scale,
 		}
 
 		if defaultValue != nil && *defaultValue != "NULL" {
 			column.Default = *defaultValue
 		}
 
-		columns = append(columns, column)
+		columns[table] = append(columns[table], column)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
 	return columns, nil
 }
 
+// TableComments retrieves the comments of the tables in the schema, views
+// can't have comments in mysql.
+func (m *MySQLDriver) TableComments(schema string) (map[string]string, error) {
+	rows, err := m.dbConn.Query(`
+	select table_name, table_comment
+	from information_schema.tables
+	where table_schema = ? and table_type = 'BASE TABLE' and table_comment <> ''
+	`, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanComments(rows)
+}
+
 // PrimaryKeyInfo looks up the primary key for a table.
 func (m *MySQLDriver) PrimaryKeyInfo(schema, tableName string) (*bdb.PrimaryKey, error) {
-	pkey := &bdb.PrimaryKey{}
-	var err error
+	pkeys, err := m.primaryKeyInfo(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
 
+	return pkeys[tableName], nil
+}
+
+// AllPrimaryKeyInfo looks up the primary keys of every table in the schema.
+func (m *MySQLDriver) AllPrimaryKeyInfo(schema string) (map[string]*bdb.PrimaryKey, error) {
+	return m.primaryKeyInfo(schema, "")
+}
+
+// primaryKeyInfo looks up the primary key of a table keyed by table name, or
+// of every table in the schema if tableName is empty.
+func (m *MySQLDriver) primaryKeyInfo(schema, tableName string) (map[string]*bdb.PrimaryKey, error) {
 	query := `
-	select tc.constraint_name
+	select tc.table_name, tc.constraint_name, kcu.column_name
 	from information_schema.table_constraints as tc
-	where tc.table_name = ? and tc.constraint_type = 'PRIMARY KEY' and tc.table_schema = ?;`
+	inner join information_schema.key_column_usage as kcu
+		on kcu.constraint_name = tc.constraint_name and kcu.table_schema = tc.table_schema and kcu.table_name = tc.table_name
+	where (? = '' or tc.table_name = ?) and tc.constraint_type = 'PRIMARY KEY' and tc.table_schema = ?
+	order by tc.table_name, kcu.ordinal_position;`
 
-	row := m.dbConn.QueryRow(query, tableName, schema)
-	if err = row.Scan(&pkey.Name); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
+	rows, err := m.dbConn.Query(query, tableName, tableName, schema)
+	if err != nil {
 		return nil, err
 	}
 
-	queryColumns := `
-	select kcu.column_name
-	from   information_schema.key_column_usage as kcu
-	where  table_name = ? and constraint_name = ? and table_schema = ?;`
+	return scanPrimaryKeys(rows)
+}
 
-	var rows *sql.Rows
-	if rows, err = m.dbConn.Query(queryColumns, tableName, pkey.Name, schema); err != nil {
+// AllUniqueKeyInfo retrieves the unique indexes of every table in the
+// schema, indexes on expressions are left out.
+func (m *MySQLDriver) AllUniqueKeyInfo(schema string) (map[string][]bdb.UniqueKey, error) {
+	query := `
+	select s.table_name, s.index_name, s.column_name
+	from information_schema.statistics as s
+	where s.table_schema = ? and s.non_unique = 0 and s.index_name <> 'PRIMARY'
+		and not exists (
+			select 1 from information_schema.statistics as e
+			where e.table_schema = s.table_schema and e.table_name = s.table_name
+				and e.index_name = s.index_name and e.column_name is null
+		)
+	order by s.table_name, s.index_name, s.seq_in_index
+	`
+
+	rows, err := m.dbConn.Query(query, schema)
+	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var columns []string
-	for rows.Next() {
-		var column string
-
-		err = rows.Scan(&column)
-		if err != nil {
-			return nil, err
-		}
+	return scanUniqueKeys(rows)
+}
 
-		columns = append(columns, column)
+// AllCheckConstraints retrieves the check constraints of every table in the
+// schema. MySQL doesn't record which column a constraint was declared on, and
+// versions before 8.0.16 have no check constraints at all.
+func (m *MySQLDriver) AllCheckConstraints(schema string) (map[string][]bdb.CheckConstraint, error) {
+	var supported bool
+	row := m.dbConn.QueryRow(`
+	select count(*) > 0
+	from information_schema.tables
+	where table_schema = 'information_schema' and table_name = 'CHECK_CONSTRAINTS'
+	`)
+	if err := row.Scan(&supported); err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, nil
 	}
 
-	if err = rows.Err(); err != nil {
+	rows, err := m.dbConn.Query(`
+	select tc.table_name, cc.constraint_name, '', cc.check_clause
+	from information_schema.table_constraints as tc
+	inner join information_schema.check_constraints as cc
+		on cc.constraint_schema = tc.constraint_schema and cc.constraint_name = tc.constraint_name
+	where tc.table_schema = ? and tc.constraint_type = 'CHECK'
+	order by tc.table_name, cc.constraint_name
+	`, schema)
+	if err != nil {
 		return nil, err
 	}
 
-	pkey.Columns = columns
-
-	return pkey, nil
+	return scanCheckConstraints(rows)
 }
 
 // ForeignKeyInfo retrieves the foreign keys for a given table name.
 func (m *MySQLDriver) ForeignKeyInfo(schema, tableName string) ([]bdb.ForeignKey, error) {
-	var fkeys []bdb.ForeignKey
-
-	query := `
-	select constraint_name, table_name, column_name, referenced_table_name, referenced_column_name
-	from information_schema.key_column_usage
-	where table_schema = ? and referenced_table_schema = ? and table_name = ?
-	`
-
-	var rows *sql.Rows
-	var err error
-	if rows, err = m.dbConn.Query(query, schema, schema, tableName); err != nil {
+	fkeys, err := m.foreignKeyInfo(schema, tableName)
+	if err != nil {
 		return nil, err
 	}
 
-	for rows.Next() {
-		var fkey bdb.ForeignKey
-		var sourceTable string
+	return fkeys[tableName], nil
+}
 
-		fkey.Table = tableName
-		err = rows.Scan(&fkey.Name, &sourceTable, &fkey.Column, &fkey.ForeignTable, &fkey.ForeignColumn)
-		if err != nil {
-			return nil, err
-		}
+// AllForeignKeyInfo retrieves the foreign keys of every table in the schema.
+func (m *MySQLDriver) AllForeignKeyInfo(schema string) (map[string][]bdb.ForeignKey, error) {
+	return m.foreignKeyInfo(schema, "")
+}
 
-		fkeys = append(fkeys, fkey)
-	}
+// foreignKeyInfo retrieves the foreign keys of a table keyed by table name,
+// or of every table in the schema if tableName is empty.
+func (m *MySQLDriver) foreignKeyInfo(schema, tableName string) (map[string][]bdb.ForeignKey, error) {
+	query := `
+	select constraint_name, table_name, column_name, referenced_table_schema, referenced_table_name, referenced_column_name
+	from information_schema.key_column_usage
+	where table_schema = ? and referenced_table_schema = ? and (? = '' or table_name = ?)
+	order by table_name, constraint_name, ordinal_position
+	`
 
-	if err = rows.Err(); err != nil {
+	rows, err := m.dbConn.Query(query, schema, schema, tableName, tableName)
+	if err != nil {
 		return nil, err
 	}
 
-	return fkeys, nil
+	return scanForeignKeys(rows)
 }
 
 // TranslateColumnType converts postgres database types to Go types, for example
@@ -88,17 +88,36 @@ func (m *PostgresDriver) UseTopClause() bool {
 // retrieves all table names from the information_schema where the
 // table schema is schema. It uses a whitelist and blacklist.
 func (p *PostgresDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	query := `select table_name from information_schema.tables where table_schema = $1 and table_type = 'BASE TABLE'`
+	return p.names(query, "table_name", schema, whitelist, blacklist)
+}
+
+// ViewNames retrieves the names of the views in the schema.
+func (p *PostgresDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	query := `select table_name from information_schema.views where table_schema = $1`
+	return p.names(query, "table_name", schema, whitelist, blacklist)
+}
+
+// MaterializedViewNames retrieves the names of the materialized views in the
+// schema, they are not part of the information_schema.
+func (p *PostgresDriver) MaterializedViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	query := `select matviewname from pg_matviews where schemaname = $1`
+	return p.names(query, "matviewname", schema, whitelist, blacklist)
+}
+
+// names runs a query selecting names for the schema given as $1, filtering
+// nameColumn with the whitelist or blacklist.
+func (p *PostgresDriver) names(query, nameColumn, schema string, whitelist, blacklist []string) ([]string, error) {
 	var names []string
 
-	query := fmt.Sprintf(`select table_name from information_schema.tables where table_schema = $1`)
 	args := []interface{}{schema}
 	if len(whitelist) > 0 {
-		query += fmt.Sprintf(" and table_name in (%s);", strmangle.Placeholders(true, len(whitelist), 2, 1))
+		query += fmt.Sprintf(" and %s in (%s);", nameColumn, strmangle.Placeholders(true, len(whitelist), 2, 1))
 		for _, w := range whitelist {
 			args = append(args, w)
 		}
 	} else if len(blacklist) > 0 {
-		query += fmt.Sprintf(" and table_name not in (%s);", strmangle.Placeholders(true, len(blacklist), 2, 1))
+		query += fmt.Sprintf(" and %s not in (%s);", nameColumn, strmangle.Placeholders(true, len(blacklist), 2, 1))
 		for _, b := range blacklist {
 			args = append(args, b)
 		}
@@ -127,10 +146,27 @@ func (p *PostgresDriver) TableNames(schema string, whitelist, blacklist []string
 // and column types and returns those as a []Column after TranslateColumnType()
 // converts the SQL types to Go types, for example: "varchar" to "string"
 func (p *PostgresDriver) Columns(schema, tableName string) ([]bdb.Column, error) {
-	var columns []bdb.Column
+	columns, err := p.columns(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return columns[tableName], nil
+}
+
+// AllColumns retrieves the columns of every table in the schema at once.
+func (p *PostgresDriver) AllColumns(schema string) (map[string][]bdb.Column, error) {
+	return p.columns(schema, "")
+}
+
+// columns retrieves the columns of a table keyed by table name, or of every
+// table in the schema if tableName is empty.
+func (p *PostgresDriver) columns(schema, tableName string) (map[string][]bdb.Column, error) {
+	columns := make(map[string][]bdb.Column)
 
 	rows, err := p.dbConn.Query(`
 		select
+		c.table_name,
 		c.column_name,
 		(
 			case when pgt.typtype = 'e'
@@ -174,7 +210,11 @@ func (p *PostgresDriver) Columns(schema, tableName string) ([]bdb.Column, error)
 			inner join pg_attribute pga on pga.attrelid = pgi.indrelid and pga.attnum = ANY(pgi.indkey)
 			where
 				pgix.schemaname = $1 and pgix.tablename = c.table_name and pga.attname = c.column_name and pgi.indisunique = true
-		)) as is_unique
+		)) as is_unique,
+		coalesce(col_description((quote_ident(c.table_schema) || '.' || quote_ident(c.table_name))::regclass, c.ordinal_position::int), '') as column_comment,
+		coalesce(c.character_maximum_length, 0) as max_length,
+		case when c.data_type = 'numeric' then coalesce(c.numeric_precision, 0) else 0 end as numeric_precision,
+		case when c.data_type = 'numeric' then coalesce(c.numeric_scale, 0) else 0 end as numeric_scale
 
 		from information_schema.columns as c
 		inner join pg_namespace as pgn on pgn.nspname = c.udt_schema
@@ -182,7 +222,8 @@ func (p *PostgresDriver) Columns(schema, tableName string) ([]bdb.Column, error)
 		left join information_schema.element_types e
 			on ((c.table_catalog, c.table_schema, c.table_name, 'TABLE', c.dtd_identifier)
 			= (e.object_catalog, e.object_schema, e.object_name, e.object_type, e.collection_type_identifier))
-		where c.table_name = $2 and c.table_schema = $1;
+		where c.table_schema = $1 and ($2::text = '' or c.table_name = $2::text)
+		order by c.table_name, c.ordinal_position;
 	`, schema, tableName)
 
 	if err != nil {
@@ -191,125 +232,251 @@ func (p *PostgresDriver) Columns(schema, tableName string) ([]bdb.Column, error)
 	defer rows.Close()
 
 	for rows.Next() {
-		var colName, colType, udtName string
+		var table, colName, colType, udtName, comment string
 		var defaultValue, arrayType *string
 		var nullable, unique bool
-		if err := rows.Scan(&colName, &colType, &udtName, &arrayType, &defaultValue, &nullable, &unique); err != nil {
+		var maxLength, precision, scale int
+		if err := rows.Scan(&table, &colName, &colType, &udtName, &arrayType, &defaultValue, &nullable, &unique, &comment, &maxLength, &precision, &scale); err != nil {
 			return nil, errors.Wrapf(err, "unable to scan for table %s", tableName)
 		}
 
 		column := bdb.Column{
+			Name:      colName,
+			DBType:    colType,
+			ArrType:   arrayType,
+			UDTName:   udtName,
+			Nullable:  nullable,
+			Unique:    unique,
+			Comment:   comment,
+			MaxLength: maxLength,
+			Precision: precision,
+			Scale:     scale,
+		}
+		if defaultValue != nil {
+			column.Default = *defaultValue
+		}
+
+		columns[table] = append(columns[table], column)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = p.materializedViewColumns(schema, tableName, columns); err != nil {
+		return nil, err
+	}
+
+	return columns, nil
+}
+
+// materializedViewColumns adds the columns of materialized views to columns.
+// They are missing from information_schema.columns so they're read from the
+// catalog instead, shaped like the information_schema would have them.
+func (p *PostgresDriver) materializedViewColumns(schema, tableName string, columns map[string][]bdb.Column) error {
+	rows, err := p.dbConn.Query(`
+		select
+		pgc.relname,
+		pga.attname,
+		(
+			case
+			when pgt.typtype = 'e' then
+			(
+				select 'enum.' || pgt.typname || '(''' || string_agg(pge.enumlabel, ''',''' order by pge.enumsortorder) || ''')'
+				from pg_enum pge
+				where pge.enumtypid = pgt.oid
+			)
+			when pgt.typcategory = 'A' then 'ARRAY'
+			when pgtn.nspname <> 'pg_catalog' then 'USER-DEFINED'
+			else format_type(pga.atttypid, null)
+			end
+		) as column_type,
+
+		pgt.typname,
+		case when pgt.typcategory = 'A' then format_type(pgt.typelem, null) end as array_type,
+
+		not pga.attnotnull as is_nullable,
+		(select exists(
+			select 1
+			from pg_index pgi
+			where pgi.indrelid = pgc.oid and pgi.indisunique and pgi.indnatts = 1 and pgi.indkey[0] = pga.attnum
+		)) as is_unique,
+		coalesce(col_description(pgc.oid, pga.attnum), '') as column_comment
+
+		from pg_class pgc
+		inner join pg_namespace pgn on pgn.oid = pgc.relnamespace
+		inner join pg_attribute pga on pga.attrelid = pgc.oid and pga.attnum > 0 and not pga.attisdropped
+		inner join pg_type pgt on pgt.oid = pga.atttypid
+		inner join pg_namespace pgtn on pgtn.oid = pgt.typnamespace
+		where pgc.relkind = 'm' and pgn.nspname = $1 and ($2::text = '' or pgc.relname = $2::text)
+		order by pgc.relname, pga.attnum;
+	`, schema, tableName)
+
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var table, colName, colType, udtName, comment string
+		var arrayType *string
+		var nullable, unique bool
+		if err := rows.Scan(&table, &colName, &colType, &udtName, &arrayType, &nullable, &unique, &comment); err != nil {
+			return errors.Wrapf(err, "unable to scan for materialized view %s", tableName)
+		}
+
+		columns[table] = append(columns[table], bdb.Column{
 			Name:     colName,
 			DBType:   colType,
 			ArrType:  arrayType,
 			UDTName:  udtName,
 			Nullable: nullable,
 			Unique:   unique,
-		}
-		if defaultValue != nil {
-			column.Default = *defaultValue
-		}
+			Comment:  comment,
+		})
+	}
+
+	return rows.Err()
+}
 
-		columns = append(columns, column)
+// TableComments retrieves the comments of the tables and views in the schema
+func (p *PostgresDriver) TableComments(schema string) (map[string]string, error) {
+	rows, err := p.dbConn.Query(`
+		select pgc.relname, obj_description(pgc.oid, 'pg_class')
+		from pg_class pgc
+		inner join pg_namespace pgn on pgn.oid = pgc.relnamespace
+		where pgn.nspname = $1 and pgc.relkind in ('r', 'v', 'm') and obj_description(pgc.oid, 'pg_class') is not null
+	`, schema)
+	if err != nil {
+		return nil, err
 	}
 
-	return columns, nil
+	return scanComments(rows)
 }
 
 // PrimaryKeyInfo looks up the primary key for a table.
 func (p *PostgresDriver) PrimaryKeyInfo(schema, tableName string) (*bdb.PrimaryKey, error) {
-	pkey := &bdb.PrimaryKey{}
-	var err error
+	pkeys, err := p.primaryKeyInfo(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return pkeys[tableName], nil
+}
 
+// AllPrimaryKeyInfo looks up the primary keys of every table in the schema.
+func (p *PostgresDriver) AllPrimaryKeyInfo(schema string) (map[string]*bdb.PrimaryKey, error) {
+	return p.primaryKeyInfo(schema, "")
+}
+
+// primaryKeyInfo looks up the primary key of a table keyed by table name, or
+// of every table in the schema if tableName is empty.
+func (p *PostgresDriver) primaryKeyInfo(schema, tableName string) (map[string]*bdb.PrimaryKey, error) {
 	query := `
-	select tc.constraint_name
+	select tc.table_name, tc.constraint_name, kcu.column_name
 	from information_schema.table_constraints as tc
-	where tc.table_name = $1 and tc.constraint_type = 'PRIMARY KEY' and tc.table_schema = $2;`
+	inner join information_schema.key_column_usage as kcu
+		on kcu.constraint_name = tc.constraint_name and kcu.table_schema = tc.table_schema and kcu.table_name = tc.table_name
+	where tc.constraint_type = 'PRIMARY KEY' and tc.table_schema = $1 and ($2::text = '' or tc.table_name = $2::text)
+	order by tc.table_name, kcu.ordinal_position;`
 
-	row := p.dbConn.QueryRow(query, tableName, schema)
-	if err = row.Scan(&pkey.Name); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
+	rows, err := p.dbConn.Query(query, schema, tableName)
+	if err != nil {
 		return nil, err
 	}
 
-	queryColumns := `
-	select kcu.column_name
-	from   information_schema.key_column_usage as kcu
-	where  constraint_name = $1 and table_schema = $2;`
+	return scanPrimaryKeys(rows)
+}
+
+// AllUniqueKeyInfo retrieves the unique constraints and unique indexes of
+// every table in the schema. Partial and expression indexes are left out.
+func (p *PostgresDriver) AllUniqueKeyInfo(schema string) (map[string][]bdb.UniqueKey, error) {
+	query := `
+	select pgc.relname as table_name, pgi.relname as index_name, pga.attname as column_name
+	from pg_index pgix
+		inner join pg_class pgc on pgc.oid = pgix.indrelid
+		inner join pg_class pgi on pgi.oid = pgix.indexrelid
+		inner join pg_namespace pgn on pgn.oid = pgc.relnamespace
+		cross join lateral unnest(pgix.indkey::int2[]) with ordinality as keys(attnum, position)
+		inner join pg_attribute pga on pga.attrelid = pgc.oid and pga.attnum = keys.attnum
+	where pgn.nspname = $1 and pgix.indisunique and not pgix.indisprimary
+		and pgix.indpred is null and pgix.indexprs is null
+	order by pgc.relname, pgi.relname, keys.position
+	`
 
-	var rows *sql.Rows
-	if rows, err = p.dbConn.Query(queryColumns, pkey.Name, schema); err != nil {
+	rows, err := p.dbConn.Query(query, schema)
+	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var columns []string
-	for rows.Next() {
-		var column string
-
-		err = rows.Scan(&column)
-		if err != nil {
-			return nil, err
-		}
+	return scanUniqueKeys(rows)
+}
 
-		columns = append(columns, column)
-	}
+// AllCheckConstraints retrieves the check constraints on single columns of
+// every table in the schema.
+func (p *PostgresDriver) AllCheckConstraints(schema string) (map[string][]bdb.CheckConstraint, error) {
+	query := `
+	select pgc.relname, pgcon.conname, pga.attname, pg_get_constraintdef(pgcon.oid)
+	from pg_constraint pgcon
+		inner join pg_class pgc on pgc.oid = pgcon.conrelid
+		inner join pg_namespace pgn on pgn.oid = pgc.relnamespace
+		inner join pg_attribute pga on pga.attrelid = pgc.oid and pga.attnum = pgcon.conkey[1]
+	where pgn.nspname = $1 and pgcon.contype = 'c' and array_length(pgcon.conkey, 1) = 1
+	order by pgc.relname, pgcon.conname
+	`
 
-	if err = rows.Err(); err != nil {
+	rows, err := p.dbConn.Query(query, schema)
+	if err != nil {
 		return nil, err
 	}
 
-	pkey.Columns = columns
-
-	return pkey, nil
+	return scanCheckConstraints(rows)
 }
 
 // ForeignKeyInfo retrieves the foreign keys for a given table name.
 func (p *PostgresDriver) ForeignKeyInfo(schema, tableName string) ([]bdb.ForeignKey, error) {
-	var fkeys []bdb.ForeignKey
+	fkeys, err := p.foreignKeyInfo(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return fkeys[tableName], nil
+}
+
+// AllForeignKeyInfo retrieves the foreign keys of every table in the schema.
+func (p *PostgresDriver) AllForeignKeyInfo(schema string) (map[string][]bdb.ForeignKey, error) {
+	return p.foreignKeyInfo(schema, "")
+}
 
+// foreignKeyInfo retrieves the foreign keys of a table keyed by table name,
+// or of every table in the schema if tableName is empty.
+func (p *PostgresDriver) foreignKeyInfo(schema, tableName string) (map[string][]bdb.ForeignKey, error) {
 	query := `
 	select
 		pgcon.conname,
 		pgc.relname as source_table,
 		pgasrc.attname as source_column,
+		dstns.nspname as dest_schema,
 		dstlookupname.relname as dest_table,
 		pgadst.attname as dest_column
 	from pg_namespace pgn
 		inner join pg_class pgc on pgn.oid = pgc.relnamespace and pgc.relkind = 'r'
 		inner join pg_constraint pgcon on pgn.oid = pgcon.connamespace and pgc.oid = pgcon.conrelid
 		inner join pg_class dstlookupname on pgcon.confrelid = dstlookupname.oid
-		inner join pg_attribute pgasrc on pgc.oid = pgasrc.attrelid and pgasrc.attnum = ANY(pgcon.conkey)
-		inner join pg_attribute pgadst on pgcon.confrelid = pgadst.attrelid and pgadst.attnum = ANY(pgcon.confkey)
-	where pgn.nspname = $2 and pgc.relname = $1 and pgcon.contype = 'f'
+		inner join pg_namespace dstns on dstlookupname.relnamespace = dstns.oid
+		cross join lateral unnest(pgcon.conkey, pgcon.confkey) with ordinality as keys(srcnum, dstnum, position)
+		inner join pg_attribute pgasrc on pgc.oid = pgasrc.attrelid and pgasrc.attnum = keys.srcnum
+		inner join pg_attribute pgadst on pgcon.confrelid = pgadst.attrelid and pgadst.attnum = keys.dstnum
+	where pgn.nspname = $2 and ($1::text = '' or pgc.relname = $1::text) and pgcon.contype = 'f'
+	order by pgc.relname, pgcon.conname, keys.position
 	`
 
-	var rows *sql.Rows
-	var err error
-	if rows, err = p.dbConn.Query(query, tableName, schema); err != nil {
-		return nil, err
-	}
-
-	for rows.Next() {
-		var fkey bdb.ForeignKey
-		var sourceTable string
-
-		fkey.Table = tableName
-		err = rows.Scan(&fkey.Name, &sourceTable, &fkey.Column, &fkey.ForeignTable, &fkey.ForeignColumn)
-		if err != nil {
-			return nil, err
-		}
-
-		fkeys = append(fkeys, fkey)
-	}
-
-	if err = rows.Err(); err != nil {
+	rows, err := p.dbConn.Query(query, tableName, schema)
+	if err != nil {
 		return nil, err
 	}
 
-	return fkeys, nil
+	return scanForeignKeys(rows)
 }
 
 // TranslateColumnType converts postgres database types to Go types, for example
@@ -2,7 +2,10 @@
 package bdb
 
 import (
+	"fmt"
 	"sort"
+	"strings"
+	"sync"
 
 	"github.com/curvegrid/sqlboiler/strmangle"
 	"github.com/pkg/errors"
@@ -40,9 +43,96 @@ type Interface interface {
 	IndexPlaceholders() bool
 }
 
-// Tables returns the metadata for all tables, minus the tables
-// specified in the blacklist.
-func Tables(db Interface, schema string, whitelist, blacklist []string) ([]Table, error) {
+// BulkInterface may optionally be implemented by a driver to fetch the
+// metadata for every table in a schema with a handful of queries rather than
+// several per table. The returned maps are keyed by table name, tables that
+// are missing from them are treated as having no columns or keys.
+type BulkInterface interface {
+	AllColumns(schema string) (map[string][]Column, error)
+	AllPrimaryKeyInfo(schema string) (map[string]*PrimaryKey, error)
+	AllForeignKeyInfo(schema string) (map[string][]ForeignKey, error)
+}
+
+// ViewInterface may optionally be implemented by a driver to introspect the
+// views of a schema as well as its tables. Views are returned by Tables
+// alongside the tables, their columns are fetched the same way.
+type ViewInterface interface {
+	ViewNames(schema string, whitelist, blacklist []string) ([]string, error)
+	MaterializedViewNames(schema string, whitelist, blacklist []string) ([]string, error)
+}
+
+// CommentInterface may optionally be implemented by a driver to read the
+// comments of the tables in a schema, keyed by table name. The comments of
+// columns are returned by Columns.
+type CommentInterface interface {
+	TableComments(schema string) (map[string]string, error)
+}
+
+// UniqueKeyInterface may optionally be implemented by a driver to read the
+// unique constraints and unique indexes of the tables in a schema, keyed by
+// table name. The columns of a key must be in index order.
+type UniqueKeyInterface interface {
+	AllUniqueKeyInfo(schema string) (map[string][]UniqueKey, error)
+}
+
+// CheckInterface may optionally be implemented by a driver to read the CHECK
+// constraints of the tables in a schema, keyed by table name. The ones that
+// only limit the values of a single column end up in Column.Check.
+type CheckInterface interface {
+	AllCheckConstraints(schema string) (map[string][]CheckConstraint, error)
+}
+
+// maxConcurrentTables is the number of tables whose metadata is fetched at
+// the same time by drivers that don't implement BulkInterface.
+const maxConcurrentTables = 8
+
+// Tables returns the metadata for all tables of the schemas, minus the tables
+// specified in the blacklist. Views are included if the driver supports
+// them, virtualKeys supplies the keys they can't declare themselves.
+func Tables(db Interface, schemas []string, whitelist, blacklist []string, virtualKeys map[string]VirtualKeys) ([]Table, error) {
+	var tables []Table
+	for _, schema := range schemas {
+		schemaTables, err := tablesInSchema(db, schema, whitelist, blacklist)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, schemaTables...)
+	}
+
+	if err := setTableNames(tables); err != nil {
+		return nil, err
+	}
+
+	if err := setVirtualKeys(tables, virtualKeys, whitelist, blacklist); err != nil {
+		return nil, err
+	}
+
+	for i := range tables {
+		t := &tables[i]
+
+		for j, c := range t.Columns {
+			t.Columns[j] = db.TranslateColumnType(c)
+		}
+
+		setIsJoinTable(t)
+	}
+
+	// Relationships have a dependency on foreign key nullability.
+	for i := range tables {
+		tbl := &tables[i]
+		setForeignKeyConstraints(tbl, tables)
+	}
+	for i := range tables {
+		tbl := &tables[i]
+		setRelationships(tbl, tables)
+	}
+
+	return tables, nil
+}
+
+// tablesInSchema returns the tables and views of a single schema with their
+// columns and keys, sorted by name.
+func tablesInSchema(db Interface, schema string, whitelist, blacklist []string) ([]Table, error) {
 	var err error
 
 	names, err := db.TableNames(schema, whitelist, blacklist)
@@ -50,56 +140,382 @@ func Tables(db Interface, schema string, whitelist, blacklist []string) ([]Table
 		return nil, errors.Wrap(err, "unable to get table names")
 	}
 
+	var views, materializedViews []string
+	if v, ok := db.(ViewInterface); ok {
+		if views, err = v.ViewNames(schema, whitelist, blacklist); err != nil {
+			return nil, errors.Wrap(err, "unable to get view names")
+		}
+		if materializedViews, err = v.MaterializedViewNames(schema, whitelist, blacklist); err != nil {
+			return nil, errors.Wrap(err, "unable to get materialized view names")
+		}
+	}
+
+	names = append(append(names, views...), materializedViews...)
 	sort.Strings(names)
 
-	var tables []Table
-	for _, name := range names {
-		t := Table{
-			Name: name,
+	tables := make([]Table, len(names))
+	for i, name := range names {
+		tables[i].Name = name
+		tables[i].SQLName = name
+		tables[i].SchemaName = schema
+		tables[i].IsMaterializedView = strmangle.SetInclude(name, materializedViews)
+		tables[i].IsView = tables[i].IsMaterializedView || strmangle.SetInclude(name, views)
+	}
+
+	if bulk, ok := db.(BulkInterface); ok {
+		err = bulkTableInfo(bulk, schema, tables)
+	} else {
+		err = concurrentTableInfo(db, schema, tables)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c, ok := db.(CommentInterface); ok {
+		comments, err := c.TableComments(schema)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to fetch table comments")
+		}
+		for i := range tables {
+			tables[i].Comment = comments[tables[i].SQLName]
 		}
+	}
 
-		if t.Columns, err = db.Columns(schema, name); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table column info (%s)", name)
+	if u, ok := db.(UniqueKeyInterface); ok {
+		ukeys, err := u.AllUniqueKeyInfo(schema)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to fetch table unique key info")
+		}
+		for i := range tables {
+			setUniqueKeys(&tables[i], ukeys[tables[i].SQLName])
 		}
+	}
 
-		for i, c := range t.Columns {
-			t.Columns[i] = db.TranslateColumnType(c)
+	if c, ok := db.(CheckInterface); ok {
+		checks, err := c.AllCheckConstraints(schema)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to fetch table check constraints")
+		}
+		for i := range tables {
+			setChecks(&tables[i], checks[tables[i].SQLName])
 		}
+	}
 
-		if t.PKey, err = db.PrimaryKeyInfo(schema, name); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table pkey info (%s)", name)
+	for i := range tables {
+		t := &tables[i]
+
+		filterForeignKeys(t, whitelist, blacklist)
+
+		for j := range t.FKeys {
+			t.FKeys[j].SchemaName = schema
+			if len(t.FKeys[j].ForeignSchemaName) == 0 {
+				t.FKeys[j].ForeignSchemaName = schema
+			}
 		}
+	}
 
-		if t.FKeys, err = db.ForeignKeyInfo(schema, name); err != nil {
-			return nil, errors.Wrapf(err, "unable to fetch table fkey info (%s)", name)
+	return tables, nil
+}
+
+// setUniqueKeys sets the unique keys of the table, skipping the ones that
+// duplicate the primary key or an earlier unique key. Columns that are a
+// unique key on their own are marked unique.
+func setUniqueKeys(t *Table, ukeys []UniqueKey) {
+	t.UKeys = nil
+	for _, ukey := range ukeys {
+		if t.PKey != nil && sameColumns(t.PKey.Columns, ukey.Columns) {
+			continue
 		}
 
-		filterForeignKeys(&t, whitelist, blacklist)
+		duplicate := false
+		for _, seen := range t.UKeys {
+			if sameColumns(seen.Columns, ukey.Columns) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
 
-		setIsJoinTable(&t)
+		t.UKeys = append(t.UKeys, ukey)
+		if len(ukey.Columns) == 1 {
+			for i := range t.Columns {
+				if t.Columns[i].Name == ukey.Columns[0] {
+					t.Columns[i].Unique = true
+				}
+			}
+		}
+	}
+}
 
-		tables = append(tables, t)
+// setChecks adds the limits of the check constraints to the columns they
+// limit. A constraint without a column is tried against every column.
+func setChecks(t *Table, checks []CheckConstraint) {
+	for _, check := range checks {
+		for i := range t.Columns {
+			c := &t.Columns[i]
+			if len(check.Column) != 0 && check.Column != c.Name {
+				continue
+			}
+			if ParseCheck(c.Name, check.Clause, &c.Check) {
+				break
+			}
+		}
 	}
+}
 
-	// Relationships have a dependency on foreign key nullability.
+// sameColumns returns true if a and b hold the same columns in any order
+func sameColumns(a, b []string) bool {
+	return len(a) == len(b) && len(strmangle.SetComplement(a, b)) == 0
+}
+
+// setTableNames gives every table a unique name. The tables must be in the
+// order of their schemas, a table whose name is taken by a table in an earlier
+// schema is prefixed with its schema. Foreign keys are renamed to match and
+// the ones to tables that aren't being generated are dropped.
+func setTableNames(tables []Table) error {
+	names := make(map[string]string)
+	taken := make(map[string]bool)
 	for i := range tables {
-		tbl := &tables[i]
-		setForeignKeyConstraints(tbl, tables)
+		t := &tables[i]
+		if taken[t.Name] {
+			t.Name = t.SchemaName + "_" + t.SQLName
+		}
+
+		if taken[t.Name] {
+			return errors.Errorf("table %s.%s can't be named %s, the name is already taken", t.SchemaName, t.SQLName, t.Name)
+		}
+		taken[t.Name] = true
+		names[t.SchemaName+"."+t.SQLName] = t.Name
 	}
+
 	for i := range tables {
-		tbl := &tables[i]
-		setRelationships(tbl, tables)
+		t := &tables[i]
+
+		var fkeys []ForeignKey
+		for _, fkey := range t.FKeys {
+			foreignName, ok := names[fkey.ForeignSchemaName+"."+fkey.ForeignTable]
+			if !ok {
+				continue
+			}
+
+			fkey.Table = t.Name
+			fkey.ForeignTable = foreignName
+			fkeys = append(fkeys, fkey)
+		}
+		t.FKeys = fkeys
 	}
 
-	return tables, nil
+	return nil
+}
+
+// bulkTableInfo fills in the columns and keys of the tables using a driver's
+// bulk introspection methods.
+func bulkTableInfo(db BulkInterface, schema string, tables []Table) error {
+	columns, err := db.AllColumns(schema)
+	if err != nil {
+		return errors.Wrap(err, "unable to fetch table column info")
+	}
+
+	pkeys, err := db.AllPrimaryKeyInfo(schema)
+	if err != nil {
+		return errors.Wrap(err, "unable to fetch table pkey info")
+	}
+
+	fkeys, err := db.AllForeignKeyInfo(schema)
+	if err != nil {
+		return errors.Wrap(err, "unable to fetch table fkey info")
+	}
+
+	for i := range tables {
+		t := &tables[i]
+		t.Columns = columns[t.Name]
+		t.PKey = pkeys[t.Name]
+		t.FKeys = fkeys[t.Name]
+	}
+
+	return nil
+}
+
+// concurrentTableInfo fills in the columns and keys of the tables using the
+// per-table driver methods, several tables at a time.
+func concurrentTableInfo(db Interface, schema string, tables []Table) error {
+	work := make(chan *Table)
+	errs := make(chan error, len(tables))
+
+	workers := maxConcurrentTables
+	if len(tables) < workers {
+		workers = len(tables)
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for t := range work {
+				if err := tableInfo(db, schema, t); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+
+	for i := range tables {
+		work <- &tables[i]
+	}
+	close(work)
+	wg.Wait()
+	close(errs)
+
+	// Report the first error only, the rest are usually the same problem
+	return <-errs
+}
+
+// tableInfo fills in the columns and keys of a single table
+func tableInfo(db Interface, schema string, t *Table) error {
+	var err error
+
+	if t.Columns, err = db.Columns(schema, t.Name); err != nil {
+		return errors.Wrapf(err, "unable to fetch table column info (%s)", t.Name)
+	}
+
+	if t.PKey, err = db.PrimaryKeyInfo(schema, t.Name); err != nil {
+		return errors.Wrapf(err, "unable to fetch table pkey info (%s)", t.Name)
+	}
+
+	if t.FKeys, err = db.ForeignKeyInfo(schema, t.Name); err != nil {
+		return errors.Wrapf(err, "unable to fetch table fkey info (%s)", t.Name)
+	}
+
+	return nil
+}
+
+// setForeignKeyColumns makes sure both the single and the list forms of the
+// foreign key columns are filled in, whichever one the driver provided.
+func setForeignKeyColumns(t *Table) {
+	for i := range t.FKeys {
+		f := &t.FKeys[i]
+		if len(f.Columns) == 0 {
+			f.Columns = []string{f.Column}
+			f.ForeignColumns = []string{f.ForeignColumn}
+		}
+		f.Column = f.Columns[0]
+		f.ForeignColumn = f.ForeignColumns[0]
+	}
+}
+
+// setVirtualKeys adds the configured virtual keys to their views. Keys for
+// views or foreign tables that were filtered out are ignored, keys for
+// anything that isn't a view or that refer to columns or tables that don't
+// exist are an error.
+func setVirtualKeys(tables []Table, virtualKeys map[string]VirtualKeys, whitelist, blacklist []string) error {
+	names := make([]string, 0, len(virtualKeys))
+	for name := range virtualKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		keys := virtualKeys[name]
+
+		t := findTable(tables, name)
+		if t == nil {
+			continue
+		}
+		if !t.IsView {
+			return errors.Errorf("virtual keys can only be configured for views, %s is a table", name)
+		}
+
+		if len(keys.PrimaryKey) != 0 {
+			if err := checkColumns(*t, keys.PrimaryKey); err != nil {
+				return errors.Wrapf(err, "invalid virtual primary key for %s", name)
+			}
+			t.PKey = &PrimaryKey{Name: name + "_vpkey", Columns: keys.PrimaryKey}
+
+			// Drivers report single column primary keys as unique, do the same
+			if len(keys.PrimaryKey) == 1 {
+				for i := range t.Columns {
+					if t.Columns[i].Name == keys.PrimaryKey[0] {
+						t.Columns[i].Unique = true
+					}
+				}
+			}
+		}
+
+		for _, fkey := range keys.ForeignKeys {
+			if len(fkey.Columns) == 0 || len(fkey.Columns) != len(fkey.ForeignColumns) {
+				return errors.Errorf("virtual foreign key for %s must have the same number of columns on both sides", name)
+			}
+			if err := checkColumns(*t, fkey.Columns); err != nil {
+				return errors.Wrapf(err, "invalid virtual foreign key for %s", name)
+			}
+
+			foreign := findTable(tables, fkey.ForeignTable)
+			if foreign == nil {
+				if includeTable(fkey.ForeignTable, whitelist, blacklist) {
+					return errors.Errorf("virtual foreign key for %s references unknown table %s", name, fkey.ForeignTable)
+				}
+				continue
+			}
+			if err := checkColumns(*foreign, fkey.ForeignColumns); err != nil {
+				return errors.Wrapf(err, "invalid virtual foreign key for %s", name)
+			}
+
+			fkey.Table = name
+			fkey.SchemaName = t.SchemaName
+			fkey.ForeignSchemaName = foreign.SchemaName
+			if len(fkey.Name) == 0 {
+				fkey.Name = fmt.Sprintf("%s_%s_vfkey", name, strings.Join(fkey.Columns, "_"))
+			}
+			t.FKeys = append(t.FKeys, fkey)
+		}
+	}
+
+	return nil
+}
+
+// findTable returns a pointer to the named table or nil if there is none
+func findTable(tables []Table, name string) *Table {
+	for i := range tables {
+		if tables[i].Name == name {
+			return &tables[i]
+		}
+	}
+
+	return nil
+}
+
+// checkColumns returns an error naming the first column the table lacks
+func checkColumns(t Table, columns []string) error {
+	for _, name := range columns {
+		found := false
+		for _, c := range t.Columns {
+			if c.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("%s has no column %s", t.Name, name)
+		}
+	}
+
+	return nil
+}
+
+// includeTable returns true if the table passes the whitelist and blacklist
+func includeTable(name string, whitelist, blacklist []string) bool {
+	return (len(whitelist) == 0 || strmangle.SetInclude(name, whitelist)) &&
+		(len(blacklist) == 0 || !strmangle.SetInclude(name, blacklist))
 }
 
 // filterForeignKeys filter FK whose ForeignTable is not in whitelist or in blacklist
 func filterForeignKeys(t *Table, whitelist, blacklist []string) {
 	var fkeys []ForeignKey
 	for _, fkey := range t.FKeys {
-		if (len(whitelist) == 0 || strmangle.SetInclude(fkey.ForeignTable, whitelist)) &&
-			(len(blacklist) == 0 || !strmangle.SetInclude(fkey.ForeignTable, blacklist)) {
+		if includeTable(fkey.ForeignTable, whitelist, blacklist) {
 			fkeys = append(fkeys, fkey)
 		}
 	}
@@ -108,16 +524,17 @@ func filterForeignKeys(t *Table, whitelist, blacklist []string) {
 
 // setIsJoinTable if there are:
 // A composite primary key involving two columns
-// Both primary key columns are also foreign keys
+// Both primary key columns are also single column foreign keys
+// Views are never join tables since the relationships would write to them
 func setIsJoinTable(t *Table) {
-	if t.PKey == nil || len(t.PKey.Columns) != 2 || len(t.FKeys) < 2 || len(t.Columns) > 2 {
+	if t.IsView || t.PKey == nil || len(t.PKey.Columns) != 2 || len(t.FKeys) < 2 || len(t.Columns) > 2 {
 		return
 	}
 
 	for _, c := range t.PKey.Columns {
 		found := false
 		for _, f := range t.FKeys {
-			if c == f.Column {
+			if c == f.Column && !f.IsComposite() {
 				found = true
 				break
 			}
@@ -131,16 +548,54 @@ func setIsJoinTable(t *Table) {
 }
 
 func setForeignKeyConstraints(t *Table, tables []Table) {
+	setForeignKeyColumns(t)
+
 	for i, fkey := range t.FKeys {
-		localColumn := t.GetColumn(fkey.Column)
 		foreignTable := GetTable(tables, fkey.ForeignTable)
-		foreignColumn := foreignTable.GetColumn(fkey.ForeignColumn)
 
-		t.FKeys[i].Nullable = localColumn.Nullable
-		t.FKeys[i].Unique = localColumn.Unique
-		t.FKeys[i].ForeignColumnNullable = foreignColumn.Nullable
-		t.FKeys[i].ForeignColumnUnique = foreignColumn.Unique
+		t.FKeys[i].Nullable = columnsNullable(*t, fkey.Columns)
+		t.FKeys[i].Unique = columnsUnique(*t, fkey.Columns)
+		t.FKeys[i].ForeignColumnNullable = columnsNullable(foreignTable, fkey.ForeignColumns)
+		t.FKeys[i].ForeignColumnUnique = columnsUnique(foreignTable, fkey.ForeignColumns)
+	}
+}
+
+// columnsNullable returns true if every one of the columns is nullable
+func columnsNullable(t Table, columns []string) bool {
+	for _, c := range columns {
+		if !t.GetColumn(c).Nullable {
+			return false
+		}
+	}
+
+	return true
+}
+
+// columnsUnique returns true if the combination of columns is unique, either
+// because one of them is or because together they are the primary key or a
+// unique key.
+func columnsUnique(t Table, columns []string) bool {
+	for _, c := range columns {
+		if t.GetColumn(c).Unique {
+			return true
+		}
+	}
+
+	if len(columns) < 2 {
+		return false
 	}
+
+	if t.PKey != nil && sameColumns(t.PKey.Columns, columns) {
+		return true
+	}
+
+	for _, ukey := range t.UKeys {
+		if sameColumns(ukey.Columns, columns) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func setRelationships(t *Table, tables []Table) {
@@ -1,6 +1,8 @@
 package bdb
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/curvegrid/sqlboiler/strmangle"
@@ -64,6 +66,18 @@ func (m testMockDriver) Columns(schema, tableName string) ([]Column, error) {
 	}[tableName], nil
 }
 
+// TableComments returns a list of mock table comments
+func (m testMockDriver) TableComments(schema string) (map[string]string, error) {
+	return map[string]string{"pilots": "Pilots of jets"}, nil
+}
+
+// AllCheckConstraints returns a list of mock check constraints
+func (m testMockDriver) AllCheckConstraints(schema string) (map[string][]CheckConstraint, error) {
+	return map[string][]CheckConstraint{
+		"airports": {{Name: "airports_size_check", Clause: "((size >= 1) AND (size <= 100))"}},
+	}, nil
+}
+
 // ForeignKeyInfo returns a list of mock foreignkeys
 func (m testMockDriver) ForeignKeyInfo(schema, tableName string) ([]ForeignKey, error) {
 	return map[string][]ForeignKey{
@@ -115,7 +129,7 @@ func (m testMockDriver) IndexPlaceholders() bool {
 func TestTables(t *testing.T) {
 	t.Parallel()
 
-	tables, err := Tables(testMockDriver{}, "public", nil, nil)
+	tables, err := Tables(testMockDriver{}, []string{"public"}, nil, nil, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -136,6 +150,20 @@ func TestTables(t *testing.T) {
 	if len(pilots.Columns) != 2 {
 		t.Error()
 	}
+	if pilots.Comment != "Pilots of jets" {
+		t.Error("wrong table comment:", pilots.Comment)
+	}
+	if GetTable(tables, "jets").Comment != "" {
+		t.Error("want no comment on jets")
+	}
+
+	size := GetTable(tables, "airports").GetColumn("size")
+	if !size.Check.HasMin || size.Check.Min != 1 || !size.Check.HasMax || size.Check.Max != 100 {
+		t.Errorf("want the check constraint on size, got: %#v", size.Check)
+	}
+	if !GetTable(tables, "airports").GetColumn("id").Check.IsZero() {
+		t.Error("want no check on id")
+	}
 	if pilots.ToOneRelationships[0].ForeignTable != "jets" {
 		t.Error("want a to many to jets")
 	}
@@ -165,6 +193,92 @@ func TestTables(t *testing.T) {
 	}
 }
 
+// testBulkMockDriver serves the same tables as testMockDriver through the
+// bulk interface, its per-table methods fail to make sure they're not used.
+type testBulkMockDriver struct {
+	testMockDriver
+}
+
+func (m testBulkMockDriver) Columns(schema, tableName string) ([]Column, error) {
+	return nil, errors.New("per-table columns should not be called")
+}
+
+func (m testBulkMockDriver) PrimaryKeyInfo(schema, tableName string) (*PrimaryKey, error) {
+	return nil, errors.New("per-table pkey should not be called")
+}
+
+func (m testBulkMockDriver) ForeignKeyInfo(schema, tableName string) ([]ForeignKey, error) {
+	return nil, errors.New("per-table fkey should not be called")
+}
+
+func (m testBulkMockDriver) AllColumns(schema string) (map[string][]Column, error) {
+	names, _ := m.TableNames(schema, nil, nil)
+	all := make(map[string][]Column)
+	for _, name := range names {
+		all[name], _ = m.testMockDriver.Columns(schema, name)
+	}
+	return all, nil
+}
+
+func (m testBulkMockDriver) AllPrimaryKeyInfo(schema string) (map[string]*PrimaryKey, error) {
+	names, _ := m.TableNames(schema, nil, nil)
+	all := make(map[string]*PrimaryKey)
+	for _, name := range names {
+		all[name], _ = m.testMockDriver.PrimaryKeyInfo(schema, name)
+	}
+	return all, nil
+}
+
+func (m testBulkMockDriver) AllForeignKeyInfo(schema string) (map[string][]ForeignKey, error) {
+	names, _ := m.TableNames(schema, nil, nil)
+	all := make(map[string][]ForeignKey)
+	for _, name := range names {
+		all[name], _ = m.testMockDriver.ForeignKeyInfo(schema, name)
+	}
+	return all, nil
+}
+
+func TestTablesBulk(t *testing.T) {
+	t.Parallel()
+
+	want, err := Tables(testMockDriver{}, []string{"public"}, nil, []string{"hangars"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Tables(testBulkMockDriver{}, []string{"public"}, nil, []string{"hangars"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("bulk and per-table introspection differ:\nwant: %#v\ngot:  %#v", want, got)
+	}
+}
+
+type testFailingMockDriver struct {
+	testMockDriver
+}
+
+func (m testFailingMockDriver) PrimaryKeyInfo(schema, tableName string) (*PrimaryKey, error) {
+	if tableName == "jets" {
+		return nil, errors.New("boom")
+	}
+	return m.testMockDriver.PrimaryKeyInfo(schema, tableName)
+}
+
+func TestTablesError(t *testing.T) {
+	t.Parallel()
+
+	_, err := Tables(testFailingMockDriver{}, []string{"public"}, nil, nil, nil)
+	if err == nil {
+		t.Fatal("want an error")
+	}
+	if err.Error() != "unable to fetch table pkey info (jets): boom" {
+		t.Error("wrong error:", err)
+	}
+}
+
 func TestFilterForeignKeys(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +370,129 @@ func TestSetIsJoinTable(t *testing.T) {
 	}
 }
 
+func TestSetVirtualKeys(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{
+			Name:    "pilots",
+			Columns: []Column{{Name: "id"}},
+		},
+		{
+			Name:    "pilot_jet_counts",
+			IsView:  true,
+			Columns: []Column{{Name: "pilot_id"}, {Name: "jet_count"}},
+		},
+	}
+
+	virtualKeys := map[string]VirtualKeys{
+		"pilot_jet_counts": {
+			PrimaryKey: []string{"pilot_id"},
+			ForeignKeys: []ForeignKey{
+				{Columns: []string{"pilot_id"}, ForeignTable: "pilots", ForeignColumns: []string{"id"}},
+			},
+		},
+	}
+
+	if err := setVirtualKeys(tables, virtualKeys, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	view := tables[1]
+	if view.PKey == nil || !reflect.DeepEqual(view.PKey.Columns, []string{"pilot_id"}) {
+		t.Errorf("wrong primary key: %#v", view.PKey)
+	}
+	if !view.Columns[0].Unique {
+		t.Error("want single column primary key to be unique")
+	}
+
+	expectFKey := ForeignKey{
+		Table:          "pilot_jet_counts",
+		Name:           "pilot_jet_counts_pilot_id_vfkey",
+		Columns:        []string{"pilot_id"},
+		ForeignTable:   "pilots",
+		ForeignColumns: []string{"id"},
+	}
+	if len(view.FKeys) != 1 || !reflect.DeepEqual(view.FKeys[0], expectFKey) {
+		t.Errorf("wrong foreign keys: %#v", view.FKeys)
+	}
+}
+
+func TestSetVirtualKeysError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Name string
+		Keys VirtualKeys
+	}{
+		{Name: "pilots", Keys: VirtualKeys{PrimaryKey: []string{"id"}}},
+		{Name: "pilot_jet_counts", Keys: VirtualKeys{PrimaryKey: []string{"id"}}},
+		{Name: "pilot_jet_counts", Keys: VirtualKeys{ForeignKeys: []ForeignKey{
+			{Columns: []string{"pilot_id"}, ForeignTable: "jets", ForeignColumns: []string{"id"}},
+		}}},
+		{Name: "pilot_jet_counts", Keys: VirtualKeys{ForeignKeys: []ForeignKey{
+			{Columns: []string{"pilot_id"}, ForeignTable: "pilots", ForeignColumns: []string{"pilot_id"}},
+		}}},
+		{Name: "pilot_jet_counts", Keys: VirtualKeys{ForeignKeys: []ForeignKey{
+			{Columns: []string{"pilot_id"}, ForeignTable: "pilots", ForeignColumns: []string{"id", "name"}},
+		}}},
+	}
+
+	for i, test := range tests {
+		tables := []Table{
+			{Name: "pilots", Columns: []Column{{Name: "id"}, {Name: "name"}}},
+			{Name: "pilot_jet_counts", IsView: true, Columns: []Column{{Name: "pilot_id"}}},
+		}
+
+		err := setVirtualKeys(tables, map[string]VirtualKeys{test.Name: test.Keys}, nil, nil)
+		if err == nil {
+			t.Errorf("%d) want an error\nTest: %#v", i, test)
+		}
+	}
+}
+
+func TestSetTableNames(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{Name: "pilots", SQLName: "pilots", SchemaName: "public"},
+		{Name: "invoices", SQLName: "invoices", SchemaName: "billing", FKeys: []ForeignKey{
+			{Table: "invoices", SchemaName: "billing", Column: "pilot_id", ForeignSchemaName: "public", ForeignTable: "pilots", ForeignColumn: "id"},
+			{Table: "invoices", SchemaName: "billing", Column: "payer_id", ForeignSchemaName: "billing", ForeignTable: "pilots", ForeignColumn: "id"},
+			{Table: "invoices", SchemaName: "billing", Column: "bank_id", ForeignSchemaName: "banking", ForeignTable: "banks", ForeignColumn: "id"},
+		}},
+		{Name: "pilots", SQLName: "pilots", SchemaName: "billing"},
+	}
+
+	if err := setTableNames(tables); err != nil {
+		t.Fatal(err)
+	}
+
+	if name := tables[0].Name; name != "pilots" {
+		t.Error("want the first pilots table to keep its name, got:", name)
+	}
+	if name := tables[2].Name; name != "billing_pilots" {
+		t.Error("want the second pilots table prefixed with its schema, got:", name)
+	}
+
+	fkeys := tables[1].FKeys
+	if len(fkeys) != 2 {
+		t.Fatalf("want the foreign key to an unknown schema dropped, got: %#v", fkeys)
+	}
+	if fkeys[0].ForeignTable != "pilots" || fkeys[1].ForeignTable != "billing_pilots" {
+		t.Errorf("wrong foreign tables: %s %s", fkeys[0].ForeignTable, fkeys[1].ForeignTable)
+	}
+
+	tables = []Table{
+		{Name: "billing_pilots", SQLName: "billing_pilots", SchemaName: "public"},
+		{Name: "pilots", SQLName: "pilots", SchemaName: "public"},
+		{Name: "pilots", SQLName: "pilots", SchemaName: "billing"},
+	}
+	if err := setTableNames(tables); err == nil {
+		t.Error("want an error when the prefixed name is taken")
+	}
+}
+
 func TestSetForeignKeyConstraints(t *testing.T) {
 	t.Parallel()
 
@@ -311,6 +548,105 @@ func TestSetForeignKeyConstraints(t *testing.T) {
 	}
 }
 
+func TestSetForeignKeyConstraintsComposite(t *testing.T) {
+	t.Parallel()
+
+	tables := []Table{
+		{
+			Name: "one",
+			Columns: []Column{
+				{Name: "id1", Type: "string"},
+				{Name: "id2", Type: "string"},
+			},
+			PKey: &PrimaryKey{Columns: []string{"id1", "id2"}},
+		},
+		{
+			Name: "other",
+			Columns: []Column{
+				{Name: "one_id_1", Type: "string", Nullable: true},
+				{Name: "one_id_2", Type: "string", Nullable: false},
+				{Name: "two_id_1", Type: "string", Nullable: true},
+				{Name: "two_id_2", Type: "string", Nullable: true},
+			},
+			PKey: &PrimaryKey{Columns: []string{"one_id_1", "one_id_2"}},
+			FKeys: []ForeignKey{
+				{Columns: []string{"one_id_1", "one_id_2"}, ForeignTable: "one", ForeignColumns: []string{"id1", "id2"}},
+				{Columns: []string{"two_id_1", "two_id_2"}, ForeignTable: "one", ForeignColumns: []string{"id1", "id2"}},
+			},
+		},
+	}
+
+	setForeignKeyConstraints(&tables[0], tables)
+	setForeignKeyConstraints(&tables[1], tables)
+
+	first := tables[1].FKeys[0]
+	second := tables[1].FKeys[1]
+	if first.Column != "one_id_1" || first.ForeignColumn != "id1" {
+		t.Errorf("single column fields should mirror the first pair, got: %s -> %s", first.Column, first.ForeignColumn)
+	}
+	if !first.IsComposite() {
+		t.Error("should be composite")
+	}
+	if first.Nullable {
+		t.Error("should not be nullable")
+	}
+	if !first.Unique {
+		t.Error("should be unique")
+	}
+	if !first.ForeignColumnUnique {
+		t.Error("should be unique")
+	}
+	if !second.Nullable {
+		t.Error("should be nullable")
+	}
+	if second.Unique {
+		t.Error("should not be unique")
+	}
+}
+
+func TestSetUniqueKeys(t *testing.T) {
+	t.Parallel()
+
+	table := Table{
+		Name: "jets",
+		Columns: []Column{
+			{Name: "id"},
+			{Name: "airport_id"},
+			{Name: "name"},
+			{Name: "serial"},
+		},
+		PKey: &PrimaryKey{Columns: []string{"id"}},
+	}
+
+	setUniqueKeys(&table, []UniqueKey{
+		{Name: "jets_id_key", Columns: []string{"id"}},
+		{Name: "jets_airport_id_name_key", Columns: []string{"airport_id", "name"}},
+		{Name: "jets_name_airport_id_idx", Columns: []string{"name", "airport_id"}},
+		{Name: "jets_serial_key", Columns: []string{"serial"}},
+	})
+
+	want := []UniqueKey{
+		{Name: "jets_airport_id_name_key", Columns: []string{"airport_id", "name"}},
+		{Name: "jets_serial_key", Columns: []string{"serial"}},
+	}
+	if !reflect.DeepEqual(table.UKeys, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, table.UKeys)
+	}
+	if !table.GetColumn("serial").Unique {
+		t.Error("a single column unique key should make the column unique")
+	}
+	if table.GetColumn("name").Unique {
+		t.Error("a column of a composite unique key should not be unique")
+	}
+
+	if !columnsUnique(table, []string{"name", "airport_id"}) {
+		t.Error("the columns of a composite unique key should be unique together")
+	}
+	if columnsUnique(table, []string{"airport_id", "id"}) {
+		t.Error("columns that aren't a key should not be unique")
+	}
+}
+
 func TestSetRelationships(t *testing.T) {
 	t.Parallel()
 
@@ -8,20 +8,54 @@ type PrimaryKey struct {
 	Columns []string
 }
 
-// ForeignKey represents a foreign key constraint in a database
+// UniqueKey represents a unique constraint or unique index in a database.
+// Partial and expression indexes are never unique keys since they can't be
+// used to look up a row by its column values.
+type UniqueKey struct {
+	Name    string
+	Columns []string
+}
+
+// ForeignKey represents a foreign key constraint in a database.
+//
+// Columns and ForeignColumns hold the paired columns of the constraint in
+// order, Column and ForeignColumn are always their first elements. For a
+// composite key Nullable is only set when every local column is nullable,
+// and the Unique flags are set when one of the columns is unique or together
+// they make up the primary key.
+//
+// Table and ForeignTable are table names as found in Table.Name, the schemas
+// the two tables live in may differ.
 type ForeignKey struct {
-	Table    string
-	Name     string
-	Column   string
-	Nullable bool
-	Unique   bool
+	Table      string
+	SchemaName string
+	Name       string
+	Column     string
+	Columns    []string
+	Nullable   bool
+	Unique     bool
 
 	ForeignTable          string
+	ForeignSchemaName     string
 	ForeignColumn         string
+	ForeignColumns        []string
 	ForeignColumnNullable bool
 	ForeignColumnUnique   bool
 }
 
+// IsComposite returns true if the foreign key spans more than one column
+func (f ForeignKey) IsComposite() bool {
+	return len(f.Columns) > 1
+}
+
+// VirtualKeys are keys that are not declared in the database but should be
+// treated as if they were. Views can't declare keys so this is the only way
+// for them to have a primary key or relationships.
+type VirtualKeys struct {
+	PrimaryKey  []string
+	ForeignKeys []ForeignKey
+}
+
 // SQLColumnDef formats a column name and type like an SQL column definition.
 type SQLColumnDef struct {
 	Name string
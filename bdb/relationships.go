@@ -3,30 +3,36 @@ package bdb
 // ToOneRelationship describes a relationship between two tables where the local
 // table has no id, and the foregin table has an id that matches a column in the
 // local table, that column is also unique which changes the dynamic into a
-// one-to-one style, not a to-many.
+// one-to-one style, not a to-many. Columns and ForeignColumns hold every
+// column of a composite key, Column and ForeignColumn the first of them.
 type ToOneRelationship struct {
 	Table    string
 	Column   string
+	Columns  []string
 	Nullable bool
 	Unique   bool
 
 	ForeignTable          string
 	ForeignColumn         string
+	ForeignColumns        []string
 	ForeignColumnNullable bool
 	ForeignColumnUnique   bool
 }
 
 // ToManyRelationship describes a relationship between two tables where the
 // local table has no id, and the foreign table has an id that matches a column
-// in the local table.
+// in the local table. Columns and ForeignColumns hold every column of a
+// composite key, join tables are only made up of single column keys.
 type ToManyRelationship struct {
 	Table    string
 	Column   string
+	Columns  []string
 	Nullable bool
 	Unique   bool
 
 	ForeignTable          string
 	ForeignColumn         string
+	ForeignColumns        []string
 	ForeignColumnNullable bool
 	ForeignColumnUnique   bool
 
@@ -89,11 +95,13 @@ func buildToOneRelationship(localTable Table, foreignKey ForeignKey, foreignTabl
 	return ToOneRelationship{
 		Table:    localTable.Name,
 		Column:   foreignKey.ForeignColumn,
+		Columns:  foreignKey.ForeignColumns,
 		Nullable: foreignKey.ForeignColumnNullable,
 		Unique:   foreignKey.ForeignColumnUnique,
 
 		ForeignTable:          foreignTable.Name,
 		ForeignColumn:         foreignKey.Column,
+		ForeignColumns:        foreignKey.Columns,
 		ForeignColumnNullable: foreignKey.Nullable,
 		ForeignColumnUnique:   foreignKey.Unique,
 	}
@@ -104,10 +112,12 @@ func buildToManyRelationship(localTable Table, foreignKey ForeignKey, foreignTab
 		return ToManyRelationship{
 			Table:                 localTable.Name,
 			Column:                foreignKey.ForeignColumn,
+			Columns:               foreignKey.ForeignColumns,
 			Nullable:              foreignKey.ForeignColumnNullable,
 			Unique:                foreignKey.ForeignColumnUnique,
 			ForeignTable:          foreignTable.Name,
 			ForeignColumn:         foreignKey.Column,
+			ForeignColumns:        foreignKey.Columns,
 			ForeignColumnNullable: foreignKey.Nullable,
 			ForeignColumnUnique:   foreignKey.Unique,
 			ToJoinTable:           false,
@@ -117,6 +127,7 @@ func buildToManyRelationship(localTable Table, foreignKey ForeignKey, foreignTab
 	relationship := ToManyRelationship{
 		Table:    localTable.Name,
 		Column:   foreignKey.ForeignColumn,
+		Columns:  foreignKey.ForeignColumns,
 		Nullable: foreignKey.ForeignColumnNullable,
 		Unique:   foreignKey.ForeignColumnUnique,
 
@@ -139,6 +150,7 @@ func buildToManyRelationship(localTable Table, foreignKey ForeignKey, foreignTab
 
 		relationship.ForeignTable = fk.ForeignTable
 		relationship.ForeignColumn = fk.ForeignColumn
+		relationship.ForeignColumns = fk.ForeignColumns
 		relationship.ForeignColumnNullable = fk.ForeignColumnNullable
 		relationship.ForeignColumnUnique = fk.ForeignColumnUnique
 	}
@@ -4,17 +4,32 @@ import "fmt"
 
 // Table metadata from the database schema.
 type Table struct {
+	// Name identifies the table in generated code. It is the name of the
+	// table unless a table of the same name exists in more than one of the
+	// generated schemas, then it is prefixed with the schema: "schema_table".
 	Name string
+	// SQLName is the name of the table in the database
+	SQLName string
 	// For dbs with real schemas, like Postgres.
 	// Example value: "schema_name"."table_name"
 	SchemaName string
 	Columns    []Column
+	// Comment is the comment on the table in the database, if any
+	Comment string
 
 	PKey  *PrimaryKey
 	FKeys []ForeignKey
+	// UKeys are the unique keys of the table other than the primary key,
+	// no two of them have the same set of columns.
+	UKeys []UniqueKey
 
 	IsJoinTable bool
 
+	// IsView is set for views and materialized views, which are read only.
+	// They have no keys unless they were configured as virtual keys.
+	IsView             bool
+	IsMaterializedView bool
+
 	ToOneRelationships  []ToOneRelationship
 	ToManyRelationships []ToManyRelationship
 }
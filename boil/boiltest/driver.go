@@ -0,0 +1,134 @@
+package boiltest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const driverName = "boiltest"
+
+// executors are the open executors by the data source name their database
+// was opened with, the driver's connections run statements on them.
+var (
+	executorsMu    sync.Mutex
+	executorsCount int
+	executors      = map[string]*Executor{}
+)
+
+func init() {
+	sql.Register(driverName, fakeDriver{})
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	executorsMu.Lock()
+	e, ok := executors[name]
+	executorsMu.Unlock()
+
+	if !ok {
+		return nil, errors.Errorf("boiltest: no executor %q, executors are created with New", name)
+	}
+
+	return conn{e: e}, nil
+}
+
+type conn struct {
+	e *Executor
+}
+
+func (c conn) Prepare(query string) (driver.Stmt, error) {
+	return stmt{e: c.e, query: query}, nil
+}
+
+func (c conn) Close() error {
+	return nil
+}
+
+func (c conn) Begin() (driver.Tx, error) {
+	return tx{}, nil
+}
+
+type tx struct{}
+
+func (tx) Commit() error   { return nil }
+func (tx) Rollback() error { return nil }
+
+type stmt struct {
+	e     *Executor
+	query string
+}
+
+func (s stmt) Close() error {
+	return nil
+}
+
+// NumInput returns -1 as statements take any number of arguments
+func (s stmt) NumInput() int {
+	return -1
+}
+
+func (s stmt) Exec(args []driver.Value) (driver.Result, error) {
+	x, err := s.e.run(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	return x.result, nil
+}
+
+func (s stmt) Query(args []driver.Value) (driver.Rows, error) {
+	x, err := s.e.run(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	return &rows{columns: x.columns, values: x.rows}, nil
+}
+
+type rows struct {
+	columns []string
+	values  [][]driver.Value
+	next    int
+}
+
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
+
+type result struct {
+	lastInsertID int64
+	rowsAffected int64
+}
+
+func (r result) LastInsertId() (int64, error) {
+	return r.lastInsertID, nil
+}
+
+func (r result) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
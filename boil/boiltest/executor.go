@@ -0,0 +1,317 @@
+// Package boiltest provides a boil.Executor for unit tests that records the
+// statements run on it and answers them from expectations instead of a
+// database, so code built on the generated models can be tested offline.
+package boiltest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/curvegrid/sqlboiler/queries"
+	"github.com/pkg/errors"
+)
+
+// Call is a statement run on an Executor. Args are the arguments as the
+// driver sees them: integers are int64, and Valuers such as the null types
+// have been replaced by their values.
+type Call struct {
+	SQL  string
+	Args []interface{}
+}
+
+// Executor is a boil.Executor that runs statements against its expectations
+// rather than a database. It is a *sql.DB on a fake driver, so it can also
+// begin transactions, which commit and roll back without doing anything.
+type Executor struct {
+	*sql.DB
+
+	name string
+
+	mu           sync.Mutex
+	calls        []Call
+	expectations []*Expectation
+}
+
+// New creates an Executor without expectations, every statement run on it
+// fails until one is added.
+func New() *Executor {
+	e := &Executor{}
+
+	executorsMu.Lock()
+	executorsCount++
+	e.name = strconv.Itoa(executorsCount)
+	executors[e.name] = e
+	executorsMu.Unlock()
+
+	db, err := sql.Open(driverName, e.name)
+	if err != nil {
+		panic(err)
+	}
+	e.DB = db
+
+	return e
+}
+
+// Close closes the database and forgets the executor
+func (e *Executor) Close() error {
+	executorsMu.Lock()
+	delete(executors, e.name)
+	executorsMu.Unlock()
+
+	return e.DB.Close()
+}
+
+// Calls returns the statements run so far, in the order they were run
+func (e *Executor) Calls() []Call {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	calls := make([]Call, len(e.calls))
+	copy(calls, e.calls)
+	return calls
+}
+
+// Expect adds an expectation for the statements that are query once runs of
+// whitespace and a trailing semicolon are ignored.
+func (e *Executor) Expect(query string) *Expectation {
+	return e.expect(&Expectation{sql: normalize(query)})
+}
+
+// ExpectRegexp adds an expectation for the statements that match pattern
+func (e *Executor) ExpectRegexp(pattern string) *Expectation {
+	return e.expect(&Expectation{rgx: regexp.MustCompile(pattern)})
+}
+
+func (e *Executor) expect(x *Expectation) *Expectation {
+	x.result = result{}
+
+	e.mu.Lock()
+	e.expectations = append(e.expectations, x)
+	e.mu.Unlock()
+
+	return x
+}
+
+// ExpectationsWereMet returns an error if an expectation has not matched any
+// statement yet.
+func (e *Executor) ExpectationsWereMet() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, x := range e.expectations {
+		if x.used == 0 {
+			return errors.Errorf("boiltest: no statement matched the expectation for %s", x)
+		}
+	}
+
+	return nil
+}
+
+// run records a statement and returns the expectation that answers it, the
+// first one added that matches it and has not been used up.
+func (e *Executor) run(query string, args []driver.Value) (*Expectation, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	call := Call{SQL: query, Args: make([]interface{}, len(args))}
+	for i, a := range args {
+		call.Args[i] = a
+	}
+	e.calls = append(e.calls, call)
+
+	for _, x := range e.expectations {
+		if x.once && x.used != 0 {
+			continue
+		}
+		if !x.matches(query, args) {
+			continue
+		}
+
+		x.used++
+		return x, nil
+	}
+
+	return nil, errors.Errorf("boiltest: no expectation matches %s %v", query, call.Args)
+}
+
+// Expectation answers the statements that match it, as many times as they
+// are run unless Once is called.
+type Expectation struct {
+	sql  string
+	rgx  *regexp.Regexp
+	args []driver.Value
+	once bool
+	used int
+
+	columns []string
+	rows    [][]driver.Value
+	result  driver.Result
+	err     error
+}
+
+func (x *Expectation) String() string {
+	if x.rgx != nil {
+		return x.rgx.String()
+	}
+	return x.sql
+}
+
+// WithArgs restricts the expectation to statements run with args
+func (x *Expectation) WithArgs(args ...interface{}) *Expectation {
+	x.args = make([]driver.Value, len(args))
+	for i, a := range args {
+		v, err := driver.DefaultParameterConverter.ConvertValue(a)
+		if err != nil {
+			x.err = errors.Wrapf(err, "boiltest: unable to convert argument %d", i)
+			continue
+		}
+		x.args[i] = v
+	}
+
+	return x
+}
+
+// Once makes the expectation answer a single statement, so the next one
+// matching it falls through to the expectations added after it.
+func (x *Expectation) Once() *Expectation {
+	x.once = true
+	return x
+}
+
+// ReturnRows makes queries matching the expectation return rows of the
+// given columns.
+func (x *Expectation) ReturnRows(columns []string, rows ...[]interface{}) *Expectation {
+	x.columns = columns
+	x.rows = nil
+	for _, row := range rows {
+		if err := x.addRow(row); err != nil {
+			x.err = err
+		}
+	}
+
+	return x
+}
+
+// ReturnModels makes queries matching the expectation return a row for each
+// of models, which is a model, a pointer to one or a slice of either. The
+// rows hold the given columns, or all the columns of the model if none are
+// given, read with the same struct mappings the generated code binds with.
+func (x *Expectation) ReturnModels(models interface{}, columns ...string) *Expectation {
+	val := reflect.Indirect(reflect.ValueOf(models))
+	var objs []reflect.Value
+	if val.Kind() == reflect.Slice {
+		for i := 0; i < val.Len(); i++ {
+			objs = append(objs, reflect.Indirect(val.Index(i)))
+		}
+	} else {
+		objs = append(objs, val)
+	}
+
+	if len(objs) == 0 {
+		x.columns, x.rows = columns, nil
+		return x
+	}
+
+	typ := objs[0].Type()
+	if len(columns) == 0 {
+		columns = columnsOf(typ)
+	}
+
+	mapping, err := queries.BindMapping(typ, queries.MakeStructMapping(typ), columns)
+	if err != nil {
+		x.err = errors.Wrap(err, "boiltest: unable to map model columns")
+		return x
+	}
+
+	x.columns, x.rows = columns, nil
+	for _, obj := range objs {
+		if err := x.addRow(queries.ValuesFromMapping(obj, mapping)); err != nil {
+			x.err = err
+		}
+	}
+
+	return x
+}
+
+// ReturnResult makes statements matching the expectation, when run with
+// Exec, report the given last insert id and number of rows affected.
+func (x *Expectation) ReturnResult(lastInsertID, rowsAffected int64) *Expectation {
+	x.result = result{lastInsertID: lastInsertID, rowsAffected: rowsAffected}
+	return x
+}
+
+// ReturnError makes statements matching the expectation fail with err
+func (x *Expectation) ReturnError(err error) *Expectation {
+	x.err = err
+	return x
+}
+
+func (x *Expectation) addRow(row []interface{}) error {
+	if len(row) != len(x.columns) {
+		return errors.Errorf("boiltest: row has %d values for %d columns", len(row), len(x.columns))
+	}
+
+	values := make([]driver.Value, len(row))
+	for i, v := range row {
+		converted, err := driver.DefaultParameterConverter.ConvertValue(v)
+		if err != nil {
+			return errors.Wrapf(err, "boiltest: unable to convert value of column %s", x.columns[i])
+		}
+		values[i] = converted
+	}
+
+	x.rows = append(x.rows, values)
+	return nil
+}
+
+func (x *Expectation) matches(query string, args []driver.Value) bool {
+	if x.rgx != nil {
+		if !x.rgx.MatchString(query) {
+			return false
+		}
+	} else if normalize(query) != x.sql {
+		return false
+	}
+
+	if x.args == nil {
+		return true
+	}
+	if len(x.args) != len(args) {
+		return false
+	}
+	for i := range args {
+		if !reflect.DeepEqual(x.args[i], args[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var rgxWhitespace = regexp.MustCompile(`\s+`)
+
+// normalize collapses runs of whitespace and drops a trailing semicolon
+func normalize(query string) string {
+	query = strings.TrimSpace(rgxWhitespace.ReplaceAllString(query, " "))
+	return strings.TrimSpace(strings.TrimSuffix(query, ";"))
+}
+
+// columnsOf returns the columns of a model, named by the boil tags of its
+// fields
+func columnsOf(typ reflect.Type) []string {
+	var columns []string
+	for i := 0; i < typ.NumField(); i++ {
+		tag := typ.Field(i).Tag.Get("boil")
+		if len(tag) == 0 || tag == "-" || strings.IndexByte(tag, ',') >= 0 {
+			continue
+		}
+		columns = append(columns, tag)
+	}
+
+	return columns
+}
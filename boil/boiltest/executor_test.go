@@ -0,0 +1,116 @@
+package boiltest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/curvegrid/sqlboiler/boil"
+	"github.com/curvegrid/sqlboiler/queries"
+	"github.com/pkg/errors"
+)
+
+type pilot struct {
+	ID   int    `boil:"id"`
+	Name string `boil:"name"`
+	Rank string `boil:"rank"`
+
+	R *struct{} `boil:"-"`
+}
+
+func TestExpectModels(t *testing.T) {
+	t.Parallel()
+
+	e := New()
+	defer e.Close()
+
+	e.Expect(`SELECT * FROM "pilots" WHERE "id"=$1`).WithArgs(5).
+		ReturnModels(&pilot{ID: 5, Name: "Hogan", Rank: "captain"})
+	e.ExpectRegexp(`^SELECT "id", "name" FROM "pilots"`).
+		ReturnModels([]*pilot{{ID: 1, Name: "Maverick"}, {ID: 2, Name: "Goose"}}, "id", "name")
+
+	var one pilot
+	if err := queries.Raw(e, "SELECT *\n  FROM \"pilots\"  WHERE \"id\"=$1;", 5).Bind(&one); err != nil {
+		t.Fatal(err)
+	}
+	if want := (pilot{ID: 5, Name: "Hogan", Rank: "captain"}); one != want {
+		t.Errorf("want: %#v, got: %#v", want, one)
+	}
+
+	var all []*pilot
+	if err := queries.Raw(e, `SELECT "id", "name" FROM "pilots";`).Bind(&all); err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].Name != "Maverick" || all[1].ID != 2 || all[1].Rank != "" {
+		t.Errorf("wrong pilots: %#v %#v", all[0], all[1])
+	}
+
+	if err := queries.Raw(e, `SELECT * FROM "pilots" WHERE "id"=$1`, 6).Bind(&one); err == nil {
+		t.Error("want an error for arguments the expectation does not match")
+	}
+
+	if err := e.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExpectExec(t *testing.T) {
+	t.Parallel()
+
+	e := New()
+	defer e.Close()
+
+	fail := errors.New("fail")
+	e.ExpectRegexp(`^UPDATE "pilots"`).Once().ReturnResult(0, 2)
+	e.ExpectRegexp(`^UPDATE "pilots"`).ReturnError(fail)
+	e.Expect(`DELETE FROM "jets"`)
+
+	result, err := e.Exec(`UPDATE "pilots" SET "rank"=$1 WHERE "id"=$2`, "major", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if affected, _ := result.RowsAffected(); affected != 2 {
+		t.Error("want 2 rows affected, got:", affected)
+	}
+
+	if _, err = e.Exec(`UPDATE "pilots" SET "rank"=$1`, "major"); err != fail {
+		t.Error("want the next expectation's error, got:", err)
+	}
+
+	if _, err = e.Exec(`INSERT INTO "jets" DEFAULT VALUES`); err == nil {
+		t.Error("want an error for a statement without an expectation")
+	}
+
+	want := []Call{
+		{SQL: `UPDATE "pilots" SET "rank"=$1 WHERE "id"=$2`, Args: []interface{}{"major", int64(3)}},
+		{SQL: `UPDATE "pilots" SET "rank"=$1`, Args: []interface{}{"major"}},
+		{SQL: `INSERT INTO "jets" DEFAULT VALUES`, Args: []interface{}{}},
+	}
+	if got := e.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %#v, got: %#v", want, got)
+	}
+
+	if err = e.ExpectationsWereMet(); err == nil {
+		t.Error("want the unused delete expectation reported")
+	}
+}
+
+func TestExecutorTransact(t *testing.T) {
+	t.Parallel()
+
+	e := New()
+	defer e.Close()
+
+	e.Expect(`DELETE FROM "jets"`).ReturnResult(0, 4)
+
+	err := boil.Transact(e, func(tx boil.Transactor) error {
+		_, err := tx.Exec(`DELETE FROM "jets"`)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls := e.Calls(); len(calls) != 1 || calls[0].SQL != `DELETE FROM "jets"` {
+		t.Error("want the delete recorded, got:", calls)
+	}
+}
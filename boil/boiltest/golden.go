@@ -0,0 +1,70 @@
+package boiltest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool(
+	"boiltest.golden",
+	false,
+	"Write the golden files compared by boiltest.Golden instead of comparing them.",
+)
+
+// Golden compares got with the contents of the golden file at path, ignoring
+// surrounding whitespace, and fails t if they differ. The file is only
+// written, with got, when the tests are run with -boiltest.golden, so
+// snapshots are recorded and updated on request; t is skipped until then.
+func Golden(t testing.TB, path, got string) {
+	got = strings.TrimSpace(got)
+
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unable to create the directory of golden file %s: %s", path, err)
+		}
+		if err := ioutil.WriteFile(path, []byte(got+"\n"), 0664); err != nil {
+			t.Fatalf("unable to write golden file %s: %s", path, err)
+		}
+		t.Logf("wrote golden file: %s", path)
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		t.Skipf("%s: golden file missing, run with -boiltest.golden", path)
+		return
+	}
+	if err != nil {
+		t.Fatalf("unable to read golden file %s: %s", path, err)
+		return
+	}
+
+	if string(bytes.TrimSpace(want)) != got {
+		t.Errorf("%s does not match, run the tests with -boiltest.golden to update it\nwant:\n%s\ngot:\n%s", path, want, got)
+	}
+}
+
+// GoldenQuery is Golden for a statement and its arguments, such as those
+// returned by queries.Render, with the arguments written after the statement
+// one per line.
+func GoldenQuery(t testing.TB, path, query string, args []interface{}) {
+	Golden(t, path, FormatQuery(query, args))
+}
+
+// FormatQuery writes a statement and its arguments the way GoldenQuery
+// stores them.
+func FormatQuery(query string, args []interface{}) string {
+	buf := &bytes.Buffer{}
+	buf.WriteString(strings.TrimSpace(query))
+	for i, a := range args {
+		fmt.Fprintf(buf, "\n-- %d: %#v", i+1, a)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,91 @@
+package boiltest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// recordingTB records the failures and skips reported to it instead of
+// failing or skipping the test
+type recordingTB struct {
+	testing.TB
+	failures []string
+	skips    []string
+}
+
+func (r *recordingTB) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+}
+
+func (r *recordingTB) Skipf(format string, args ...interface{}) {
+	r.skips = append(r.skips, fmt.Sprintf(format, args...))
+}
+
+func TestGolden(t *testing.T) {
+	dir, err := ioutil.TempDir("", "boiltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "postgres", "pilots.sql")
+	query := FormatQuery(`SELECT * FROM "pilots" WHERE "id"=$1;`, []interface{}{5})
+
+	r := &recordingTB{TB: t}
+	Golden(r, path, query)
+	if len(r.failures) != 0 || len(r.skips) != 1 || !strings.Contains(r.skips[0], "golden file missing, run with -boiltest.golden") {
+		t.Error("want a skip for the missing golden file, got:", r.failures, r.skips)
+	}
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Error("did not want the golden file written without -boiltest.golden")
+	}
+	r.failures = nil
+
+	*updateGolden = true
+	Golden(t, path, query)
+	*updateGolden = false
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "SELECT * FROM \"pilots\" WHERE \"id\"=$1;\n-- 1: 5\n"; string(b) != want {
+		t.Errorf("want: %q, got: %q", want, b)
+	}
+
+	GoldenQuery(r, path, "\n"+`SELECT * FROM "pilots" WHERE "id"=$1;`, []interface{}{5})
+	if len(r.failures) != 0 {
+		t.Error("want the query to match, got:", r.failures)
+	}
+
+	GoldenQuery(r, path, `SELECT * FROM "pilots" WHERE "id"=$1;`, []interface{}{6})
+	if len(r.failures) != 1 {
+		t.Error("want a failure for different arguments, got:", r.failures)
+	}
+
+	*updateGolden = true
+	GoldenQuery(r, path, `SELECT * FROM "pilots" WHERE "id"=$1;`, []interface{}{6})
+	*updateGolden = false
+	GoldenQuery(r, path, `SELECT * FROM "pilots" WHERE "id"=$1;`, []interface{}{6})
+	if len(r.failures) != 1 {
+		t.Error("want the golden file updated, got:", r.failures)
+	}
+}
+
+func TestFormatQuery(t *testing.T) {
+	t.Parallel()
+
+	got := FormatQuery("DELETE FROM `jets` WHERE `id`=? AND `name`=?; ", []interface{}{int64(3), "Bomber"})
+	want := "DELETE FROM `jets` WHERE `id`=? AND `name`=?;\n-- 1: 3\n-- 2: \"Bomber\""
+	if got != want {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
@@ -0,0 +1,152 @@
+package boil
+
+import "sync"
+
+// txCallbacks are the callbacks registered for a transaction or savepoint
+type txCallbacks struct {
+	mu       sync.Mutex
+	commit   []func()
+	rollback []func()
+}
+
+func (c *txCallbacks) add(commit bool, fn func()) {
+	c.mu.Lock()
+	if commit {
+		c.commit = append(c.commit, fn)
+	} else {
+		c.rollback = append(c.rollback, fn)
+	}
+	c.mu.Unlock()
+}
+
+// end runs the commit or the rollback callbacks in the order they were
+// registered, and forgets all of them
+func (c *txCallbacks) end(committed bool) {
+	c.mu.Lock()
+	fns := c.rollback
+	if committed {
+		fns = c.commit
+	}
+	c.commit, c.rollback = nil, nil
+	c.mu.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// merge moves the callbacks of a released savepoint to the transaction
+func (c *txCallbacks) merge(savepoint *txCallbacks) {
+	savepoint.mu.Lock()
+	commit, rollback := savepoint.commit, savepoint.rollback
+	savepoint.commit, savepoint.rollback = nil, nil
+	savepoint.mu.Unlock()
+
+	c.mu.Lock()
+	c.commit = append(c.commit, commit...)
+	c.rollback = append(c.rollback, rollback...)
+	c.mu.Unlock()
+}
+
+// callbackTracker is implemented by the transactions that collect the
+// callbacks registered with AfterCommit and AfterRollback
+type callbackTracker interface {
+	callbacks() *txCallbacks
+}
+
+// CallbackTx is a Transactor that runs the callbacks registered on it with
+// AfterCommit once it commits, and those registered with AfterRollback once it
+// rolls back or fails to commit. The transactions handed out by Transact
+// collect callbacks the same way.
+type CallbackTx struct {
+	Transactor
+
+	cbs txCallbacks
+}
+
+// NewCallbackTx wraps tx to collect callbacks
+func NewCallbackTx(tx Transactor) *CallbackTx {
+	return &CallbackTx{Transactor: tx}
+}
+
+// BeginWithCallbacks begins a transaction on the global database that
+// collects callbacks
+func BeginWithCallbacks() (*CallbackTx, error) {
+	tx, err := Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCallbackTx(tx), nil
+}
+
+// Commit commits the transaction, then runs the AfterCommit callbacks if it
+// succeeded and the AfterRollback callbacks if it did not.
+func (t *CallbackTx) Commit() error {
+	err := t.Transactor.Commit()
+	t.cbs.end(err == nil)
+	return err
+}
+
+// Rollback rolls the transaction back, then runs the AfterRollback callbacks
+func (t *CallbackTx) Rollback() error {
+	err := t.Transactor.Rollback()
+	t.cbs.end(false)
+	return err
+}
+
+func (t *CallbackTx) callbacks() *txCallbacks {
+	return &t.cbs
+}
+
+func (t *CallbackTx) unwrap() Executor {
+	return t.Transactor
+}
+
+// AfterCommit registers fn to run once the transaction exec belongs to
+// commits, exec being or wrapping a CallbackTx or a transaction handed out by
+// Transact. If exec is a database, fn is run right away, as statements run on
+// it are committed as they go. Other transactions, such as a *sql.Tx from
+// Begin, cannot be followed and fn is never run, begin them with
+// BeginWithCallbacks or wrap them with NewCallbackTx instead.
+func AfterCommit(exec Executor, fn func()) {
+	if cbs := callbacksOf(exec); cbs != nil {
+		cbs.add(true, fn)
+		return
+	}
+
+	if !inTransaction(exec) {
+		fn()
+	}
+}
+
+// AfterRollback registers fn to run once the transaction exec belongs to
+// rolls back, exec being or wrapping a CallbackTx or a transaction handed out
+// by Transact. Otherwise, fn is never run.
+func AfterRollback(exec Executor, fn func()) {
+	if cbs := callbacksOf(exec); cbs != nil {
+		cbs.add(false, fn)
+	}
+}
+
+// inTransaction reports whether exec is or wraps a transaction
+func inTransaction(exec Executor) bool {
+	return walkExecutors(exec, func(e Executor) bool {
+		_, ok := e.(Transactor)
+		return ok
+	})
+}
+
+// callbacksOf returns the callbacks of the transaction exec is or wraps, or
+// nil if it does not collect callbacks
+func callbacksOf(exec Executor) *txCallbacks {
+	var cbs *txCallbacks
+	walkExecutors(exec, func(e Executor) bool {
+		if t, ok := e.(callbackTracker); ok {
+			cbs = t.callbacks()
+		}
+		return cbs != nil
+	})
+
+	return cbs
+}
@@ -0,0 +1,180 @@
+package boil
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestCallbackTx(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectCommit().WillReturnError(errors.New("fail"))
+
+	var ran []string
+	record := func(s string) func() {
+		return func() { ran = append(ran, s) }
+	}
+
+	for i, end := range []string{"commit", "rollback", "failed commit"} {
+		sqlTx, err := db.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tx := NewCallbackTx(sqlTx)
+		AfterCommit(WithSchema(tx, "tenant_a"), record("commit 1"))
+		AfterRollback(tx, record("rollback"))
+		AfterCommit(SkipHooks(tx), record("commit 2"))
+		if len(ran) != 0 {
+			t.Errorf("%d) want no callbacks run before the transaction ends, got: %v", i, ran)
+		}
+
+		if end == "rollback" {
+			err = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+		if (err != nil) != (end == "failed commit") {
+			t.Errorf("%d) unexpected error: %v", i, err)
+		}
+
+		want := []string{"rollback"}
+		if end == "commit" {
+			want = []string{"commit 1", "commit 2"}
+		}
+		if !reflect.DeepEqual(ran, want) {
+			t.Errorf("%d) want: %v, got: %v", i, want, ran)
+		}
+		ran = nil
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAfterCommitWithoutTransaction(t *testing.T) {
+	t.Parallel()
+
+	committed, rolledBack := false, false
+	AfterCommit(&sql.DB{}, func() { committed = true })
+	AfterRollback(&sql.DB{}, func() { rolledBack = true })
+
+	if !committed {
+		t.Error("want the commit callback run right away")
+	}
+	if rolledBack {
+		t.Error("want the rollback callback never run")
+	}
+}
+
+func TestAfterCommitInTransaction(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	committed, rolledBack := false, false
+	AfterCommit(WithSchema(tx, "tenant_a"), func() { committed = true })
+	AfterRollback(tx, func() { rolledBack = true })
+
+	if err = tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if committed || rolledBack {
+		t.Error("want the callbacks of a transaction that does not collect them dropped")
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransactCallbacks(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT boil_savepoint_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT boil_savepoint_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	var ran []string
+	record := func(s string) func() {
+		return func() { ran = append(ran, s) }
+	}
+
+	err = Transact(db, func(tx Transactor) error {
+		AfterCommit(tx, record("outer commit"))
+
+		_ = Transact(tx, func(nested Transactor) error {
+			AfterCommit(nested, record("failed savepoint commit"))
+			AfterRollback(nested, record("failed savepoint rollback"))
+			return errors.New("fail")
+		})
+
+		err := Transact(tx, func(nested Transactor) error {
+			AfterCommit(nested, record("savepoint commit"))
+			AfterRollback(nested, record("savepoint rollback"))
+			return nil
+		})
+
+		if want := []string{"failed savepoint rollback"}; !reflect.DeepEqual(ran, want) {
+			t.Errorf("want: %v, got: %v", want, ran)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"failed savepoint rollback", "outer commit", "savepoint commit"}; !reflect.DeepEqual(ran, want) {
+		t.Errorf("want: %v, got: %v", want, ran)
+	}
+
+	ran = nil
+	_ = Transact(db, func(tx Transactor) error {
+		AfterCommit(tx, record("commit"))
+		AfterRollback(tx, record("rollback"))
+		return errors.New("fail")
+	})
+	if want := []string{"rollback"}; !reflect.DeepEqual(ran, want) {
+		t.Errorf("want: %v, got: %v", want, ran)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,301 @@
+package boil
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// DBError holds what the driver reported about a statement that violated a
+// constraint or conflicted with another transaction. Constraint, Table and
+// Columns are filled in as far as the driver's error tells them.
+type DBError struct {
+	Constraint string
+	Table      string
+	Columns    []string
+
+	// Err is the error returned by the driver
+	Err error
+}
+
+// Error returns the driver's error message
+func (e *DBError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the driver's error
+func (e *DBError) Unwrap() error {
+	return e.Err
+}
+
+// UniqueViolation is returned when a statement would duplicate a unique key
+type UniqueViolation struct{ DBError }
+
+// ForeignKeyViolation is returned when a statement references a missing row,
+// or removes a row that is still referenced
+type ForeignKeyViolation struct{ DBError }
+
+// NotNullViolation is returned when a statement sets a NOT NULL column to null
+type NotNullViolation struct{ DBError }
+
+// CheckViolation is returned when a statement fails a check constraint
+type CheckViolation struct{ DBError }
+
+// SerializationFailure is returned when a statement failed because it
+// conflicted with another transaction, such as a serialization failure or a
+// deadlock, and may succeed if its transaction is run again
+type SerializationFailure struct{ DBError }
+
+// ClassifyError returns err as one of the typed errors of this package if it
+// is an error of the postgres, mysql or mssql drivers reporting a constraint
+// violation or serialization failure, and returns err unchanged otherwise.
+// The generated models classify the errors of the statements they run before
+// wrapping them, so errors.Cause returns the typed error:
+//
+//	if v, ok := errors.Cause(err).(*boil.UniqueViolation); ok {
+//	  fmt.Println(v.Constraint, v.Columns)
+//	}
+func ClassifyError(err error) error {
+	var typed error
+	if state, ok := sqlState(err); ok {
+		typed = classifyPostgres(err, state)
+	} else if number, ok := errorNumber(err); ok {
+		if isMSSQLError(err) {
+			typed = classifyMSSQL(err, number)
+		} else {
+			typed = classifyMySQL(err, number)
+		}
+	}
+
+	if typed == nil {
+		return err
+	}
+	return typed
+}
+
+var rgxPostgresKey = regexp.MustCompile(`^Key \((.+?)\)=`)
+
+// classifyPostgres classifies the errors of lib/pq and pgx by their SQLSTATE
+func classifyPostgres(err error, state string) error {
+	e := DBError{
+		Constraint: stringField(err, "Constraint", "ConstraintName"),
+		Table:      stringField(err, "Table", "TableName"),
+		Err:        err,
+	}
+
+	if column := stringField(err, "Column", "ColumnName"); len(column) != 0 {
+		e.Columns = []string{column}
+	} else if m := rgxPostgresKey.FindStringSubmatch(stringField(err, "Detail")); m != nil {
+		e.Columns = strings.Split(m[1], ", ")
+	}
+
+	switch state {
+	case "23505":
+		return &UniqueViolation{e}
+	case "23503":
+		return &ForeignKeyViolation{e}
+	case "23502":
+		return &NotNullViolation{e}
+	case "23514":
+		return &CheckViolation{e}
+	case "40001", "40P01":
+		return &SerializationFailure{e}
+	}
+
+	return nil
+}
+
+var (
+	rgxMySQLDuplicate  = regexp.MustCompile(`for key '([^']+)'`)
+	rgxMySQLForeignKey = regexp.MustCompile("fails \\((?:`[^`]+`\\.)?`([^`]+)`, CONSTRAINT `([^`]+)` FOREIGN KEY \\(([^)]+)\\)")
+	rgxMySQLColumn     = regexp.MustCompile(`^(?:Column|Field) '([^']+)'`)
+	rgxMySQLCheck      = regexp.MustCompile(`^Check constraint '([^']+)'`)
+)
+
+// classifyMySQL classifies the errors of go-sql-driver/mysql by their number
+func classifyMySQL(err error, number int64) error {
+	e := DBError{Err: err}
+	msg := errorMessage(err)
+
+	switch number {
+	case 1062:
+		if m := rgxMySQLDuplicate.FindStringSubmatch(msg); m != nil {
+			// MySQL 8 prefixes the key with its table
+			e.Constraint = m[1]
+			if i := strings.IndexByte(m[1], '.'); i >= 0 {
+				e.Table, e.Constraint = m[1][:i], m[1][i+1:]
+			}
+		}
+		return &UniqueViolation{e}
+	case 1451, 1452:
+		if m := rgxMySQLForeignKey.FindStringSubmatch(msg); m != nil {
+			e.Table, e.Constraint = m[1], m[2]
+			e.Columns = strings.Split(strings.Replace(m[3], "`", "", -1), ", ")
+		}
+		return &ForeignKeyViolation{e}
+	case 1048, 1364:
+		if m := rgxMySQLColumn.FindStringSubmatch(msg); m != nil {
+			e.Columns = []string{m[1]}
+		}
+		return &NotNullViolation{e}
+	case 3819:
+		if m := rgxMySQLCheck.FindStringSubmatch(msg); m != nil {
+			e.Constraint = m[1]
+		}
+		return &CheckViolation{e}
+	case 1205, 1213:
+		return &SerializationFailure{e}
+	}
+
+	return nil
+}
+
+var (
+	rgxMSSQLUniqueConstraint = regexp.MustCompile(`constraint '([^']+)'\. Cannot insert duplicate key in object '([^']+)'`)
+	rgxMSSQLUniqueIndex      = regexp.MustCompile(`in object '([^']+)' with unique index '([^']+)'`)
+	rgxMSSQLConflict         = regexp.MustCompile(`conflicted with the (FOREIGN KEY|REFERENCE|CHECK) constraint "([^"]+)"`)
+	rgxMSSQLConflictTable    = regexp.MustCompile(`table "([^"]+)"`)
+	rgxMSSQLConflictColumn   = regexp.MustCompile(`column '([^']+)'`)
+	rgxMSSQLNull             = regexp.MustCompile(`into column '([^']+)', table '([^']+)'`)
+)
+
+// classifyMSSQL classifies the errors of denisenkom/go-mssqldb by their number
+func classifyMSSQL(err error, number int64) error {
+	e := DBError{Err: err}
+	msg := errorMessage(err)
+
+	switch number {
+	case 2627:
+		if m := rgxMSSQLUniqueConstraint.FindStringSubmatch(msg); m != nil {
+			e.Constraint, e.Table = m[1], unqualified(m[2])
+		}
+		return &UniqueViolation{e}
+	case 2601:
+		if m := rgxMSSQLUniqueIndex.FindStringSubmatch(msg); m != nil {
+			e.Table, e.Constraint = unqualified(m[1]), m[2]
+		}
+		return &UniqueViolation{e}
+	case 547:
+		m := rgxMSSQLConflict.FindStringSubmatch(msg)
+		if m == nil {
+			return nil
+		}
+
+		e.Constraint = m[2]
+		if t := rgxMSSQLConflictTable.FindStringSubmatch(msg); t != nil {
+			e.Table = unqualified(t[1])
+		}
+		if c := rgxMSSQLConflictColumn.FindStringSubmatch(msg); c != nil {
+			e.Columns = []string{c[1]}
+		}
+
+		if m[1] == "CHECK" {
+			return &CheckViolation{e}
+		}
+		return &ForeignKeyViolation{e}
+	case 515:
+		if m := rgxMSSQLNull.FindStringSubmatch(msg); m != nil {
+			e.Columns, e.Table = []string{m[1]}, unqualified(m[2])
+		}
+		return &NotNullViolation{e}
+	case 1205, 3960:
+		return &SerializationFailure{e}
+	}
+
+	return nil
+}
+
+// unqualified strips the database and schema from an MSSQL object name
+func unqualified(name string) string {
+	return name[strings.LastIndexByte(name, '.')+1:]
+}
+
+// sqlState returns the SQLSTATE of postgres errors, which have a SQLState
+// method or a string Code field.
+func sqlState(err error) (string, bool) {
+	if e, ok := err.(interface {
+		SQLState() string
+	}); ok {
+		return e.SQLState(), true
+	}
+
+	if f, ok := errorField(err, "Code"); ok && f.Kind() == reflect.String {
+		return f.String(), true
+	}
+
+	return "", false
+}
+
+// errorNumber returns the error number of mysql and mssql errors, which have
+// a SQLErrorNumber method or an integer Number field.
+func errorNumber(err error) (int64, bool) {
+	if e, ok := err.(interface {
+		SQLErrorNumber() int32
+	}); ok {
+		return int64(e.SQLErrorNumber()), true
+	}
+
+	f, ok := errorField(err, "Number")
+	if !ok {
+		return 0, false
+	}
+
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return f.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(f.Uint()), true
+	}
+
+	return 0, false
+}
+
+// isMSSQLError tells the errors of the mssql driver apart from mysql's, both
+// of which are numbered.
+func isMSSQLError(err error) bool {
+	if _, ok := err.(interface {
+		SQLErrorNumber() int32
+	}); ok {
+		return true
+	}
+
+	return strings.Contains(reflect.TypeOf(err).String(), "mssql")
+}
+
+// errorMessage returns the message of a driver error without the prefix some
+// drivers add to Error()
+func errorMessage(err error) string {
+	if e, ok := err.(interface {
+		SQLErrorMessage() string
+	}); ok {
+		return e.SQLErrorMessage()
+	}
+
+	if msg := stringField(err, "Message"); len(msg) != 0 {
+		return msg
+	}
+
+	return err.Error()
+}
+
+// stringField returns the first of the named string fields err has
+func stringField(err error, names ...string) string {
+	for _, name := range names {
+		if f, ok := errorField(err, name); ok && f.Kind() == reflect.String {
+			return f.String()
+		}
+	}
+
+	return ""
+}
+
+// errorField returns the exported field of the struct err is or points to
+func errorField(err error, name string) (reflect.Value, bool) {
+	v := reflect.Indirect(reflect.ValueOf(err))
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	f := v.FieldByName(name)
+	return f, f.IsValid()
+}
@@ -0,0 +1,165 @@
+package boil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+type pqError struct {
+	Code       string
+	Message    string
+	Detail     string
+	Table      string
+	Column     string
+	Constraint string
+}
+
+func (p *pqError) Error() string { return "pq: " + p.Message }
+
+type mysqlError struct {
+	Number  uint16
+	Message string
+}
+
+func (m *mysqlError) Error() string { return "Error: " + m.Message }
+
+type mssqlError struct {
+	number  int32
+	message string
+}
+
+func (m mssqlError) Error() string           { return "mssql: " + m.message }
+func (m mssqlError) SQLErrorNumber() int32   { return m.number }
+func (m mssqlError) SQLErrorMessage() string { return m.message }
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Err  error
+		Want error
+	}{
+		{
+			&pqError{Code: "23505", Table: "pilots", Constraint: "pilots_name_key", Detail: "Key (name, team)=(Hogan, a) already exists."},
+			&UniqueViolation{DBError{Constraint: "pilots_name_key", Table: "pilots", Columns: []string{"name", "team"}}},
+		},
+		{
+			&pqError{Code: "23503", Table: "jets", Constraint: "jets_pilot_id_fkey", Detail: `Key (pilot_id)=(5) is not present in table "pilots".`},
+			&ForeignKeyViolation{DBError{Constraint: "jets_pilot_id_fkey", Table: "jets", Columns: []string{"pilot_id"}}},
+		},
+		{
+			&pqError{Code: "23502", Table: "pilots", Column: "name"},
+			&NotNullViolation{DBError{Table: "pilots", Columns: []string{"name"}}},
+		},
+		{
+			&pqError{Code: "23514", Table: "jets", Constraint: "jets_age_check"},
+			&CheckViolation{DBError{Constraint: "jets_age_check", Table: "jets"}},
+		},
+		{
+			&pqError{Code: "40001"},
+			&SerializationFailure{},
+		},
+		{
+			&mysqlError{Number: 1062, Message: "Duplicate entry 'Hogan' for key 'pilots.name'"},
+			&UniqueViolation{DBError{Constraint: "name", Table: "pilots"}},
+		},
+		{
+			&mysqlError{Number: 1062, Message: "Duplicate entry 'Hogan' for key 'name'"},
+			&UniqueViolation{DBError{Constraint: "name"}},
+		},
+		{
+			&mysqlError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails (`db`.`jets`, CONSTRAINT `jets_pilot_id_fkey` FOREIGN KEY (`pilot_id`) REFERENCES `pilots` (`id`))"},
+			&ForeignKeyViolation{DBError{Constraint: "jets_pilot_id_fkey", Table: "jets", Columns: []string{"pilot_id"}}},
+		},
+		{
+			&mysqlError{Number: 1048, Message: "Column 'name' cannot be null"},
+			&NotNullViolation{DBError{Columns: []string{"name"}}},
+		},
+		{
+			&mysqlError{Number: 3819, Message: "Check constraint 'jets_age_check' is violated."},
+			&CheckViolation{DBError{Constraint: "jets_age_check"}},
+		},
+		{
+			&mysqlError{Number: 1213, Message: "Deadlock found when trying to get lock; try restarting transaction"},
+			&SerializationFailure{},
+		},
+		{
+			mssqlError{number: 2627, message: "Violation of UNIQUE KEY constraint 'UQ_pilots_name'. Cannot insert duplicate key in object 'dbo.pilots'. The duplicate key value is (Hogan)."},
+			&UniqueViolation{DBError{Constraint: "UQ_pilots_name", Table: "pilots"}},
+		},
+		{
+			mssqlError{number: 2601, message: "Cannot insert duplicate key row in object 'dbo.pilots' with unique index 'IX_pilots_name'. The duplicate key value is (Hogan)."},
+			&UniqueViolation{DBError{Constraint: "IX_pilots_name", Table: "pilots"}},
+		},
+		{
+			mssqlError{number: 547, message: `The INSERT statement conflicted with the FOREIGN KEY constraint "FK_jets_pilots". The conflict occurred in database "db", table "dbo.pilots", column 'id'.`},
+			&ForeignKeyViolation{DBError{Constraint: "FK_jets_pilots", Table: "pilots", Columns: []string{"id"}}},
+		},
+		{
+			mssqlError{number: 547, message: `The INSERT statement conflicted with the CHECK constraint "CK_jets_age". The conflict occurred in database "db", table "dbo.jets", column 'age'.`},
+			&CheckViolation{DBError{Constraint: "CK_jets_age", Table: "jets", Columns: []string{"age"}}},
+		},
+		{
+			mssqlError{number: 515, message: "Cannot insert the value NULL into column 'name', table 'db.dbo.pilots'; column does not allow nulls. INSERT fails."},
+			&NotNullViolation{DBError{Table: "pilots", Columns: []string{"name"}}},
+		},
+		{
+			mssqlError{number: 1205, message: "Transaction was deadlocked"},
+			&SerializationFailure{},
+		},
+	}
+
+	for i, test := range tests {
+		got := ClassifyError(test.Err)
+		if reflect.TypeOf(got) != reflect.TypeOf(test.Want) {
+			t.Errorf("%d) want: %T, got: %T", i, test.Want, got)
+			continue
+		}
+
+		// Compare everything but the driver error, which the typed error wraps
+		want := reflect.ValueOf(test.Want).Elem().Field(0).Addr().Interface().(*DBError)
+		gotErr := reflect.ValueOf(got).Elem().Field(0).Addr().Interface().(*DBError)
+		if gotErr.Err != test.Err {
+			t.Errorf("%d) want driver error kept, got: %v", i, gotErr.Err)
+		}
+		want.Err = test.Err
+		if !reflect.DeepEqual(gotErr, want) {
+			t.Errorf("%d) want: %#v, got: %#v", i, want, gotErr)
+		}
+	}
+}
+
+func TestClassifyErrorUnknown(t *testing.T) {
+	t.Parallel()
+
+	for i, err := range []error{
+		errors.New("fail"),
+		&pqError{Code: "42601"},
+		&mysqlError{Number: 1064},
+		mssqlError{number: 102},
+	} {
+		if got := ClassifyError(err); got != err {
+			t.Errorf("%d) want error unchanged, got: %#v", i, got)
+		}
+	}
+}
+
+func TestClassifiedErrorCause(t *testing.T) {
+	t.Parallel()
+
+	driverErr := &pqError{Code: "23505", Message: "duplicate key value violates unique constraint"}
+	err := errors.Wrap(ClassifyError(driverErr), "models: unable to insert into pilots")
+
+	v, ok := errors.Cause(err).(*UniqueViolation)
+	if !ok {
+		t.Fatalf("want a unique violation as the cause, got: %T", errors.Cause(err))
+	}
+	if v.Unwrap() != driverErr || v.Err != driverErr {
+		t.Error("want the driver error kept")
+	}
+	if want := "models: unable to insert into pilots: pq: duplicate key value violates unique constraint"; err.Error() != want {
+		t.Errorf("want: %s, got: %s", want, err.Error())
+	}
+}
@@ -1,6 +1,9 @@
 package boil
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+)
 
 // Executor can perform SQL queries.
 type Executor interface {
@@ -31,3 +34,59 @@ func Begin() (Transactor, error) {
 
 	return creator.Begin()
 }
+
+// wrapper is implemented by the executors of this package that wrap another
+// executor, such as those returned by WithSchema and SkipHooks, so that they
+// can be combined.
+type wrapper interface {
+	unwrap() Executor
+}
+
+// walkExecutors calls fn with exec and each of the executors it wraps in
+// turn until fn returns true, it reports whether fn did.
+func walkExecutors(exec Executor, fn func(Executor) bool) bool {
+	for exec != nil {
+		if fn(exec) {
+			return true
+		}
+
+		w, ok := exec.(wrapper)
+		if !ok {
+			return false
+		}
+		exec = w.unwrap()
+	}
+
+	return false
+}
+
+// BeginnerOf returns the Beginner that exec is or wraps, such as the database
+// given to WithSchema or SkipHooks. It reports false when exec is or wraps a
+// transaction, or nothing it wraps can begin one.
+func BeginnerOf(exec Executor) (Beginner, bool) {
+	var beginner Beginner
+	walkExecutors(exec, func(e Executor) bool {
+		switch t := e.(type) {
+		case wrapper:
+			return false
+		case Transactor:
+		case Beginner:
+			beginner = t
+		}
+		return true
+	})
+
+	return beginner, beginner != nil
+}
+
+// contextOf returns the context of exec if it has a Context() context.Context
+// method, or nil if it does not.
+func contextOf(exec Executor) context.Context {
+	if c, ok := exec.(interface {
+		Context() context.Context
+	}); ok {
+		return c.Context()
+	}
+
+	return nil
+}
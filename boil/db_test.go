@@ -14,3 +14,30 @@ func TestGetSetDB(t *testing.T) {
 		t.Errorf("Expected GetDB to return a database handle, got nil")
 	}
 }
+
+func TestBeginnerOf(t *testing.T) {
+	t.Parallel()
+
+	db := &sql.DB{}
+
+	tests := []struct {
+		Exec Executor
+		OK   bool
+	}{
+		{db, true},
+		{WithSchema(db, "tenant_a"), true},
+		{SkipHooks(WithSchema(db, "tenant_a")), true},
+		{&sql.Tx{}, false},
+		{SkipHooks(WithSchema(&sql.Tx{}, "tenant_a")), false},
+	}
+
+	for i, test := range tests {
+		beginner, ok := BeginnerOf(test.Exec)
+		if ok != test.OK {
+			t.Errorf("%d) want ok: %t, got: %t", i, test.OK, ok)
+		}
+		if ok && beginner != Beginner(db) {
+			t.Errorf("%d) want the wrapped database, got: %#v", i, beginner)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package boil
 
+import "context"
+
 // HookPoint is the point in time at which we hook
 type HookPoint int
 
@@ -14,4 +16,89 @@ const (
 	AfterUpdateHook
 	AfterDeleteHook
 	AfterUpsertHook
+
+	// The bulk hook points run around UpdateAll and DeleteAll
+	BeforeUpdateAllHook
+	AfterUpdateAllHook
+	BeforeDeleteAllHook
+	AfterDeleteAllHook
+
+	// The transaction hook points run once the transaction an object was
+	// inserted, updated, upserted or deleted in commits or rolls back
+	AfterCommitHook
+	AfterRollbackHook
 )
+
+type skipHooksKey struct{}
+
+// skipHooksExecutor is an Executor hooks are not run for
+type skipHooksExecutor struct {
+	Executor
+}
+
+// HooksSkipped reports that hooks are skipped
+func (s skipHooksExecutor) HooksSkipped() bool {
+	return true
+}
+
+func (s skipHooksExecutor) unwrap() Executor {
+	return s.Executor
+}
+
+// skipHooksTransactor is a Transactor hooks are not run for
+type skipHooksTransactor struct {
+	Transactor
+}
+
+// HooksSkipped reports that hooks are skipped
+func (s skipHooksTransactor) HooksSkipped() bool {
+	return true
+}
+
+func (s skipHooksTransactor) unwrap() Executor {
+	return s.Transactor
+}
+
+// SkipHooks returns an executor that runs queries with exec without running
+// the hooks of the generated models, for calls such as data backfills. If
+// exec is a Transactor so is the returned executor.
+func SkipHooks(exec Executor) Executor {
+	if tx, ok := exec.(Transactor); ok {
+		return skipHooksTransactor{Transactor: tx}
+	}
+
+	return skipHooksExecutor{Executor: exec}
+}
+
+// ContextWithSkipHooks returns a copy of ctx that makes HooksAreSkipped true
+// for executors that carry it.
+func ContextWithSkipHooks(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipHooksKey{}, true)
+}
+
+// SkipHooksFromContext reports whether ctx was made with ContextWithSkipHooks
+func SkipHooksFromContext(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipHooksKey{}).(bool)
+	return skip
+}
+
+// HooksAreSkipped reports whether the generated models should not run their
+// hooks for exec. It is true for executors returned by SkipHooks, those with
+// a HooksSkipped() bool method that returns true, and those that carry a
+// context made with ContextWithSkipHooks through a Context() context.Context
+// method.
+func HooksAreSkipped(exec Executor) bool {
+	return walkExecutors(exec, func(e Executor) bool {
+		if s, ok := e.(interface {
+			HooksSkipped() bool
+		}); ok && s.HooksSkipped() {
+			return true
+		}
+
+		if ctx := contextOf(e); ctx != nil {
+			return SkipHooksFromContext(ctx)
+		}
+
+		return false
+	})
+}
@@ -0,0 +1,46 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+func TestHooksAreSkipped(t *testing.T) {
+	t.Parallel()
+
+	db := &sql.DB{}
+	ctx := ContextWithSkipHooks(context.Background())
+
+	tests := []struct {
+		Exec Executor
+		Want bool
+	}{
+		{nil, false},
+		{db, false},
+		{SkipHooks(db), true},
+		{WithSchema(SkipHooks(db), "tenant_a"), true},
+		{WithSchema(db, "tenant_a"), false},
+		{contextExecutor{Executor: db, ctx: ctx}, true},
+		{contextExecutor{Executor: db, ctx: context.Background()}, false},
+	}
+
+	for i, test := range tests {
+		if got := HooksAreSkipped(test.Exec); got != test.Want {
+			t.Errorf("%d) want: %t, got: %t", i, test.Want, got)
+		}
+	}
+}
+
+func TestSkipHooksTransactor(t *testing.T) {
+	t.Parallel()
+
+	exec := SkipHooks(&sql.Tx{})
+	if _, ok := exec.(Transactor); !ok {
+		t.Error("want a transactor to stay a transactor")
+	}
+
+	if _, ok := SkipHooks(&sql.DB{}).(Transactor); ok {
+		t.Error("want a plain executor to stay a plain executor")
+	}
+}
@@ -0,0 +1,87 @@
+package boil
+
+import "context"
+
+type schemaKey struct{}
+
+// schemaExecutor is an Executor bound to a schema
+type schemaExecutor struct {
+	Executor
+	schema string
+}
+
+// Schema returns the schema queries are run in
+func (s schemaExecutor) Schema() string {
+	return s.schema
+}
+
+func (s schemaExecutor) unwrap() Executor {
+	return s.Executor
+}
+
+// schemaTransactor is a Transactor bound to a schema
+type schemaTransactor struct {
+	Transactor
+	schema string
+}
+
+// Schema returns the schema queries are run in
+func (s schemaTransactor) Schema() string {
+	return s.schema
+}
+
+func (s schemaTransactor) unwrap() Executor {
+	return s.Transactor
+}
+
+// WithSchema binds exec to a schema. Packages generated with --runtime-schema
+// run the queries they are given the returned executor for in that schema
+// rather than the one they were generated from. If exec is a Transactor so
+// is the returned executor.
+func WithSchema(exec Executor, schema string) Executor {
+	if tx, ok := exec.(Transactor); ok {
+		return schemaTransactor{Transactor: tx, schema: schema}
+	}
+
+	return schemaExecutor{Executor: exec, schema: schema}
+}
+
+// ContextWithSchema returns a copy of ctx carrying a schema, it is used by
+// SchemaOf for executors that carry a context.
+func ContextWithSchema(ctx context.Context, schema string) context.Context {
+	return context.WithValue(ctx, schemaKey{}, schema)
+}
+
+// SchemaFromContext returns the schema carried by ctx if there is one
+func SchemaFromContext(ctx context.Context) (string, bool) {
+	schema, ok := ctx.Value(schemaKey{}).(string)
+	return schema, ok && len(schema) != 0
+}
+
+// SchemaOf returns the schema queries run with exec should use. It is the
+// schema exec was bound to with WithSchema, or that carried by its context
+// if exec has a Context() context.Context method, or defaultSchema if
+// neither is set. The executors exec wraps, such as those of SkipHooks,
+// are looked through.
+func SchemaOf(exec Executor, defaultSchema string) string {
+	schema := defaultSchema
+	walkExecutors(exec, func(e Executor) bool {
+		if s, ok := e.(interface {
+			Schema() string
+		}); ok && len(s.Schema()) != 0 {
+			schema = s.Schema()
+			return true
+		}
+
+		if ctx := contextOf(e); ctx != nil {
+			if s, ok := SchemaFromContext(ctx); ok {
+				schema = s
+				return true
+			}
+		}
+
+		return false
+	})
+
+	return schema
+}
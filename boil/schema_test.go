@@ -0,0 +1,57 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+)
+
+type contextExecutor struct {
+	Executor
+	ctx context.Context
+}
+
+func (c contextExecutor) Context() context.Context {
+	return c.ctx
+}
+
+func TestSchemaOf(t *testing.T) {
+	t.Parallel()
+
+	db := &sql.DB{}
+	ctx := ContextWithSchema(context.Background(), "tenant_ctx")
+
+	tests := []struct {
+		Exec Executor
+		Want string
+	}{
+		{db, "public"},
+		{WithSchema(db, "tenant_a"), "tenant_a"},
+		{WithSchema(db, ""), "public"},
+		{SkipHooks(WithSchema(db, "tenant_a")), "tenant_a"},
+		{contextExecutor{Executor: db, ctx: ctx}, "tenant_ctx"},
+		{contextExecutor{Executor: db, ctx: context.Background()}, "public"},
+	}
+
+	for i, test := range tests {
+		if got := SchemaOf(test.Exec, "public"); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+}
+
+func TestWithSchemaTransactor(t *testing.T) {
+	t.Parallel()
+
+	exec := WithSchema(&sql.Tx{}, "tenant_a")
+	if _, ok := exec.(Transactor); !ok {
+		t.Error("want a transactor to stay a transactor")
+	}
+	if got := SchemaOf(exec, "public"); got != "tenant_a" {
+		t.Error("wrong schema:", got)
+	}
+
+	if _, ok := WithSchema(&sql.DB{}, "tenant_a").(Transactor); ok {
+		t.Error("want a plain executor to stay a plain executor")
+	}
+}
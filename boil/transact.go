@@ -0,0 +1,251 @@
+package boil
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrTxManaged is returned by Commit and Rollback of the transactions handed
+// to the functions run by Transact, which commits and rolls them back itself.
+var ErrTxManaged = errors.New("boil: transaction is committed and rolled back by Transact")
+
+// TxPolicy controls how Transact retries transactions that failed because
+// they conflicted with others.
+type TxPolicy struct {
+	// MaxRetries is how many times a failed transaction is run again
+	MaxRetries int
+	// Backoff returns how long to wait before the given retry, counting from
+	// 1. A nil Backoff retries right away.
+	Backoff func(retry int) time.Duration
+	// Retryable reports whether a transaction that failed with err may
+	// succeed if it is run again, nil uses IsRetryable.
+	Retryable func(err error) bool
+}
+
+// DefaultTxPolicy is the policy used by Transact
+var DefaultTxPolicy = TxPolicy{
+	MaxRetries: 3,
+	Backoff: func(retry int) time.Duration {
+		return time.Duration(retry) * 20 * time.Millisecond
+	},
+}
+
+// IsRetryable reports whether err, or the error it wraps, is a
+// SerializationFailure, or a driver error ClassifyError finds is one.
+func IsRetryable(err error) bool {
+	_, ok := ClassifyError(causeOf(err)).(*SerializationFailure)
+	return ok
+}
+
+// causeOf returns the error at the root of errors wrapped with pkg/errors
+// and WrapErr
+func causeOf(err error) error {
+	for {
+		err = errors.Cause(err)
+		b, ok := err.(boilErr)
+		if !ok {
+			return err
+		}
+		err = b.error
+	}
+}
+
+// transaction is the Transactor handed to the functions run by Transact
+type transaction struct {
+	Transactor
+
+	// exec is the executor the transaction was begun with, SchemaOf and
+	// HooksAreSkipped look through the transaction to it.
+	exec       Executor
+	mssql      bool
+	savepoints *int
+	// cbs collects the callbacks registered in the transaction or savepoint,
+	// it is nil for savepoints of transactions that do not collect them.
+	cbs *txCallbacks
+}
+
+// Commit returns ErrTxManaged, Transact commits the transaction
+func (t *transaction) Commit() error {
+	return ErrTxManaged
+}
+
+// Rollback returns ErrTxManaged, Transact rolls the transaction back
+func (t *transaction) Rollback() error {
+	return ErrTxManaged
+}
+
+func (t *transaction) unwrap() Executor {
+	return t.exec
+}
+
+func (t *transaction) callbacks() *txCallbacks {
+	return t.cbs
+}
+
+// Transact runs fn in a transaction begun on exec with DefaultTxPolicy, see
+// TransactWithPolicy.
+func Transact(exec Executor, fn func(tx Transactor) error) error {
+	return TransactWithPolicy(exec, DefaultTxPolicy, fn)
+}
+
+// TransactWithPolicy runs fn in a transaction begun on exec, which must be a
+// Beginner such as *sql.DB, optionally wrapped by WithSchema or SkipHooks. The
+// transaction is committed if fn returns nil and rolled back if it returns an
+// error or panics, fn must not commit or roll it back itself. Transactions
+// that fail with an error the policy finds retryable are run again. The
+// callbacks fn registers with AfterCommit and AfterRollback run once the
+// transaction ends.
+//
+// If exec is, or wraps, a transaction handed out by Transact, fn is run in a
+// savepoint of it instead (SAVE TRANSACTION on MSSQL). A savepoint that is
+// rolled back runs the AfterRollback callbacks registered in it, and leaves
+// retries to the outermost call. Other transactions, such as a *sql.Tx, cannot
+// tell which database they belong to, so their savepoints use the syntax of
+// the database set with SetDB, or the SAVEPOINT syntax if there is none.
+func TransactWithPolicy(exec Executor, policy TxPolicy, fn func(tx Transactor) error) error {
+	var tx *transaction
+	var beginner Beginner
+	walkExecutors(exec, func(e Executor) bool {
+		switch t := e.(type) {
+		case *transaction:
+			tx = t
+		case wrapper:
+			return false
+		case Transactor:
+			tx = &transaction{Transactor: t, exec: t, mssql: isMSSQL(currentDB), savepoints: new(int), cbs: callbacksOf(exec)}
+		case Beginner:
+			beginner = t
+		default:
+			return false
+		}
+		return true
+	})
+
+	if tx != nil {
+		return tx.savepoint(exec, fn)
+	}
+	if beginner == nil {
+		return errors.New("boil: executor cannot begin transactions")
+	}
+
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+
+	for retry := 1; ; retry++ {
+		err := transact(exec, beginner, fn)
+		if err == nil || retry > policy.MaxRetries || !retryable(err) {
+			return err
+		}
+
+		if policy.Backoff != nil {
+			time.Sleep(policy.Backoff(retry))
+		}
+	}
+}
+
+// transact runs fn once in a transaction begun with beginner
+func transact(exec Executor, beginner Beginner, fn func(tx Transactor) error) (err error) {
+	sqlTx, err := beginner.Begin()
+	if err != nil {
+		return errors.Wrap(err, "boil: unable to begin transaction")
+	}
+
+	tx := &transaction{Transactor: sqlTx, exec: exec, mssql: isMSSQL(beginner), savepoints: new(int), cbs: new(txCallbacks)}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = sqlTx.Rollback()
+			tx.cbs.end(false)
+			panic(p)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		_ = sqlTx.Rollback()
+		tx.cbs.end(false)
+		return err
+	}
+
+	if err = sqlTx.Commit(); err != nil {
+		tx.cbs.end(false)
+		return errors.Wrap(err, "boil: unable to commit transaction")
+	}
+
+	tx.cbs.end(true)
+	return nil
+}
+
+// isMSSQL reports whether db is a database opened with an MSSQL driver, which
+// names its savepoints with SAVE TRANSACTION.
+func isMSSQL(db interface{}) bool {
+	d, ok := db.(interface {
+		Driver() driver.Driver
+	})
+	if !ok {
+		return false
+	}
+
+	return strings.Contains(reflect.TypeOf(d.Driver()).String(), "mssql")
+}
+
+// run executes a savepoint statement in the transaction
+func (t *transaction) run(query string) error {
+	if DebugMode {
+		fmt.Fprintln(DebugWriter, query)
+	}
+
+	_, err := t.Exec(query)
+	return err
+}
+
+// savepoint runs fn in a savepoint of t
+func (t *transaction) savepoint(exec Executor, fn func(tx Transactor) error) (err error) {
+	*t.savepoints++
+	name := fmt.Sprintf("boil_savepoint_%d", *t.savepoints)
+
+	save, rollback, release := "SAVEPOINT "+name, "ROLLBACK TO SAVEPOINT "+name, "RELEASE SAVEPOINT "+name
+	if t.mssql {
+		save, rollback, release = "SAVE TRANSACTION "+name, "ROLLBACK TRANSACTION "+name, ""
+	}
+
+	if err = t.run(save); err != nil {
+		return errors.Wrap(err, "boil: unable to create savepoint")
+	}
+
+	nested := &transaction{Transactor: t.Transactor, exec: exec, mssql: t.mssql, savepoints: t.savepoints, cbs: new(txCallbacks)}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = t.run(rollback)
+			nested.cbs.end(false)
+			panic(p)
+		}
+	}()
+
+	if err = fn(nested); err != nil {
+		_ = t.run(rollback)
+		nested.cbs.end(false)
+		return err
+	}
+
+	if len(release) != 0 {
+		if err = t.run(release); err != nil {
+			nested.cbs.end(false)
+			return errors.Wrap(err, "boil: unable to release savepoint")
+		}
+	}
+
+	// The savepoint's callbacks now wait on the transaction. If it does not
+	// collect them there is no telling when it commits, so like AfterCommit
+	// outside of Transact they are dropped.
+	if t.cbs != nil {
+		t.cbs.merge(nested.cbs)
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package boil
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Err  error
+		Want bool
+	}{
+		{nil, false},
+		{errors.New("fail"), false},
+		{&pqError{Code: "40001"}, true},
+		{&pqError{Code: "40P01"}, true},
+		{&pqError{Code: "23505"}, false},
+		{&mysqlError{Number: 1213}, true},
+		{&mysqlError{Number: 1062}, false},
+		{mssqlError{number: 1205}, true},
+		{errors.Wrap(&pqError{Code: "40001"}, "models: unable to update"), true},
+		{WrapErr(errors.Wrap(&mysqlError{Number: 1205}, "wrapped")), true},
+		{errors.Wrap(ClassifyError(&pqError{Code: "40001"}), "models: unable to update"), true},
+	}
+
+	for i, test := range tests {
+		if got := IsRetryable(test.Err); got != test.Want {
+			t.Errorf("%d) want: %t, got: %t", i, test.Want, got)
+		}
+	}
+}
+
+func TestTransactCommit(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE pilots").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = Transact(WithSchema(db, "tenant_a"), func(tx Transactor) error {
+		if schema := SchemaOf(tx, "public"); schema != "tenant_a" {
+			t.Error("want schema carried into the transaction, got:", schema)
+		}
+		if err := tx.Commit(); err != ErrTxManaged {
+			t.Error("want commit refused, got:", err)
+		}
+
+		_, err := tx.Exec("UPDATE pilots SET name = 'a'")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransactRollback(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	fail := errors.New("fail")
+	err = Transact(db, func(tx Transactor) error {
+		return fail
+	})
+	if err != fail {
+		t.Error("want fn's error, got:", err)
+	}
+
+	func() {
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Error("want panic to propagate, got:", p)
+			}
+		}()
+
+		_ = Transact(db, func(tx Transactor) error {
+			panic("boom")
+		})
+	}()
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransactSavepoint(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT boil_savepoint_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT boil_savepoint_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	fail := errors.New("fail")
+	err = Transact(db, func(tx Transactor) error {
+		if err := Transact(tx, func(Transactor) error { return fail }); err != fail {
+			t.Error("want nested error, got:", err)
+		}
+
+		return Transact(SkipHooks(tx), func(nested Transactor) error {
+			if !HooksAreSkipped(nested) {
+				t.Error("want hooks skipped in the savepoint")
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransactSavepointMSSQL(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVE TRANSACTION boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TRANSACTION boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	sqlTx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := &transaction{Transactor: sqlTx, exec: db, mssql: true, savepoints: new(int)}
+	fail := errors.New("fail")
+	if err = Transact(tx, func(Transactor) error { return fail }); err != fail {
+		t.Error("want nested error, got:", err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// mssqlDriver is a driver whose type names MSSQL, so isMSSQL finds databases
+// opened with it are
+type mssqlDriver struct{ driver.Driver }
+
+func init() {
+	sql.Register("boil_mssql_test", mssqlDriver{})
+}
+
+func TestTransactSavepointSQLTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVE TRANSACTION boil_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	sqlTx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prev := GetDB()
+	defer SetDB(prev)
+
+	SetDB(db)
+	if err = Transact(sqlTx, func(Transactor) error { return nil }); err != nil {
+		t.Error(err)
+	}
+
+	mssqlDB, err := sql.Open("boil_mssql_test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDB(mssqlDB)
+	if err = Transact(sqlTx, func(Transactor) error { return nil }); err != nil {
+		t.Error(err)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransactRetry(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectCommit().WillReturnError(&pqError{Code: "40001"})
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	attempts := 0
+	err = TransactWithPolicy(db, TxPolicy{MaxRetries: 2}, func(tx Transactor) error {
+		attempts++
+		if attempts == 1 {
+			return errors.Wrap(&mysqlError{Number: 1213}, "models: unable to insert")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 3 {
+		t.Error("want 3 attempts, got:", attempts)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	deadlock := &pqError{Code: "40P01"}
+	attempts = 0
+	err = TransactWithPolicy(db, TxPolicy{MaxRetries: 1}, func(tx Transactor) error {
+		attempts++
+		return deadlock
+	})
+	if err != deadlock || attempts != 2 {
+		t.Errorf("want deadlock after 2 attempts, got: %v after %d", err, attempts)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
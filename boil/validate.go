@@ -0,0 +1,182 @@
+package boil
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ColumnLimits are the limits the database puts on the values of a column.
+// Zero values mean no limit.
+type ColumnLimits struct {
+	// NotNull is set for columns that are NOT NULL without a default
+	NotNull bool
+	// MaxLength is the maximum number of characters of a string
+	MaxLength int
+	// Precision and Scale are the digits of a decimal number, and the digits
+	// of those after the decimal point
+	Precision int
+	Scale     int
+
+	// Min and Max are the range of a number taken from CHECK constraints
+	Min          float64
+	Max          float64
+	HasMin       bool
+	HasMax       bool
+	ExclusiveMin bool
+	ExclusiveMax bool
+	// In is the list of allowed values taken from CHECK constraints
+	In []string
+}
+
+// ColumnViolation is a value that breaks the limits of its column
+type ColumnViolation struct {
+	Column  string
+	Message string
+}
+
+// ValidationError is returned by the generated Validate methods, and by
+// Insert, Update and Upsert when the values given would be refused by
+// the database.
+type ValidationError struct {
+	Table      string
+	Violations []ColumnViolation
+}
+
+// Error lists every column that failed validation
+func (v *ValidationError) Error() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s: invalid values:", v.Table)
+	for i, violation := range v.Violations {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(buf, " %s %s", violation.Column, violation.Message)
+	}
+
+	return buf.String()
+}
+
+// ValidateColumns checks values against the limits of the columns they are
+// stored in, columns and values are matched by index. Columns without an
+// entry in limits are not checked. It returns a *ValidationError listing
+// every offending column, or nil.
+func ValidateColumns(table string, columns []string, values []interface{}, limits map[string]ColumnLimits) error {
+	var violations []ColumnViolation
+
+	for i, column := range columns {
+		lim, ok := limits[column]
+		if !ok || i >= len(values) {
+			continue
+		}
+
+		if msg := validateValue(values[i], lim); len(msg) != 0 {
+			violations = append(violations, ColumnViolation{Column: column, Message: msg})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Table: table, Violations: violations}
+}
+
+// validateValue returns what is wrong with val, or an empty string
+func validateValue(val interface{}, lim ColumnLimits) string {
+	if valuer, ok := val.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return ""
+		}
+		val = v
+	}
+
+	if val == nil {
+		if lim.NotNull {
+			return "must not be null"
+		}
+		return ""
+	}
+
+	if len(lim.In) != 0 {
+		s := fmt.Sprint(val)
+		found := false
+		for _, in := range lim.In {
+			if s == in {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("must be one of %s", strings.Join(lim.In, ", "))
+		}
+	}
+
+	str, isString := val.(string)
+	if isString && lim.MaxLength > 0 && utf8.RuneCountInString(str) > lim.MaxLength {
+		return fmt.Sprintf("must be at most %d characters long", lim.MaxLength)
+	}
+
+	num, isNumber := numberOf(val)
+	if !isNumber {
+		return ""
+	}
+
+	if lim.Precision > 0 {
+		digits := lim.Precision - lim.Scale
+		if integerDigits(num) > digits {
+			return fmt.Sprintf("must have at most %d digits before the decimal point", digits)
+		}
+	}
+
+	if lim.HasMin && (num < lim.Min || (lim.ExclusiveMin && num == lim.Min)) {
+		if lim.ExclusiveMin {
+			return fmt.Sprintf("must be greater than %v", lim.Min)
+		}
+		return fmt.Sprintf("must be at least %v", lim.Min)
+	}
+	if lim.HasMax && (num > lim.Max || (lim.ExclusiveMax && num == lim.Max)) {
+		if lim.ExclusiveMax {
+			return fmt.Sprintf("must be less than %v", lim.Max)
+		}
+		return fmt.Sprintf("must be at most %v", lim.Max)
+	}
+
+	return ""
+}
+
+// numberOf returns val as a float64 if it is a number or a string holding
+// one, decimal columns are often mapped to strings.
+func numberOf(val interface{}) (float64, bool) {
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.String()), 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// integerDigits returns the number of digits before the decimal point of f
+func integerDigits(f float64) int {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	s = strings.TrimLeft(s, "-")
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimLeft(s, "0")
+
+	return len(s)
+}
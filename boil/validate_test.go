@@ -0,0 +1,85 @@
+package boil
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestValidateColumns(t *testing.T) {
+	t.Parallel()
+
+	limits := map[string]ColumnLimits{
+		"name":   {NotNull: true, MaxLength: 5},
+		"price":  {Precision: 5, Scale: 2},
+		"size":   {HasMin: true, Min: 1, HasMax: true, Max: 10, ExclusiveMax: true},
+		"status": {In: []string{"new", "done"}},
+	}
+	columns := []string{"name", "price", "size", "status", "other"}
+
+	tests := []struct {
+		Values []interface{}
+		Want   []ColumnViolation
+	}{
+		{[]interface{}{"héllo", "123.456", 1, "new", "anything"}, nil},
+		{[]interface{}{sql.NullString{String: "x", Valid: true}, 999.99, 9.5, sql.NullString{}, nil}, nil},
+		{
+			[]interface{}{"toolong", "1234.5", 10, "old", nil},
+			[]ColumnViolation{
+				{"name", "must be at most 5 characters long"},
+				{"price", "must have at most 3 digits before the decimal point"},
+				{"size", "must be less than 10"},
+				{"status", "must be one of new, done"},
+			},
+		},
+		{
+			[]interface{}{sql.NullString{}, -1000, int64(0), "done", nil},
+			[]ColumnViolation{
+				{"name", "must not be null"},
+				{"price", "must have at most 3 digits before the decimal point"},
+				{"size", "must be at least 1"},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		err := ValidateColumns("jets", columns, test.Values, limits)
+		if test.Want == nil {
+			if err != nil {
+				t.Errorf("%d) want no error, got: %v", i, err)
+			}
+			continue
+		}
+
+		verr, ok := err.(*ValidationError)
+		if !ok {
+			t.Errorf("%d) want a validation error, got: %#v", i, err)
+			continue
+		}
+		if verr.Table != "jets" {
+			t.Errorf("%d) wrong table: %s", i, verr.Table)
+		}
+		if len(verr.Violations) != len(test.Want) {
+			t.Errorf("%d) want violations: %v, got: %v", i, test.Want, verr.Violations)
+			continue
+		}
+		for j, v := range verr.Violations {
+			if v != test.Want[j] {
+				t.Errorf("%d/%d) want: %v, got: %v", i, j, test.Want[j], v)
+			}
+		}
+	}
+}
+
+func TestValidationErrorString(t *testing.T) {
+	t.Parallel()
+
+	err := &ValidationError{Table: "jets", Violations: []ColumnViolation{
+		{"name", "must not be null"},
+		{"size", "must be at least 1"},
+	}}
+
+	want := "jets: invalid values: name must not be null, size must be at least 1"
+	if got := err.Error(); got != want {
+		t.Errorf("want: %s\ngot: %s", want, got)
+	}
+}
@@ -3,12 +3,16 @@
 package boilingcore
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"go/build"
+	"io"
+	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -45,6 +49,9 @@ type State struct {
 	TestMainTemplate *template.Template
 
 	Importer importer
+
+	// written holds the names of the files output by the current Run
+	written map[string]struct{}
 }
 
 // New creates a new state based off of the config
@@ -58,12 +65,20 @@ func New(config *Config) (*State, error) {
 		return nil, err
 	}
 
+	if len(config.Schemas) == 0 {
+		schema := driverSchema(config)
+		if len(schema) == 0 {
+			return nil, errors.New("no schema configured")
+		}
+		config.Schemas = []string{schema}
+	}
+
 	// Connect to the driver database
 	if err = s.Driver.Open(); err != nil {
 		return nil, errors.Wrap(err, "unable to connect to the database")
 	}
 
-	err = s.initTables(config.Schema, config.WhitelistTables, config.BlacklistTables)
+	err = s.initTables(config.Schemas, config.WhitelistTables, config.BlacklistTables)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to initialize tables")
 	}
@@ -76,11 +91,6 @@ func New(config *Config) (*State, error) {
 		fmt.Printf("%s\n", b)
 	}
 
-	err = s.initOutFolder()
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to initialize the output folder")
-	}
-
 	err = s.initTemplates()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to initialize templates")
@@ -99,14 +109,25 @@ func New(config *Config) (*State, error) {
 // Run executes the sqlboiler templates and outputs them to files based on the
 // state given.
 func (s *State) Run(includeTests bool) error {
+	previous, err := s.initOutFolder()
+	if err != nil {
+		return errors.Wrap(err, "unable to initialize the output folder")
+	}
+	s.written = make(map[string]struct{})
+
 	singletonData := &templateData{
 		Tables:           s.Tables,
-		Schema:           s.Config.Schema,
+		Schema:           s.defaultSchema(),
+		DriverSchema:     driverSchema(s.Config),
+		RuntimeSchema:    s.Config.RuntimeSchema,
 		DriverName:       s.Config.DriverName,
 		UseLastInsertID:  s.Driver.UseLastInsertID(),
 		PkgName:          s.Config.PkgName,
 		NoHooks:          s.Config.NoHooks,
 		NoAutoTimestamps: s.Config.NoAutoTimestamps,
+		NoValidate:       s.Config.NoValidate,
+		RowsAffected:     s.Config.RowsAffected,
+		TestTransactions: s.Config.TestTransactions,
 		StructTagCasing:  s.Config.StructTagCasing,
 		Dialect:          s.Dialect,
 		LQ:               strmangle.QuoteCharacter(s.Dialect.LQ),
@@ -137,12 +158,17 @@ func (s *State) Run(includeTests bool) error {
 		data := &templateData{
 			Tables:           s.Tables,
 			Table:            table,
-			Schema:           s.Config.Schema,
+			Schema:           s.defaultSchema(),
+			DriverSchema:     driverSchema(s.Config),
+			RuntimeSchema:    s.Config.RuntimeSchema,
 			DriverName:       s.Config.DriverName,
 			UseLastInsertID:  s.Driver.UseLastInsertID(),
 			PkgName:          s.Config.PkgName,
 			NoHooks:          s.Config.NoHooks,
 			NoAutoTimestamps: s.Config.NoAutoTimestamps,
+			NoValidate:       s.Config.NoValidate,
+			RowsAffected:     s.Config.RowsAffected,
+			TestTransactions: s.Config.TestTransactions,
 			StructTagCasing:  s.Config.StructTagCasing,
 			Tags:             s.Config.Tags,
 			Dialect:          s.Dialect,
@@ -157,131 +183,261 @@ func (s *State) Run(includeTests bool) error {
 			return errors.Wrap(err, "unable to generate output")
 		}
 
-		// Generate the test templates
-		if !s.Config.NoTests && includeTests {
+		// Generate the test templates, views can't be written to so the tests
+		// have no way of setting up their rows
+		if !s.Config.NoTests && includeTests && !table.IsView {
 			if err := generateTestOutput(s, data); err != nil {
 				return errors.Wrap(err, "unable to generate test output")
 			}
 		}
 	}
 
+	s.reportStaleFiles(previous)
+
 	return nil
 }
 
+// Check runs the generation into a temporary folder and compares the result
+// against the output folder. It returns a unified diff of the files that have
+// changed, are missing, or were generated but would no longer be. An empty
+// diff means the output folder is up to date. Files that were not generated
+// by sqlboiler are ignored.
+func (s *State) Check(includeTests bool) ([]byte, error) {
+	tmp, err := ioutil.TempDir("", "sqlboiler_check")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create temporary output folder")
+	}
+	defer os.RemoveAll(tmp)
+
+	outFolder := s.Config.OutFolder
+	s.Config.OutFolder = tmp
+	err = s.Run(includeTests)
+	s.Config.OutFolder = outFolder
+	if err != nil {
+		return nil, err
+	}
+
+	generated, err := ioutil.ReadDir(tmp)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read generated output")
+	}
+
+	names := make(map[string]struct{})
+	for _, inf := range generated {
+		names[inf.Name()] = struct{}{}
+	}
+
+	existing, err := generatedFiles(outFolder)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range existing {
+		names[name] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	diff := &bytes.Buffer{}
+	for _, name := range sorted {
+		outName := filepath.Join(outFolder, name)
+		aName, bName := outName, outName
+
+		want, ok, err := readIfExists(filepath.Join(tmp, name))
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			bName = ""
+		}
+
+		got, ok, err := readIfExists(outName)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			aName = ""
+		}
+
+		unifiedDiff(diff, aName, bName, got, want)
+	}
+
+	return diff.Bytes(), nil
+}
+
 // Cleanup closes any resources that must be closed
 func (s *State) Cleanup() error {
 	s.Driver.Close()
 	return nil
 }
 
+// templateDirectories are all the template folders, relative to the base
+// dir or any of the user's template dirs.
+var templateDirectories = []string{
+	templatesDirectory,
+	templatesSingletonDirectory,
+	templatesTestDirectory,
+	templatesSingletonTestDirectory,
+	templatesTestMainDirectory,
+}
+
 // initTemplates loads all template folders into the state object.
 func (s *State) initTemplates() error {
 	var err error
 
-	basePath, err := getBasePath(s.Config.BaseDir)
-	if err != nil {
+	if err = s.checkTemplateDirs(); err != nil {
 		return err
 	}
 
-	s.Templates, err = loadTemplates(filepath.Join(basePath, templatesDirectory))
+	s.Templates, err = s.loadTemplateDir(templatesDirectory)
 	if err != nil {
 		return err
 	}
 
-	s.SingletonTemplates, err = loadTemplates(filepath.Join(basePath, templatesSingletonDirectory))
+	s.SingletonTemplates, err = s.loadTemplateDir(templatesSingletonDirectory)
 	if err != nil {
 		return err
 	}
 
 	if !s.Config.NoTests {
-		s.TestTemplates, err = loadTemplates(filepath.Join(basePath, templatesTestDirectory))
+		s.TestTemplates, err = s.loadTemplateDir(templatesTestDirectory)
 		if err != nil {
 			return err
 		}
 
-		s.SingletonTestTemplates, err = loadTemplates(filepath.Join(basePath, templatesSingletonTestDirectory))
+		s.SingletonTestTemplates, err = s.loadTemplateDir(templatesSingletonTestDirectory)
 		if err != nil {
 			return err
 		}
 
-		s.TestMainTemplate, err = loadTemplate(filepath.Join(basePath, templatesTestMainDirectory), s.Config.DriverName+"_main.tpl")
+		files, err := s.templateFiles(templatesTestMainDirectory)
 		if err != nil {
 			return err
 		}
+
+		s.TestMainTemplate, err = loadTemplate(files, testMainName(s.Config))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// testSingletons are the singleton templates that import packages the models
+// don't need otherwise, keyed by the name of their file. They are generated
+// into a test file unless their option exports them from the package.
+var testSingletons = map[string]func(config *Config) bool{
+	"boil_factories": func(config *Config) bool { return config.ExportFactories },
+	"boil_fixtures":  func(config *Config) bool { return config.ExportFixtures },
+}
+
+// exportSingleton reports whether the singleton template of the file name is
+// generated into the package rather than its tests.
+func exportSingleton(config *Config, name string) bool {
+	export, ok := testSingletons[name]
+	return !ok || export(config)
+}
+
+// testMainName is the name of the TestMain template. With TestTransactions
+// the tests run on the configured database itself, so every driver shares the
+// one template that connects to it rather than its own that copies the schema.
+func testMainName(config *Config) string {
+	if config.TestTransactions {
+		return "tx_main.tpl"
 	}
 
-	return s.processReplacements()
+	return config.DriverName + "_main.tpl"
 }
 
-// processReplacements loads any replacement templates
-func (s *State) processReplacements() error {
-	basePath, err := getBasePath(s.Config.BaseDir)
+// loadTemplateDir loads every template for one of the template directories
+func (s *State) loadTemplateDir(dir string) (*templateList, error) {
+	files, err := s.templateFiles(dir)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, replace := range s.Config.Replacements {
-		splits := strings.Split(replace, ":")
-		if len(splits) != 2 {
-			return errors.Errorf("replace parameters must have 2 arguments, given: %s", replace)
-		}
+	return loadTemplates(files)
+}
 
-		var toReplaceFname string
-		toReplace, replaceWith := splits[0], splits[1]
+// templateFiles returns the template files for dir keyed by file name. They
+// are read from the base dir when one is configured and from the templates
+// bundled into the binary otherwise. Files in the user's template dirs are
+// then layered on top, adding new templates or shadowing existing ones.
+func (s *State) templateFiles(dir string) (map[string][]byte, error) {
+	var files map[string][]byte
+	var err error
 
-		inf, err := os.Stat(filepath.Join(basePath, toReplace))
-		if err != nil {
-			return errors.Errorf("cannot stat %q", toReplace)
-		}
-		if inf.IsDir() {
-			return errors.Errorf("replace argument must be a path to a file not a dir: %q", toReplace)
-		}
-		toReplaceFname = inf.Name()
+	if len(s.Config.BaseDir) != 0 {
+		files, err = readTemplateDir(filepath.Join(s.Config.BaseDir, dir))
+	} else {
+		files, err = readBundledTemplateDir(dir)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-		inf, err = os.Stat(replaceWith)
+	if len(files) == 0 {
+		return nil, errors.Errorf("no templates found for %s", dir)
+	}
+
+	for _, templateDir := range s.Config.TemplateDirs {
+		overlay, err := readTemplateDir(filepath.Join(templateDir, dir))
 		if err != nil {
-			return errors.Errorf("cannot stat %q", replaceWith)
-		}
-		if inf.IsDir() {
-			return errors.Errorf("replace argument must be a path to a file not a dir: %q", replaceWith)
+			return nil, err
 		}
 
-		switch filepath.Dir(toReplace) {
-		case templatesDirectory:
-			err = replaceTemplate(s.Templates.Template, toReplaceFname, replaceWith)
-		case templatesSingletonDirectory:
-			err = replaceTemplate(s.SingletonTemplates.Template, toReplaceFname, replaceWith)
-		case templatesTestDirectory:
-			err = replaceTemplate(s.TestTemplates.Template, toReplaceFname, replaceWith)
-		case templatesSingletonTestDirectory:
-			err = replaceTemplate(s.SingletonTestTemplates.Template, toReplaceFname, replaceWith)
-		case templatesTestMainDirectory:
-			err = replaceTemplate(s.TestMainTemplate, toReplaceFname, replaceWith)
-		default:
-			return errors.Errorf("replace file's directory not part of any known folder: %s", toReplace)
+		for name, contents := range overlay {
+			files[name] = contents
 		}
+	}
 
+	return files, nil
+}
+
+// checkTemplateDirs ensures the user's template dirs exist and that no two of
+// them provide the same template file, since it would be ambiguous which one
+// should win.
+func (s *State) checkTemplateDirs() error {
+	var conflicts []string
+
+	for _, templateDir := range s.Config.TemplateDirs {
+		inf, err := os.Stat(templateDir)
 		if err != nil {
-			return err
+			return errors.Wrap(err, "unable to read template dir")
+		}
+		if !inf.IsDir() {
+			return errors.Errorf("template dir must be a directory: %q", templateDir)
 		}
 	}
 
-	return nil
-}
+	for _, dir := range templateDirectories {
+		owners := make(map[string][]string)
+		for _, templateDir := range s.Config.TemplateDirs {
+			files, err := readTemplateDir(filepath.Join(templateDir, dir))
+			if err != nil {
+				return err
+			}
 
-var basePackage = "github.com/curvegrid/sqlboiler"
+			for name := range files {
+				owners[name] = append(owners[name], templateDir)
+			}
+		}
 
-func getBasePath(baseDirConfig string) (string, error) {
-	if len(baseDirConfig) > 0 {
-		return baseDirConfig, nil
+		for name, templateDirs := range owners {
+			if len(templateDirs) > 1 {
+				conflicts = append(conflicts, fmt.Sprintf("%s (%s)", path.Join(dir, name), strings.Join(templateDirs, ", ")))
+			}
+		}
 	}
 
-	p, _ := build.Default.Import(basePackage, "", build.FindOnly)
-	if p != nil && len(p.Dir) > 0 {
-		return p.Dir, nil
+	if len(conflicts) != 0 {
+		sort.Strings(conflicts)
+		return errors.Errorf("template files found in more than one template dir: %s", strings.Join(conflicts, "; "))
 	}
 
-	return os.Getwd()
+	return nil
 }
 
 // initDriver attempts to set the state Interface based off the passed in
@@ -333,9 +489,9 @@ func (s *State) initDriver(driverName string) error {
 }
 
 // initTables retrieves all "public" schema table names from the database.
-func (s *State) initTables(schema string, whitelist, blacklist []string) error {
+func (s *State) initTables(schemas []string, whitelist, blacklist []string) error {
 	var err error
-	s.Tables, err = bdb.Tables(s.Driver, schema, whitelist, blacklist)
+	s.Tables, err = bdb.Tables(s.Driver, schemas, whitelist, blacklist, s.virtualKeys())
 	if err != nil {
 		return errors.Wrap(err, "unable to fetch table data")
 	}
@@ -351,6 +507,52 @@ func (s *State) initTables(schema string, whitelist, blacklist []string) error {
 	return nil
 }
 
+// virtualKeys converts the configured view keys for the bdb package
+func (s *State) virtualKeys() map[string]bdb.VirtualKeys {
+	if len(s.Config.Views) == 0 {
+		return nil
+	}
+
+	keys := make(map[string]bdb.VirtualKeys, len(s.Config.Views))
+	for name, view := range s.Config.Views {
+		vk := bdb.VirtualKeys{PrimaryKey: view.PrimaryKey}
+		for _, fkey := range view.ForeignKeys {
+			vk.ForeignKeys = append(vk.ForeignKeys, bdb.ForeignKey{
+				Columns:        fkey.Columns,
+				ForeignTable:   fkey.ForeignTable,
+				ForeignColumns: fkey.ForeignColumns,
+			})
+		}
+		keys[name] = vk
+	}
+
+	return keys
+}
+
+// driverSchema is the schema the driver's tables are in when none is
+// configured: public on postgres, dbo on mssql and the database on mysql,
+// which has no schemas.
+func driverSchema(config *Config) string {
+	switch config.DriverName {
+	case "postgres", "mock":
+		return "public"
+	case "mssql":
+		return "dbo"
+	case "mysql":
+		return config.MySQL.DBName
+	}
+	return ""
+}
+
+// defaultSchema is the first of the configured schemas, tables are looked up
+// in it when they can't be found by name
+func (s *State) defaultSchema() string {
+	if len(s.Config.Schemas) == 0 {
+		return ""
+	}
+	return s.Config.Schemas[0]
+}
+
 // Tags must be in a format like: json, xml, etc.
 var rgxValidTag = regexp.MustCompile(`[a-zA-Z_\.]+`)
 
@@ -367,22 +569,109 @@ func (s *State) initTags(tags []string) error {
 	return nil
 }
 
-// initOutFolder creates the folder that will hold the generated output.
-func (s *State) initOutFolder() error {
+// initOutFolder creates the folder that will hold the generated output and
+// returns the names of the previously generated files found inside of it.
+// When wiping, those files are removed. Anything that was not generated by
+// sqlboiler is left alone so hand-written code can live alongside the models.
+func (s *State) initOutFolder() ([]string, error) {
+	previous, err := generatedFiles(s.Config.OutFolder)
+	if err != nil {
+		return nil, err
+	}
+
 	if s.Config.Wipe {
-		if err := os.RemoveAll(s.Config.OutFolder); err != nil {
-			return err
+		for _, name := range previous {
+			if err := os.Remove(filepath.Join(s.Config.OutFolder, name)); err != nil {
+				return nil, errors.Wrap(err, "unable to remove generated file")
+			}
+		}
+	}
+
+	return previous, os.MkdirAll(s.Config.OutFolder, os.ModePerm)
+}
+
+// reportStaleFiles prints the previously generated files that were not
+// generated again, typically because their table no longer exists.
+func (s *State) reportStaleFiles(previous []string) {
+	for _, name := range previous {
+		if _, ok := s.written[name]; ok {
+			continue
+		}
+
+		path := filepath.Join(s.Config.OutFolder, name)
+		if s.Config.Wipe {
+			fmt.Printf("removed stale generated file: %s\n", path)
+		} else {
+			fmt.Printf("stale generated file, use --wipe to remove it: %s\n", path)
+		}
+	}
+}
+
+// generatedFiles returns the sorted names of the files in dir that carry
+// the generated code disclaimer. A missing dir has no generated files.
+func generatedFiles(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "unable to read the output folder")
+	}
+
+	var names []string
+	for _, inf := range infos {
+		if !inf.Mode().IsRegular() {
+			continue
+		}
+
+		isGenerated, err := hasDisclaimer(filepath.Join(dir, inf.Name()))
+		if err != nil {
+			return nil, err
 		}
+		if isGenerated {
+			names = append(names, inf.Name())
+		}
+	}
+
+	return names, nil
+}
+
+// hasDisclaimer reports whether the file starts with the disclaimer that is
+// written to the top of every generated file.
+func hasDisclaimer(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to open %s", path)
+	}
+	defer f.Close()
+
+	header := make([]byte, len(noEditDisclaimer))
+	if _, err = io.ReadFull(f, header); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrapf(err, "unable to read %s", path)
+	}
+
+	return bytes.Equal(header, noEditDisclaimer), nil
+}
+
+// readIfExists reads the file at path, ok is false if there is no such file
+func readIfExists(path string) (contents []byte, ok bool, err error) {
+	contents, err = ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, errors.Wrapf(err, "unable to read %s", path)
 	}
 
-	return os.MkdirAll(s.Config.OutFolder, os.ModePerm)
+	return contents, true, nil
 }
 
-// checkPKeys ensures every table has a primary key column
+// checkPKeys ensures every table has a primary key column, views may go
+// without one
 func checkPKeys(tables []bdb.Table) error {
 	var missingPkey []string
 	for _, t := range tables {
-		if t.PKey == nil {
+		if t.PKey == nil && !t.IsView {
 			missingPkey = append(missingPkey, t.Name)
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strconv"
 	"testing"
@@ -38,9 +39,18 @@ func TestNew(t *testing.T) {
 
 	config := &Config{
 		DriverName:      "mock",
+		Schemas:         []string{"public", "billing"},
 		PkgName:         "models",
 		OutFolder:       out,
 		BlacklistTables: []string{"hangars"},
+		Views: map[string]ViewConfig{
+			"pilot_jet_counts": {
+				PrimaryKey: []string{"pilot_id"},
+				ForeignKeys: []ViewForeignKeyConfig{
+					{Columns: []string{"pilot_id"}, ForeignTable: "pilots", ForeignColumns: []string{"id"}},
+				},
+			},
+		},
 	}
 
 	state, err = New(config)
@@ -65,6 +75,277 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewOptions(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+
+	tests := []struct {
+		Name   string
+		Option func(config *Config)
+		// State adjusts the state before it is run, if set
+		State func(s *State)
+		// Files are the contents each generated file must have, by its name
+		Files map[string]string
+	}{
+		{
+			Name:   "export fixtures",
+			Option: func(config *Config) { config.ExportFixtures = true },
+			Files: map[string]string{
+				"boil_fixtures.go": "func LoadFixtures(exec boil.Executor, paths ...string) (fixtures.Loaded, error)",
+			},
+		},
+		{
+			Name:   "export factories",
+			Option: func(config *Config) { config.ExportFactories = true },
+			Files: map[string]string{
+				"boil_factories.go": "func (f jetFactory) Create(exec boil.Executor) (*Jet, error)",
+			},
+		},
+		{
+			Name:   "runtime schema",
+			Option: func(config *Config) { config.RuntimeSchema = true },
+			Files: map[string]string{
+				"invoices.go":            `qm.From(schemaTable(exec, "billing", "invoices"))`,
+				"boil_fixtures_test.go":  "func LoadFixtures(exec boil.Executor, paths ...string) (fixtures.Loaded, error)",
+				"boil_factories_test.go": "func (f jetFactory) Create(exec boil.Executor) (*Jet, error)",
+			},
+		},
+		{
+			Name:   "rows affected",
+			Option: func(config *Config) { config.RowsAffected = true },
+			Files: map[string]string{
+				"pilots.go": "func (o *Pilot) Update(exec boil.Executor, whitelist ...string) (int64, error)",
+			},
+		},
+		{
+			Name:   "test transactions",
+			Option: func(config *Config) { config.TestTransactions = true },
+			State: func(s *State) {
+				// The mock driver has no database/sql driver to import
+				s.Importer.TestTxMain["mock"] = imports{
+					standard:   importList{`"database/sql"`},
+					thirdParty: importList{`"github.com/spf13/viper"`, `"github.com/curvegrid/sqlboiler/bdb/drivers"`},
+				}
+			},
+			Files: map[string]string{
+				"main_test.go": "dbMain = &txTester{}",
+				"jets_test.go": "parent, err := Factory.Airport().Create(exec)",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		out, err := ioutil.TempDir("", "boil_options")
+		if err != nil {
+			t.Fatalf("unable to create tempdir: %s", err)
+		}
+
+		config := &Config{
+			DriverName:      "mock",
+			Schemas:         []string{"public", "billing"},
+			PkgName:         "models",
+			OutFolder:       out,
+			BlacklistTables: []string{"hangars"},
+		}
+		test.Option(config)
+
+		s, err := New(config)
+		if err != nil {
+			t.Fatalf("%s) Unable to create State using config: %s", test.Name, err)
+		}
+		if test.State != nil {
+			test.State(s)
+		}
+
+		if err = s.Run(true); err != nil {
+			t.Fatalf("%s) Unable to execute State.Run: %s", test.Name, err)
+		}
+
+		failed := false
+		for file, want := range test.Files {
+			b, err := ioutil.ReadFile(filepath.Join(out, file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Contains(b, []byte(want)) {
+				t.Errorf("%s) want %s to contain: %s", test.Name, file, want)
+				failed = true
+			}
+		}
+
+		buf := &bytes.Buffer{}
+
+		cmd := exec.Command("go", "test", "-c")
+		cmd.Dir = out
+		cmd.Stderr = buf
+
+		if err = cmd.Run(); err != nil {
+			t.Errorf("%s) go test cmd execution failed: %s", test.Name, err)
+			outputCompileErrors(buf, out)
+			fmt.Println()
+			failed = true
+		}
+
+		if failed {
+			t.Log("template test output:", out)
+			continue
+		}
+		os.RemoveAll(out)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+
+	out, err := ioutil.TempDir("", "boil_check")
+	if err != nil {
+		t.Fatalf("unable to create tempdir: %s", err)
+	}
+	defer os.RemoveAll(out)
+
+	s, err := New(&Config{
+		DriverName:      "mock",
+		Schemas:         []string{"public", "billing"},
+		PkgName:         "models",
+		OutFolder:       out,
+		BlacklistTables: []string{"hangars"},
+		NoTests:         true,
+	})
+	if err != nil {
+		t.Fatalf("Unable to create State using config: %s", err)
+	}
+
+	diff, err := s.Check(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(diff, []byte("--- /dev/null\n+++ "+filepath.Join(out, "pilots.go"))) {
+		t.Errorf("want pilots.go to be missing, got:\n%s", diff)
+	}
+
+	if err = s.Run(false); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err = s.Check(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff) != 0 {
+		t.Errorf("want no differences, got:\n%s", diff)
+	}
+
+	pilots := filepath.Join(out, "pilots.go")
+	b, err := ioutil.ReadFile(pilots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(pilots, append(b, []byte("\nvar handEdited = true\n")...), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	stale := append(append([]byte{}, noEditDisclaimer...), []byte("package models\n")...)
+	if err = ioutil.WriteFile(filepath.Join(out, "stale.go"), stale, 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(out, "custom.go"), []byte("package models\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err = s.Check(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(diff, []byte("-var handEdited = true\n")) {
+		t.Errorf("want the hand edit to be reverted, got:\n%s", diff)
+	}
+	if !bytes.Contains(diff, []byte("--- "+filepath.Join(out, "stale.go")+"\n+++ /dev/null\n")) {
+		t.Errorf("want stale.go to be removed, got:\n%s", diff)
+	}
+	if bytes.Contains(diff, []byte("custom.go")) {
+		t.Errorf("did not want custom.go in the diff, got:\n%s", diff)
+	}
+
+	if _, err = os.Stat(filepath.Join(out, "stale.go")); err != nil {
+		t.Error("check should not modify the output folder")
+	}
+}
+
+func TestNewDefaultSchema(t *testing.T) {
+	t.Parallel()
+
+	s, err := New(&Config{DriverName: "mock", PkgName: "models", BlacklistTables: []string{"hangars"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"public"}; !reflect.DeepEqual(s.Config.Schemas, want) {
+		t.Errorf("want: %v, got: %v", want, s.Config.Schemas)
+	}
+
+	if _, err = New(&Config{DriverName: "mysql"}); err == nil || err.Error() != "no schema configured" {
+		t.Error("want an error without a mysql database, got:", err)
+	}
+}
+
+func TestInitOutFolder(t *testing.T) {
+	t.Parallel()
+
+	out, err := ioutil.TempDir("", "boil_wipe")
+	if err != nil {
+		t.Fatalf("unable to create tempdir: %s", err)
+	}
+	defer os.RemoveAll(out)
+
+	generated := append(append([]byte{}, noEditDisclaimer...), []byte("package models\n")...)
+	files := map[string][]byte{
+		"pilots.go":   generated,
+		"jets.go":     generated,
+		"custom.go":   []byte("package models\n"),
+		"notes.txt":   []byte("hello"),
+		"partial.go":  noEditDisclaimer[:10],
+		"generate.sh": []byte("#!/bin/sh\n"),
+	}
+	for name, contents := range files {
+		if err = ioutil.WriteFile(filepath.Join(out, name), contents, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := &State{Config: &Config{OutFolder: out}}
+	previous, err := s.initOutFolder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(previous) != 2 || previous[0] != "jets.go" || previous[1] != "pilots.go" {
+		t.Errorf("want the generated files, got: %v", previous)
+	}
+	if _, err = os.Stat(filepath.Join(out, "pilots.go")); err != nil {
+		t.Error("did not want generated files removed without wipe")
+	}
+
+	s.Config.Wipe = true
+	if _, err = s.initOutFolder(); err != nil {
+		t.Fatal(err)
+	}
+
+	for name := range files {
+		_, err := os.Stat(filepath.Join(out, name))
+		switch name {
+		case "pilots.go", "jets.go":
+			if !os.IsNotExist(err) {
+				t.Errorf("want %s to be wiped", name)
+			}
+		default:
+			if err != nil {
+				t.Errorf("want %s to be kept: %s", name, err)
+			}
+		}
+	}
+}
+
 func outputCompileErrors(buf *bytes.Buffer, outFolder string) {
 	type errObj struct {
 		errMsg     string
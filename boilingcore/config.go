@@ -3,26 +3,50 @@ package boilingcore
 // Config for the running of the commands
 type Config struct {
 	DriverName       string
-	Schema           string
+	Schemas          []string
 	PkgName          string
 	OutFolder        string
 	BaseDir          string
+	TemplateDirs     []string
 	WhitelistTables  []string
 	BlacklistTables  []string
 	Tags             []string
-	Replacements     []string
 	Debug            bool
 	NoTests          bool
+	TestTransactions bool
 	NoHooks          bool
 	NoAutoTimestamps bool
+	NoValidate       bool
+	RowsAffected     bool
+	ExportFixtures   bool
+	ExportFactories  bool
 	Wipe             bool
 	StructTagCasing  string
+	RuntimeSchema    bool
+
+	// Views configures the virtual keys of views, keyed by view name
+	Views map[string]ViewConfig
 
 	Postgres PostgresConfig
 	MySQL    MySQLConfig
 	MSSQL    MSSQLConfig
 }
 
+// ViewConfig configures the keys of a view. Views can't declare keys of their
+// own, without a primary key no Find, Reload or Exists is generated for them
+// and without foreign keys they have no relationships.
+type ViewConfig struct {
+	PrimaryKey  []string               `mapstructure:"primary_key"`
+	ForeignKeys []ViewForeignKeyConfig `mapstructure:"foreign_keys"`
+}
+
+// ViewForeignKeyConfig configures a virtual foreign key of a view
+type ViewForeignKeyConfig struct {
+	Columns        []string `mapstructure:"columns"`
+	ForeignTable   string   `mapstructure:"foreign_table"`
+	ForeignColumns []string `mapstructure:"foreign_columns"`
+}
+
 // PostgresConfig configures a postgres database
 type PostgresConfig struct {
 	User    string
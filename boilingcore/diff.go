@@ -0,0 +1,212 @@
+package boilingcore
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change
+const diffContext = 3
+
+// diffMaxCells bounds the size of the table used to find the longest common
+// subsequence of two files. Beyond it the differing region is reported as
+// one big replacement which is still correct, just not minimal.
+const diffMaxCells = 4 * 1024 * 1024
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// unifiedDiff writes a unified diff turning a into b. An empty name denotes a
+// file that doesn't exist and is written as /dev/null. Nothing is written if
+// the two are equal.
+func unifiedDiff(buf *bytes.Buffer, aName, bName string, a, b []byte) {
+	if bytes.Equal(a, b) {
+		return
+	}
+
+	if len(aName) == 0 {
+		aName = "/dev/null"
+	}
+	if len(bName) == 0 {
+		bName = "/dev/null"
+	}
+
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	fmt.Fprintf(buf, "--- %s\n+++ %s\n", aName, bName)
+
+	for start := 0; start < len(ops); {
+		// Find the next change, and the end of the hunk it belongs to
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+
+		hunkStart := first - diffContext
+		if hunkStart < start {
+			hunkStart = start
+		}
+
+		hunkEnd, unchanged := first, 0
+		for i := first; i < len(ops); i++ {
+			if ops[i].kind != ' ' {
+				unchanged = 0
+				hunkEnd = i + 1
+				continue
+			}
+
+			unchanged++
+			if unchanged > 2*diffContext {
+				break
+			}
+		}
+		hunkEnd += diffContext
+		if hunkEnd > len(ops) {
+			hunkEnd = len(ops)
+		}
+
+		writeHunk(buf, ops, hunkStart, hunkEnd)
+		start = hunkEnd
+	}
+}
+
+// writeHunk writes ops[start:end] as a single hunk
+func writeHunk(buf *bytes.Buffer, ops []diffOp, start, end int) {
+	aLine, bLine := 1, 1
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			aLine++
+		}
+		if op.kind != '-' {
+			bLine++
+		}
+	}
+
+	aLen, bLen := 0, 0
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			aLen++
+		}
+		if op.kind != '-' {
+			bLen++
+		}
+	}
+
+	// An empty range starts at the line before it by convention
+	if aLen == 0 {
+		aLine--
+	}
+	if bLen == 0 {
+		bLine--
+	}
+
+	fmt.Fprintf(buf, "@@ -%d,%d +%d,%d @@\n", aLine, aLen, bLine, bLen)
+	for _, op := range ops[start:end] {
+		buf.WriteByte(op.kind)
+		buf.WriteString(op.line)
+		if !strings.HasSuffix(op.line, "\n") {
+			buf.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// diffLines returns the edit script turning a into b
+func diffLines(a, b []string) []diffOp {
+	var prefix, suffix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, l := range a[:prefix] {
+		ops = append(ops, diffOp{kind: ' ', line: l})
+	}
+
+	ops = append(ops, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+
+	for _, l := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{kind: ' ', line: l})
+	}
+
+	return ops
+}
+
+// diffMiddle diffs the region between the common prefix and suffix using the
+// longest common subsequence of the two.
+func diffMiddle(a, b []string) []diffOp {
+	var ops []diffOp
+
+	if len(a)*len(b) > diffMaxCells {
+		for _, l := range a {
+			ops = append(ops, diffOp{kind: '-', line: l})
+		}
+		for _, l := range b {
+			ops = append(ops, diffOp{kind: '+', line: l})
+		}
+		return ops
+	}
+
+	// lcs[i][j] is the length of the lcs of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{kind: ' ', line: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{kind: '-', line: a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: '+', line: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{kind: '-', line: a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{kind: '+', line: b[j]})
+	}
+
+	return ops
+}
+
+// splitLines splits b into lines keeping their line endings
+func splitLines(b []byte) []string {
+	if len(b) == 0 {
+		return nil
+	}
+
+	lines := strings.SplitAfter(string(b), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
@@ -0,0 +1,66 @@
+package boilingcore
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		AName string
+		BName string
+		A     string
+		B     string
+		Want  string
+	}{
+		{"x", "x", "a\nb\n", "a\nb\n", ""},
+		{"", "x", "", "a\nb\n", "--- /dev/null\n+++ x\n@@ -0,0 +1,2 @@\n+a\n+b\n"},
+		{"x", "", "a\nb\n", "", "--- x\n+++ /dev/null\n@@ -1,2 +0,0 @@\n-a\n-b\n"},
+		{
+			"x", "x",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			"1\n2\n3\n4\nfive\n6\n7\n8\n9\n",
+			"--- x\n+++ x\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			"x", "x",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			"one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n",
+			"--- x\n+++ x\n@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -9,4 +9,4 @@\n 9\n 10\n 11\n-12\n+twelve\n",
+		},
+		{
+			"x", "x",
+			"a\nb",
+			"a\nc",
+			"--- x\n+++ x\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n",
+		},
+	}
+
+	for i, test := range tests {
+		buf := &bytes.Buffer{}
+		unifiedDiff(buf, test.AName, test.BName, []byte(test.A), []byte(test.B))
+		if got := buf.String(); got != test.Want {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.Want, got)
+		}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	t.Parallel()
+
+	a := strings.Split("a b c d e f", " ")
+	b := strings.Split("a c d x e f g", " ")
+
+	var got []string
+	for _, op := range diffLines(a, b) {
+		got = append(got, string(op.kind)+op.line)
+	}
+
+	want := []string{" a", "-b", " c", " d", "+x", " e", " f", "+g"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
@@ -150,7 +150,8 @@ type importer struct {
 	Singleton     mapImports
 	TestSingleton mapImports
 
-	TestMain mapImports
+	TestMain   mapImports
+	TestTxMain mapImports
 
 	BasedOnType mapImports
 }
@@ -181,6 +182,21 @@ func newImporter() importer {
 	}
 
 	imp.Singleton = mapImports{
+		"boil_factories": {
+			standard: importList{
+				`"reflect"`,
+			},
+			thirdParty: importList{
+				`"github.com/curvegrid/sqlboiler/boil"`,
+				`"github.com/curvegrid/sqlboiler/randomize"`,
+			},
+		},
+		"boil_fixtures": {
+			thirdParty: importList{
+				`"github.com/curvegrid/sqlboiler/boil"`,
+				`"github.com/curvegrid/sqlboiler/fixtures"`,
+			},
+		},
 		"boil_queries": {
 			thirdParty: importList{
 				`"github.com/curvegrid/sqlboiler/boil"`,
@@ -205,6 +221,8 @@ func newImporter() importer {
 		},
 		thirdParty: importList{
 			`"github.com/curvegrid/sqlboiler/boil"`,
+			`"github.com/curvegrid/sqlboiler/boil/boiltest"`,
+			`"github.com/curvegrid/sqlboiler/queries"`,
 			`"github.com/curvegrid/sqlboiler/randomize"`,
 			`"github.com/curvegrid/sqlboiler/strmangle"`,
 			`"github.com/curvegrid/sqlboiler/marshal"`,
@@ -221,13 +239,13 @@ func newImporter() importer {
 				`"os"`,
 				`"path/filepath"`,
 				`"testing"`,
-				`"time"`,
 			},
 			thirdParty: importList{
 				`"github.com/kat-co/vala"`,
 				`"github.com/pkg/errors"`,
 				`"github.com/spf13/viper"`,
 				`"github.com/curvegrid/sqlboiler/boil"`,
+				`"github.com/curvegrid/sqlboiler/randomize"`,
 			},
 		},
 		"boil_queries_test": {
@@ -308,6 +326,39 @@ func newImporter() importer {
 		},
 	}
 
+	imp.TestTxMain = mapImports{
+		"postgres": {
+			standard: importList{
+				`"database/sql"`,
+			},
+			thirdParty: importList{
+				`"github.com/spf13/viper"`,
+				`"github.com/curvegrid/sqlboiler/bdb/drivers"`,
+				`_ "github.com/lib/pq"`,
+			},
+		},
+		"mysql": {
+			standard: importList{
+				`"database/sql"`,
+			},
+			thirdParty: importList{
+				`"github.com/spf13/viper"`,
+				`"github.com/curvegrid/sqlboiler/bdb/drivers"`,
+				`_ "github.com/go-sql-driver/mysql"`,
+			},
+		},
+		"mssql": {
+			standard: importList{
+				`"database/sql"`,
+			},
+			thirdParty: importList{
+				`"github.com/spf13/viper"`,
+				`"github.com/curvegrid/sqlboiler/bdb/drivers"`,
+				`_ "github.com/denisenkom/go-mssqldb"`,
+			},
+		},
+	}
+
 	// basedOnType imports are only included in the template output if the
 	// database requires one of the following special types. Check
 	// TranslateColumnType to see the type assignments.
@@ -27,6 +27,9 @@ var (
 
 	rgxRemoveNumberedPrefix = regexp.MustCompile(`[0-9]+_`)
 	rgxSyntaxError          = regexp.MustCompile(`(\d+):\d+: `)
+	// rgxSchemaTableConcat matches the empty strings SchemaTableIn leaves
+	// around a runtime schema lookup at the ends of a string literal
+	rgxSchemaTableConcat = regexp.MustCompile(`(?:"" \+ )?(schemaTable\([^)]*\))(?: \+ "")?`)
 
 	testHarnessWriteFile = ioutil.WriteFile
 )
@@ -64,19 +67,36 @@ func generateSingletonOutput(state *State, data *templateData) error {
 		templates:      state.SingletonTemplates,
 		importNamedSet: state.Importer.Singleton,
 		fileSuffix:     ".go",
+		skip: func(name string) bool {
+			return !exportSingleton(state.Config, name)
+		},
 	})
 }
 
 // generateSingletonTestOutput processes the templates that should only be run
-// one time.
+// one time, along with the singleton templates not exported from the package.
 func generateSingletonTestOutput(state *State, data *templateData) error {
-	return executeSingletonTemplates(executeTemplateData{
+	err := executeSingletonTemplates(executeTemplateData{
 		state:          state,
 		data:           data,
 		templates:      state.SingletonTestTemplates,
 		importNamedSet: state.Importer.TestSingleton,
 		fileSuffix:     ".go",
 	})
+	if err != nil {
+		return err
+	}
+
+	return executeSingletonTemplates(executeTemplateData{
+		state:          state,
+		data:           data,
+		templates:      state.SingletonTemplates,
+		importNamedSet: state.Importer.Singleton,
+		fileSuffix:     "_test.go",
+		skip: func(name string) bool {
+			return exportSingleton(state.Config, name)
+		},
+	})
 }
 
 type executeTemplateData struct {
@@ -91,6 +111,10 @@ type executeTemplateData struct {
 	combineImportsOnType bool
 
 	fileSuffix string
+
+	// skip reports whether a singleton template is left out, by the name of
+	// its file
+	skip func(name string) bool
 }
 
 func executeTemplates(e executeTemplateData) error {
@@ -119,7 +143,7 @@ func executeTemplates(e executeTemplateData) error {
 	}
 
 	fName := e.data.Table.Name + e.fileSuffix
-	if err := writeFile(e.state.Config.OutFolder, fName, out); err != nil {
+	if err := e.state.writeOutput(fName, out); err != nil {
 		return err
 	}
 
@@ -138,6 +162,9 @@ func executeSingletonTemplates(e executeTemplateData) error {
 		fName := tplName
 		ext := filepath.Ext(fName)
 		fName = rgxRemoveNumberedPrefix.ReplaceAllString(fName[:len(fName)-len(ext)], "")
+		if e.skip != nil && e.skip(fName) {
+			continue
+		}
 
 		imps := imports{
 			standard:   e.importNamedSet[fName].standard,
@@ -152,7 +179,7 @@ func executeSingletonTemplates(e executeTemplateData) error {
 			return err
 		}
 
-		if err := writeFile(e.state.Config.OutFolder, fName+e.fileSuffix, out); err != nil {
+		if err := e.state.writeOutput(fName+e.fileSuffix, out); err != nil {
 			return err
 		}
 	}
@@ -168,9 +195,14 @@ func generateTestMainOutput(state *State, data *templateData) error {
 	out := templateByteBuffer
 	out.Reset()
 
+	mainImports := state.Importer.TestMain
+	if state.Config.TestTransactions {
+		mainImports = state.Importer.TestTxMain
+	}
+
 	var imps imports
-	imps.standard = state.Importer.TestMain[state.Config.DriverName].standard
-	imps.thirdParty = state.Importer.TestMain[state.Config.DriverName].thirdParty
+	imps.standard = mainImports[state.Config.DriverName].standard
+	imps.thirdParty = mainImports[state.Config.DriverName].thirdParty
 
 	writeFileDisclaimer(out)
 	writePackageName(out, state.Config.PkgName)
@@ -180,7 +212,7 @@ func generateTestMainOutput(state *State, data *templateData) error {
 		return err
 	}
 
-	if err := writeFile(state.Config.OutFolder, "main_test.go", out); err != nil {
+	if err := state.writeOutput("main_test.go", out); err != nil {
 		return err
 	}
 
@@ -207,6 +239,19 @@ func writeImports(out *bytes.Buffer, imps imports) {
 	}
 }
 
+// writeOutput writes the file to the output folder and records it as
+// generated by the current run.
+func (s *State) writeOutput(fileName string, input *bytes.Buffer) error {
+	if err := writeFile(s.Config.OutFolder, fileName, input); err != nil {
+		return err
+	}
+
+	if s.written != nil {
+		s.written[fileName] = struct{}{}
+	}
+	return nil
+}
+
 // writeFile writes to the given folder and filename, formatting the buffer
 // given.
 func writeFile(outFolder string, fileName string, input *bytes.Buffer) error {
@@ -233,7 +278,7 @@ func executeTemplate(buf *bytes.Buffer, t *template.Template, name string, data
 }
 
 func formatBuffer(buf *bytes.Buffer) ([]byte, error) {
-	output, err := format.Source(buf.Bytes())
+	output, err := format.Source(rgxSchemaTableConcat.ReplaceAll(buf.Bytes(), []byte("$1")))
 	if err == nil {
 		return output, nil
 	}
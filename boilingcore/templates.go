@@ -3,6 +3,8 @@ package boilingcore
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -12,6 +14,7 @@ import (
 	"github.com/curvegrid/sqlboiler/bdb"
 	"github.com/curvegrid/sqlboiler/queries"
 	"github.com/curvegrid/sqlboiler/strmangle"
+	"github.com/curvegrid/sqlboiler/templatebin"
 )
 
 // templateData for sqlboiler templates
@@ -21,15 +24,28 @@ type templateData struct {
 
 	// Controls what names are output
 	PkgName string
-	Schema  string
+	// Schema is used for tables that are not in Tables
+	Schema string
+	// DriverSchema is the schema the driver uses for unqualified tables
+	DriverSchema string
+	// RuntimeSchema looks the schema of queries up from their executor
+	RuntimeSchema bool
 
 	// Controls which code is output (mysql vs postgres ...)
 	DriverName      string
 	UseLastInsertID bool
 
-	// Turn off auto timestamps or hook generation
+	// Turn off auto timestamps, hook generation or validation before writes
 	NoHooks          bool
 	NoAutoTimestamps bool
+	NoValidate       bool
+
+	// Return the number of rows affected from updates and deletes
+	RowsAffected bool
+
+	// Run the generated tests in transactions on the configured database,
+	// which keeps its foreign keys, rather than on a copy of its schema
+	TestTransactions bool
 
 	// Tags control which
 	Tags []string
@@ -50,8 +66,30 @@ func (t templateData) Quotes(s string) string {
 	return fmt.Sprintf("%s%s%s", t.LQ, s, t.RQ)
 }
 
+// SchemaTable quotes a table given by its Name along with its schema, the
+// schema is left out where the driver doesn't need it. See SchemaTableIn for
+// packages generated with a runtime schema.
 func (t templateData) SchemaTable(table string) string {
-	return strmangle.SchemaTable(t.LQ, t.RQ, t.DriverName, t.Schema, table)
+	return t.SchemaTableIn("exec", table)
+}
+
+// SchemaTableIn is SchemaTable for use in a Go string literal of a function
+// whose executor is named exec. With a runtime schema the literal is split
+// around a call looking up the schema from the executor.
+func (t templateData) SchemaTableIn(exec, table string) string {
+	schema, name := t.Schema, table
+	for _, tbl := range t.Tables {
+		if tbl.Name == table {
+			schema, name = tbl.SchemaName, tbl.SQLName
+			break
+		}
+	}
+
+	if t.RuntimeSchema {
+		return fmt.Sprintf(`" + schemaTable(%s, %q, %q) + "`, exec, schema, name)
+	}
+
+	return strmangle.SchemaTable(t.LQ, t.RQ, t.DriverName, schema, name)
 }
 
 type templateList struct {
@@ -102,47 +140,82 @@ func (t templateList) Templates() []string {
 	return ret
 }
 
-// loadTemplates loads all of the template files in the specified directory.
-func loadTemplates(dir string) (*templateList, error) {
-	pattern := filepath.Join(dir, "*.tpl")
-	tpl, err := template.New("").Funcs(templateFunctions).ParseGlob(pattern)
+// loadTemplates parses the template files given, keyed by file name.
+func loadTemplates(files map[string][]byte) (*templateList, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-	if err != nil {
-		return nil, err
+	tpl := template.New("").Funcs(templateFunctions)
+	for _, name := range names {
+		if _, err := tpl.New(name).Parse(string(files[name])); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse template file: %s", name)
+		}
 	}
 
-	return &templateList{Template: tpl}, err
+	return &templateList{Template: tpl}, nil
 }
 
-// loadTemplate loads a single template file
-func loadTemplate(dir string, filename string) (*template.Template, error) {
-	pattern := filepath.Join(dir, filename)
-	tpl, err := template.New("").Funcs(templateFunctions).ParseFiles(pattern)
+// loadTemplate parses a single template file from the given files
+func loadTemplate(files map[string][]byte, filename string) (*template.Template, error) {
+	contents, ok := files[filename]
+	if !ok {
+		return nil, errors.Errorf("template file not found: %s", filename)
+	}
 
+	tpl, err := template.New(filename).Funcs(templateFunctions).Parse(string(contents))
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrapf(err, "failed to parse template file: %s", filename)
 	}
 
-	return tpl.Lookup(filename), err
+	return tpl, nil
 }
 
-// replaceTemplate finds the template matching with name and replaces its
-// contents with the contents of the template located at filename
-func replaceTemplate(tpl *template.Template, name, filename string) error {
-	if tpl == nil {
-		return fmt.Errorf("template for %s is nil", name)
+// readTemplateDir reads the .tpl files directly inside of dir. A missing
+// directory is not an error, it simply has no templates.
+func readTemplateDir(dir string) (map[string][]byte, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return map[string][]byte{}, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to read template dir: %s", dir)
 	}
 
-	b, err := ioutil.ReadFile(filename)
-	if err != nil {
-		return errors.Wrapf(err, "failed reading template file: %s", filename)
+	files := make(map[string][]byte)
+	for _, inf := range infos {
+		if inf.IsDir() || filepath.Ext(inf.Name()) != ".tpl" {
+			continue
+		}
+
+		b, err := ioutil.ReadFile(filepath.Join(dir, inf.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed reading template file: %s", inf.Name())
+		}
+		files[inf.Name()] = b
 	}
 
-	if tpl, err = tpl.New(name).Funcs(templateFunctions).Parse(string(b)); err != nil {
-		return errors.Wrapf(err, "failed to parse template file: %s", filename)
+	return files, nil
+}
+
+// readBundledTemplateDir reads the .tpl files for dir out of the templates
+// compiled into the binary.
+func readBundledTemplateDir(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, name := range templatebin.AssetDir(dir) {
+		if path.Ext(name) != ".tpl" {
+			continue
+		}
+
+		b, err := templatebin.Asset(path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		files[name] = b
 	}
 
-	return nil
+	return files, nil
 }
 
 // set is to stop duplication from named enums, allowing a template loop
@@ -203,6 +276,7 @@ var templateFunctions = template.FuncMap{
 	"containsAny":        strmangle.ContainsAny,
 	"generateTags":       strmangle.GenerateTags,
 	"generateIgnoreTags": strmangle.GenerateIgnoreTags,
+	"docComment":         strmangle.DocComment,
 
 	// Enum ops
 	"parseEnumName":       strmangle.ParseEnumName,
@@ -226,6 +300,7 @@ var templateFunctions = template.FuncMap{
 	"txtsFromFKey":     txtsFromFKey,
 	"txtsFromOneToOne": txtsFromOneToOne,
 	"txtsFromToMany":   txtsFromToMany,
+	"columnLimits":     columnLimits,
 
 	// dbdrivers ops
 	"filterColumnsByAuto":    bdb.FilterColumnsByAuto,
@@ -1,9 +1,15 @@
 package boilingcore
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 	"text/template"
+
+	"github.com/curvegrid/sqlboiler/bdb"
 )
 
 func TestTemplateNameListSort(t *testing.T) {
@@ -66,3 +72,132 @@ func TestTemplateList_Templates(t *testing.T) {
 		t.Error("don't want not")
 	}
 }
+
+func TestTemplateFiles(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "boil_templatedir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = os.MkdirAll(filepath.Join(dir, templatesDirectory), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, templatesDirectory, "00_struct.tpl"), []byte("shadowed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, templatesDirectory, "50_extra.tpl"), []byte("extra"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &State{Config: &Config{}}
+	bundled, err := s.templateFiles(templatesDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bundled["00_struct.tpl"]) == 0 {
+		t.Error("want the bundled struct template")
+	}
+
+	s.Config.TemplateDirs = []string{dir}
+	files, err := s.templateFiles(templatesDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != len(bundled)+1 {
+		t.Errorf("want %d files, got %d", len(bundled)+1, len(files))
+	}
+	if got := string(files["00_struct.tpl"]); got != "shadowed" {
+		t.Errorf("want struct template to be shadowed, got: %q", got)
+	}
+	if got := string(files["50_extra.tpl"]); got != "extra" {
+		t.Errorf("want the extra template, got: %q", got)
+	}
+
+	// Directories missing from the template dir fall back to the base
+	files, err = s.templateFiles(templatesSingletonDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files["boil_queries.tpl"]; !ok {
+		t.Error("want the bundled singleton templates")
+	}
+
+	s.Config.BaseDir = dir
+	s.Config.TemplateDirs = nil
+	if _, err = s.templateFiles(templatesSingletonDirectory); err == nil {
+		t.Error("want an error for a base dir without templates")
+	}
+}
+
+func TestCheckTemplateDirs(t *testing.T) {
+	t.Parallel()
+
+	var dirs []string
+	for i := 0; i < 2; i++ {
+		dir, err := ioutil.TempDir("", "boil_templatedir")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		if err = os.MkdirAll(filepath.Join(dir, templatesTestMainDirectory), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, templatesTestMainDirectory, "mock_main.tpl"), []byte("main"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		dirs = append(dirs, dir)
+	}
+
+	s := &State{Config: &Config{TemplateDirs: dirs[:1]}}
+	if err := s.checkTemplateDirs(); err != nil {
+		t.Error(err)
+	}
+
+	s.Config.TemplateDirs = dirs
+	err := s.checkTemplateDirs()
+	if err == nil {
+		t.Fatal("want an error for conflicting template dirs")
+	}
+	if !strings.Contains(err.Error(), "templates_test/main_test/mock_main.tpl") {
+		t.Errorf("want the conflicting file in the error: %s", err)
+	}
+
+	s.Config.TemplateDirs = []string{filepath.Join(dirs[0], "missing")}
+	if err = s.checkTemplateDirs(); err == nil {
+		t.Error("want an error for a missing template dir")
+	}
+}
+
+func TestTemplateDataSchemaTable(t *testing.T) {
+	t.Parallel()
+
+	data := templateData{
+		Tables: []bdb.Table{
+			{Name: "pilots", SQLName: "pilots", SchemaName: "public"},
+			{Name: "billing_pilots", SQLName: "pilots", SchemaName: "billing"},
+		},
+		Schema:     "public",
+		DriverName: "postgres",
+		LQ:         `"`,
+		RQ:         `"`,
+	}
+
+	tests := []struct {
+		Table string
+		Want  string
+	}{
+		{"pilots", `"pilots"`},
+		{"billing_pilots", `"billing"."pilots"`},
+		{"unknown", `"unknown"`},
+	}
+
+	for i, test := range tests {
+		if got := data.SchemaTable(test.Table); got != test.Want {
+			t.Errorf("%d) want: %s, got: %s", i, test.Want, got)
+		}
+	}
+}
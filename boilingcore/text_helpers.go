@@ -2,17 +2,37 @@ package boilingcore
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/curvegrid/sqlboiler/bdb"
 	"github.com/curvegrid/sqlboiler/strmangle"
 )
 
+// TxtKeyColumn contains the text for one pair of columns making up the key
+// of a relationship.
+type TxtKeyColumn struct {
+	LocalColumn     string
+	LocalColumnGo   string
+	LocalNullable   bool
+	ForeignColumn   string
+	ForeignColumnGo string
+	ForeignNullable bool
+
+	UsesBytes bool
+
+	LocalAssignment   string
+	ForeignAssignment string
+}
+
 // TxtToOne contains text that will be used by templates for a one-to-many or
-// a one-to-one relationship.
+// a one-to-one relationship. The single column fields describe the first
+// column of the key, Columns describes all of them.
 type TxtToOne struct {
 	ForeignKey bdb.ForeignKey
 
+	Columns []TxtKeyColumn
+
 	LocalTable struct {
 		NameGo       string
 		ColumnNameGo string
@@ -69,6 +89,8 @@ func txtsFromFKey(tables []bdb.Table, table bdb.Table, fkey bdb.ForeignKey) TxtT
 
 	r.Function.UsesBytes = foreignColumn.Type == "[]byte"
 
+	r.Columns = txtKeyColumns(table, foreignTable, fkey.Columns, fkey.ForeignColumns)
+
 	return r
 }
 
@@ -77,11 +99,13 @@ func txtsFromOneToOne(tables []bdb.Table, table bdb.Table, oneToOne bdb.ToOneRel
 		Table:    oneToOne.Table,
 		Name:     "none",
 		Column:   oneToOne.Column,
+		Columns:  oneToOne.Columns,
 		Nullable: oneToOne.Nullable,
 		Unique:   oneToOne.Unique,
 
 		ForeignTable:          oneToOne.ForeignTable,
 		ForeignColumn:         oneToOne.ForeignColumn,
+		ForeignColumns:        oneToOne.ForeignColumns,
 		ForeignColumnNullable: oneToOne.ForeignColumnNullable,
 		ForeignColumnUnique:   oneToOne.ForeignColumnUnique,
 	}
@@ -92,21 +116,28 @@ func txtsFromOneToOne(tables []bdb.Table, table bdb.Table, oneToOne bdb.ToOneRel
 	// Reverse foreign key
 	rel.ForeignKey.Table, rel.ForeignKey.ForeignTable = rel.ForeignKey.ForeignTable, rel.ForeignKey.Table
 	rel.ForeignKey.Column, rel.ForeignKey.ForeignColumn = rel.ForeignKey.ForeignColumn, rel.ForeignKey.Column
+	rel.ForeignKey.Columns, rel.ForeignKey.ForeignColumns = rel.ForeignKey.ForeignColumns, rel.ForeignKey.Columns
 	rel.ForeignKey.Nullable, rel.ForeignKey.ForeignColumnNullable = rel.ForeignKey.ForeignColumnNullable, rel.ForeignKey.Nullable
 	rel.ForeignKey.Unique, rel.ForeignKey.ForeignColumnUnique = rel.ForeignKey.ForeignColumnUnique, rel.ForeignKey.Unique
 	rel.Function.UsesBytes = col.Type == "[]byte"
 	rel.Function.ForeignName, rel.Function.Name = txtNameToOne(bdb.ForeignKey{
-		Table:         oneToOne.ForeignTable,
-		Column:        oneToOne.ForeignColumn,
-		Unique:        true,
-		ForeignTable:  oneToOne.Table,
-		ForeignColumn: oneToOne.Column,
+		Table:          oneToOne.ForeignTable,
+		Column:         oneToOne.ForeignColumn,
+		Columns:        oneToOne.ForeignColumns,
+		Unique:         true,
+		ForeignTable:   oneToOne.Table,
+		ForeignColumn:  oneToOne.Column,
+		ForeignColumns: oneToOne.Columns,
 	})
 	return rel
 }
 
 // TxtToMany contains text that will be used by many-to-one relationships.
+// The single column fields describe the first column of the key, Columns
+// describes all of them.
 type TxtToMany struct {
+	Columns []TxtKeyColumn
+
 	LocalTable struct {
 		NameGo       string
 		ColumnNameGo string
@@ -164,9 +195,51 @@ func txtsFromToMany(tables []bdb.Table, table bdb.Table, rel bdb.ToManyRelations
 
 	r.Function.UsesBytes = col.Type == "[]byte"
 
+	r.Columns = txtKeyColumns(table, bdb.GetTable(tables, rel.ForeignTable), rel.Columns, rel.ForeignColumns)
+
 	return r
 }
 
+// txtKeyColumns pairs up the local and foreign columns of a key
+func txtKeyColumns(localTable, foreignTable bdb.Table, localColumns, foreignColumns []string) []TxtKeyColumn {
+	cols := make([]TxtKeyColumn, len(localColumns))
+
+	for i, name := range localColumns {
+		localCol := localTable.GetColumn(name)
+		foreignCol := foreignTable.GetColumn(foreignColumns[i])
+
+		cols[i] = TxtKeyColumn{
+			LocalColumn:       localCol.Name,
+			LocalColumnGo:     strmangle.TitleCase(localCol.Name),
+			LocalNullable:     localCol.Nullable,
+			LocalAssignment:   txtAssignment(localCol),
+			ForeignColumn:     foreignCol.Name,
+			ForeignColumnGo:   strmangle.TitleCase(foreignCol.Name),
+			ForeignNullable:   foreignCol.Nullable,
+			ForeignAssignment: txtAssignment(foreignCol),
+			UsesBytes:         txtUsesBytes(localCol) || txtUsesBytes(foreignCol),
+		}
+	}
+
+	return cols
+}
+
+// txtAssignment is the field expression holding the value of a column,
+// which for a nullable column is the value inside the null type.
+func txtAssignment(col bdb.Column) string {
+	if col.Nullable {
+		return fmt.Sprintf("%s.%s", strmangle.TitleCase(col.Name), strings.TrimPrefix(col.Type, "null."))
+	}
+
+	return strmangle.TitleCase(col.Name)
+}
+
+// txtUsesBytes returns true if the value of the column is a byte slice that
+// can't be compared with ==
+func txtUsesBytes(col bdb.Column) bool {
+	return col.Type == "[]byte" || col.Type == "null.Bytes"
+}
+
 // txtNameToOne creates the local and foreign function names for
 // one-to-many and one-to-one relationships, where local == lhs (one).
 //
@@ -195,8 +268,11 @@ func txtsFromToMany(tables []bdb.Table, table bdb.Table, rel bdb.ToManyRelations
 //
 // fk == table = industry.Industry | industry.Industry
 // fk != table = industry.ParentIndustry | industry.Industry
+//
+// Composite keys are named after the first column that differs from the one
+// it references, see txtNameColumn.
 func txtNameToOne(fk bdb.ForeignKey) (localFn, foreignFn string) {
-	localFn = strmangle.Singular(trimSuffixes(fk.Column))
+	localFn = strmangle.Singular(trimSuffixes(txtNameColumn(fk.Column, fk.Columns, fk.ForeignColumns, fk.ForeignTable)))
 	fkeyIsTableName := localFn != strmangle.Singular(fk.ForeignTable)
 	localFn = strmangle.TitleCase(localFn)
 
@@ -251,7 +327,7 @@ func txtNameToMany(toMany bdb.ToManyRelationship) (localFn, foreignFn string) {
 		return localFn, foreignFn
 	}
 
-	fkeyName := strmangle.Singular(trimSuffixes(toMany.ForeignColumn))
+	fkeyName := strmangle.Singular(trimSuffixes(txtNameColumn(toMany.ForeignColumn, toMany.ForeignColumns, toMany.Columns, toMany.Table)))
 	if fkeyName != strmangle.Singular(toMany.Table) {
 		localFn = strmangle.TitleCase(fkeyName)
 	}
@@ -260,6 +336,25 @@ func txtNameToMany(toMany bdb.ToManyRelationship) (localFn, foreignFn string) {
 	return localFn, foreignFn
 }
 
+// txtNameColumn returns the column of a key that a relationship is named
+// after. For a composite key columns that share their name with the column
+// they reference are skipped, like an organization_id on both sides of a
+// multi-tenant key, they say nothing about what is being pointed to. When
+// every column is shared the relationship is named after the foreign table.
+func txtNameColumn(column string, columns, foreignColumns []string, foreignTable string) string {
+	if len(columns) < 2 {
+		return column
+	}
+
+	for i, c := range columns {
+		if c != foreignColumns[i] {
+			return c
+		}
+	}
+
+	return strmangle.Singular(foreignTable)
+}
+
 // mkFunctionName checks to see if the foreign key name is the same as the local table name (minus _id suffix)
 // Simple case: yes - we can name the function the same as the plural table name
 // Not simple case: We have to name the function based off the foreign key and the foreign table name
@@ -286,3 +381,59 @@ func trimSuffixes(str string) string {
 
 	return str
 }
+
+// columnLimits returns the entries of the boil.ColumnLimits map of a table,
+// one per column that has limits, as Go source. A column is NotNull when the
+// database would refuse the nil its Go type can hold: it is not nullable and
+// has no default to fall back on.
+func columnLimits(columns []bdb.Column) []string {
+	var entries []string
+
+	for _, c := range columns {
+		var fields []string
+
+		if !c.Nullable && len(c.Default) == 0 && canBeNil(c.Type) {
+			fields = append(fields, "NotNull: true")
+		}
+		if c.MaxLength > 0 {
+			fields = append(fields, fmt.Sprintf("MaxLength: %d", c.MaxLength))
+		}
+		if c.Precision > 0 {
+			fields = append(fields, fmt.Sprintf("Precision: %d, Scale: %d", c.Precision, c.Scale))
+		}
+		if c.Check.HasMin {
+			fields = append(fields, fmt.Sprintf("Min: %v, HasMin: true", c.Check.Min))
+			if c.Check.ExclusiveMin {
+				fields = append(fields, "ExclusiveMin: true")
+			}
+		}
+		if c.Check.HasMax {
+			fields = append(fields, fmt.Sprintf("Max: %v, HasMax: true", c.Check.Max))
+			if c.Check.ExclusiveMax {
+				fields = append(fields, "ExclusiveMax: true")
+			}
+		}
+		if len(c.Check.In) != 0 {
+			in := make([]string, len(c.Check.In))
+			for i, v := range c.Check.In {
+				in[i] = strconv.Quote(v)
+			}
+			fields = append(fields, fmt.Sprintf("In: []string{%s}", strings.Join(in, ", ")))
+		}
+
+		if len(fields) != 0 {
+			entries = append(entries, fmt.Sprintf("%q: {%s}", c.Name, strings.Join(fields, ", ")))
+		}
+	}
+
+	return entries
+}
+
+// canBeNil returns true if a value of the Go type typ can be nil
+func canBeNil(typ string) bool {
+	if typ == "types.Byte" {
+		return false
+	}
+
+	return strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "types.") || typ == "interface{}"
+}
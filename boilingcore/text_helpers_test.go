@@ -2,6 +2,7 @@ package boilingcore
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -12,7 +13,7 @@ import (
 func TestTxtsFromOne(t *testing.T) {
 	t.Parallel()
 
-	tables, err := bdb.Tables(&drivers.MockDriver{}, "public", nil, nil)
+	tables, err := bdb.Tables(&drivers.MockDriver{}, []string{"public"}, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -37,6 +38,16 @@ func TestTxtsFromOne(t *testing.T) {
 	expect.Function.LocalAssignment = "PilotID.Int"
 	expect.Function.ForeignAssignment = "ID"
 
+	expect.Columns = []TxtKeyColumn{{
+		LocalColumn:       "pilot_id",
+		LocalColumnGo:     "PilotID",
+		LocalNullable:     true,
+		ForeignColumn:     "id",
+		ForeignColumnGo:   "ID",
+		LocalAssignment:   "PilotID.Int",
+		ForeignAssignment: "ID",
+	}}
+
 	if !reflect.DeepEqual(expect, texts) {
 		t.Errorf("Want:\n%s\nGot:\n%s\n", spew.Sdump(expect), spew.Sdump(texts))
 	}
@@ -59,6 +70,15 @@ func TestTxtsFromOne(t *testing.T) {
 	expect.Function.LocalAssignment = "AirportID"
 	expect.Function.ForeignAssignment = "ID"
 
+	expect.Columns = []TxtKeyColumn{{
+		LocalColumn:       "airport_id",
+		LocalColumnGo:     "AirportID",
+		ForeignColumn:     "id",
+		ForeignColumnGo:   "ID",
+		LocalAssignment:   "AirportID",
+		ForeignAssignment: "ID",
+	}}
+
 	if !reflect.DeepEqual(expect, texts) {
 		t.Errorf("Want:\n%s\nGot:\n%s\n", spew.Sdump(expect), spew.Sdump(texts))
 	}
@@ -68,10 +88,54 @@ func TestTxtsFromOne(t *testing.T) {
 	}
 }
 
+func TestTxtsFromOneComposite(t *testing.T) {
+	t.Parallel()
+
+	tables, err := bdb.Tables(&drivers.MockDriver{}, []string{"public"}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parkings := bdb.GetTable(tables, "parkings")
+	texts := txtsFromFKey(tables, parkings, parkings.FKeys[0])
+
+	if texts.Function.Name != "HangarSpot" {
+		t.Error("name wrong:", texts.Function.Name)
+	}
+	if texts.Function.ForeignName != "Parkings" {
+		t.Error("foreign name wrong:", texts.Function.ForeignName)
+	}
+
+	expect := []TxtKeyColumn{
+		{
+			LocalColumn:       "hangar_id",
+			LocalColumnGo:     "HangarID",
+			LocalNullable:     true,
+			ForeignColumn:     "hangar_id",
+			ForeignColumnGo:   "HangarID",
+			LocalAssignment:   "HangarID.Int",
+			ForeignAssignment: "HangarID",
+		},
+		{
+			LocalColumn:       "spot",
+			LocalColumnGo:     "Spot",
+			LocalNullable:     true,
+			ForeignColumn:     "spot",
+			ForeignColumnGo:   "Spot",
+			LocalAssignment:   "Spot.Int",
+			ForeignAssignment: "Spot",
+		},
+	}
+
+	if !reflect.DeepEqual(expect, texts.Columns) {
+		t.Errorf("Want:\n%s\nGot:\n%s\n", spew.Sdump(expect), spew.Sdump(texts.Columns))
+	}
+}
+
 func TestTxtsFromOneToOne(t *testing.T) {
 	t.Parallel()
 
-	tables, err := bdb.Tables(&drivers.MockDriver{}, "public", nil, nil)
+	tables, err := bdb.Tables(&drivers.MockDriver{}, []string{"public"}, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -85,11 +149,13 @@ func TestTxtsFromOneToOne(t *testing.T) {
 
 		Table:    "jets",
 		Column:   "pilot_id",
+		Columns:  []string{"pilot_id"},
 		Nullable: true,
 		Unique:   true,
 
 		ForeignTable:          "pilots",
 		ForeignColumn:         "id",
+		ForeignColumns:        []string{"id"},
 		ForeignColumnNullable: false,
 		ForeignColumnUnique:   false,
 	}
@@ -108,6 +174,16 @@ func TestTxtsFromOneToOne(t *testing.T) {
 	expect.Function.LocalAssignment = "ID"
 	expect.Function.ForeignAssignment = "PilotID.Int"
 
+	expect.Columns = []TxtKeyColumn{{
+		LocalColumn:       "id",
+		LocalColumnGo:     "ID",
+		ForeignColumn:     "pilot_id",
+		ForeignColumnGo:   "PilotID",
+		ForeignNullable:   true,
+		LocalAssignment:   "ID",
+		ForeignAssignment: "PilotID.Int",
+	}}
+
 	if !reflect.DeepEqual(expect, texts) {
 		t.Errorf("Want:\n%s\nGot:\n%s\n", spew.Sdump(expect), spew.Sdump(texts))
 	}
@@ -116,7 +192,7 @@ func TestTxtsFromOneToOne(t *testing.T) {
 func TestTxtsFromMany(t *testing.T) {
 	t.Parallel()
 
-	tables, err := bdb.Tables(&drivers.MockDriver{}, "public", nil, nil)
+	tables, err := bdb.Tables(&drivers.MockDriver{}, []string{"public"}, nil, nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -138,6 +214,15 @@ func TestTxtsFromMany(t *testing.T) {
 	expect.Function.LocalAssignment = "ID"
 	expect.Function.ForeignAssignment = "PilotID"
 
+	expect.Columns = []TxtKeyColumn{{
+		LocalColumn:       "id",
+		LocalColumnGo:     "ID",
+		ForeignColumn:     "pilot_id",
+		ForeignColumnGo:   "PilotID",
+		LocalAssignment:   "ID",
+		ForeignAssignment: "PilotID",
+	}}
+
 	if !reflect.DeepEqual(expect, texts) {
 		t.Errorf("Want:\n%s\nGot:\n%s\n", spew.Sdump(expect), spew.Sdump(texts))
 	}
@@ -158,6 +243,15 @@ func TestTxtsFromMany(t *testing.T) {
 	expect.Function.LocalAssignment = "ID"
 	expect.Function.ForeignAssignment = "ID"
 
+	expect.Columns = []TxtKeyColumn{{
+		LocalColumn:       "id",
+		LocalColumnGo:     "ID",
+		ForeignColumn:     "id",
+		ForeignColumnGo:   "ID",
+		LocalAssignment:   "ID",
+		ForeignAssignment: "ID",
+	}}
+
 	if !reflect.DeepEqual(expect, texts) {
 		t.Errorf("Want:\n%s\nGot:\n%s\n", spew.Sdump(expect), spew.Sdump(texts))
 	}
@@ -272,3 +366,30 @@ func TestTrimSuffixes(t *testing.T) {
 		}
 	}
 }
+
+func TestColumnLimits(t *testing.T) {
+	t.Parallel()
+
+	columns := []bdb.Column{
+		{Name: "id", Type: "int", Default: "nextval('id')"},
+		{Name: "name", Type: "string", MaxLength: 10},
+		{Name: "data", Type: "[]byte"},
+		{Name: "opt", Type: "[]byte", Nullable: true},
+		{Name: "meta", Type: "types.JSON", Default: "'{}'"},
+		{Name: "price", Type: "string", Precision: 5, Scale: 2, Check: bdb.Check{HasMin: true, Min: 0, ExclusiveMin: true}},
+		{Name: "size", Type: "float64", Check: bdb.Check{HasMin: true, Min: 1, HasMax: true, Max: 10.5}},
+		{Name: "status", Type: "string", Check: bdb.Check{In: []string{"new", `"done"`}}},
+	}
+
+	want := []string{
+		`"name": {MaxLength: 10}`,
+		`"data": {NotNull: true}`,
+		`"price": {Precision: 5, Scale: 2, Min: 0, HasMin: true, ExclusiveMin: true}`,
+		`"size": {Min: 1, HasMin: true, Max: 10.5, HasMax: true}`,
+		`"status": {In: []string{"new", "\"done\""}}`,
+	}
+
+	if got := columnLimits(columns); !reflect.DeepEqual(got, want) {
+		t.Errorf("want:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
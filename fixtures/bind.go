@@ -0,0 +1,107 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/curvegrid/sqlboiler/boil"
+	"github.com/curvegrid/sqlboiler/queries"
+	"github.com/curvegrid/sqlboiler/strmangle"
+	"github.com/pkg/errors"
+)
+
+// Bind sets the fields of a model, a pointer to a generated struct, from the
+// columns of a row. The values are converted the way encoding/json converts
+// them, so times are given as RFC 3339 strings and nullable columns as their
+// value or null.
+func Bind(row Row, model interface{}) error {
+	val := reflect.ValueOf(model)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("fixtures: want a pointer to a struct, got %T", model)
+	}
+	fields := jsonFields(val.Elem().Type())
+
+	obj := make(map[string]interface{}, len(row))
+	for col, v := range row {
+		name, ok := fields[col]
+		if !ok {
+			return errors.Errorf("fixtures: %T has no column %s", model, col)
+		}
+		obj[name] = v
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return errors.Wrap(err, "fixtures: unable to encode row")
+	}
+	if err = json.Unmarshal(b, model); err != nil {
+		return errors.Wrapf(err, "fixtures: unable to set the fields of %T", model)
+	}
+
+	return nil
+}
+
+// jsonFields maps the columns of a model, named by the boil tags of its
+// fields, to the names of the fields in JSON.
+func jsonFields(typ reflect.Type) map[string]string {
+	fields := map[string]string{}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		col := f.Tag.Get("boil")
+		if len(col) == 0 || col == "-" {
+			continue
+		}
+
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if len(name) == 0 {
+			name = f.Name
+		}
+		fields[col] = name
+	}
+
+	return fields
+}
+
+// Values returns the columns of a model, a pointer to a generated struct
+func Values(model interface{}) Row {
+	val := reflect.Indirect(reflect.ValueOf(model))
+	typ := val.Type()
+
+	row := Row{}
+	for i := 0; i < typ.NumField(); i++ {
+		col := typ.Field(i).Tag.Get("boil")
+		if len(col) == 0 || col == "-" {
+			continue
+		}
+		row[col] = val.Field(i).Interface()
+	}
+
+	return row
+}
+
+// InsertRaw inserts a row into table, a quoted table name, without a model.
+// Generated packages use it for join tables, which have none.
+func InsertRaw(exec boil.Executor, dialect queries.Dialect, table string, row Row) (Row, error) {
+	cols := sortedColumns(row)
+	args := make([]interface{}, len(cols))
+	for i, col := range cols {
+		args[i] = row[col]
+	}
+
+	query := "INSERT INTO " + table + " (" +
+		strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, cols), ",") + ") VALUES (" +
+		strmangle.Placeholders(dialect.IndexPlaceholders, len(cols), 1, 1) + ")"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	if _, err := exec.Exec(query, args...); err != nil {
+		return nil, boil.ClassifyError(err)
+	}
+
+	return row, nil
+}
@@ -0,0 +1,391 @@
+// Package fixtures loads rows described in YAML or JSON files into a
+// database through the models of a generated package, for integration tests
+// and demo environments.
+//
+// A fixture file maps table names to rows, and each row has a label that
+// other rows refer to it by:
+//
+//	pilots:
+//	  alice:
+//	    name: Alice
+//	jets:
+//	  bomber:
+//	    name: Bomber
+//	    pilot: alice
+//
+// A foreign key column may be given as the label of the row it refers to,
+// under the column's name without its _id suffix (pilot for pilot_id). The
+// referenced row is inserted first, and the column is set to the value of
+// the referenced column once it is inserted, so the database may pick it.
+// Foreign keys of several columns are given under the name their Ref sets,
+// which for generated packages is the referenced table's singular name.
+// JSON files have the same shape.
+//
+// Generated packages describe their tables in FixtureTables and wrap Load
+// and Clean as LoadFixtures and CleanFixtures.
+package fixtures
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/curvegrid/sqlboiler/boil"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Row holds the values of a row by column
+type Row map[string]interface{}
+
+// ForeignKey is a foreign key of a Table
+type ForeignKey struct {
+	Columns        []string
+	ForeignTable   string
+	ForeignColumns []string
+
+	// Ref is the name rows give the label of the row they refer to under.
+	// When it is empty it is the column without its _id suffix, foreign keys
+	// of several columns must set it or be given as values.
+	Ref string
+}
+
+// ref returns the name rows give the label of the referenced row under, or
+// "" if they can't refer to one by label
+func (f ForeignKey) ref() string {
+	if len(f.Ref) != 0 {
+		return f.Ref
+	}
+	if len(f.Columns) != 1 || !strings.HasSuffix(f.Columns[0], "_id") {
+		return ""
+	}
+	return strings.TrimSuffix(f.Columns[0], "_id")
+}
+
+// Table describes how rows are loaded into a table
+type Table struct {
+	Name        string
+	ForeignKeys []ForeignKey
+
+	// Insert inserts a row and returns the values of its columns as they
+	// were inserted, with those the database or hooks set filled in.
+	Insert func(exec boil.Executor, row Row) (Row, error)
+	// Clean deletes all the rows of the table
+	Clean func(exec boil.Executor) error
+}
+
+// Loaded holds the rows that were loaded, as returned by Table.Insert, by
+// label by table.
+type Loaded map[string]map[string]Row
+
+// Set is the rows of one or more fixture files
+type Set struct {
+	tables []string
+	labels map[string][]string
+	rows   map[string]map[string]Row
+}
+
+// Read reads the fixture files at paths into a single set. Tables may have
+// rows in several files, but labels must be unique within a table.
+func Read(paths ...string) (*Set, error) {
+	set := newSet()
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read fixtures")
+		}
+		if err = set.parse(b); err != nil {
+			return nil, errors.Wrapf(err, "unable to parse fixtures %s", path)
+		}
+	}
+
+	return set, nil
+}
+
+// Parse parses a fixture file, YAML or JSON
+func Parse(b []byte) (*Set, error) {
+	set := newSet()
+	if err := set.parse(b); err != nil {
+		return nil, errors.Wrap(err, "unable to parse fixtures")
+	}
+
+	return set, nil
+}
+
+func newSet() *Set {
+	return &Set{
+		labels: map[string][]string{},
+		rows:   map[string]map[string]Row{},
+	}
+}
+
+// parse adds the rows of a file to the set. Files are decoded as YAML, which
+// JSON is a subset of, into MapSlices to keep the order of their rows.
+func (s *Set) parse(b []byte) error {
+	var file yaml.MapSlice
+	if err := yaml.Unmarshal(b, &file); err != nil {
+		return err
+	}
+
+	for _, t := range file {
+		table, ok := t.Key.(string)
+		if !ok {
+			return errors.Errorf("table name %v is not a string", t.Key)
+		}
+		rows, ok := t.Value.(yaml.MapSlice)
+		if !ok && t.Value != nil {
+			return errors.Errorf("rows of %s are not a map of labels to rows", table)
+		}
+
+		if _, ok := s.rows[table]; !ok {
+			s.tables = append(s.tables, table)
+			s.rows[table] = map[string]Row{}
+		}
+
+		for _, r := range rows {
+			label, ok := r.Key.(string)
+			if !ok {
+				return errors.Errorf("label %v in %s is not a string", r.Key, table)
+			}
+			if _, ok := s.rows[table][label]; ok {
+				return errors.Errorf("%s has more than one row labeled %s", table, label)
+			}
+
+			cols, ok := r.Value.(yaml.MapSlice)
+			if !ok && r.Value != nil {
+				return errors.Errorf("row %s of %s is not a map of columns to values", label, table)
+			}
+
+			row := Row{}
+			for _, c := range cols {
+				col, ok := c.Key.(string)
+				if !ok {
+					return errors.Errorf("column %v of row %s of %s is not a string", c.Key, label, table)
+				}
+				row[col] = convert(c.Value)
+			}
+
+			s.labels[table] = append(s.labels[table], label)
+			s.rows[table][label] = row
+		}
+	}
+
+	return nil
+}
+
+// convert turns the maps YAML decodes into maps with string keys, which
+// encoding/json and drivers can handle.
+func convert(v interface{}) interface{} {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		m := make(map[string]interface{}, len(v))
+		for _, item := range v {
+			m[toString(item.Key)] = convert(item.Value)
+		}
+		return m
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[toString(k)] = convert(item)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = convert(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func toString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, _ := yaml.Marshal(v)
+	return strings.TrimSpace(string(b))
+}
+
+// Tables returns the names of the tables the set has rows for, in the order
+// their rows are inserted: every table after those its foreign keys refer to,
+// otherwise in the order they first appear in the files.
+func (s *Set) Tables(tables []Table) ([]string, error) {
+	byName := tablesByName(tables)
+	for _, name := range s.tables {
+		if _, ok := byName[name]; !ok {
+			return nil, errors.Errorf("fixtures have rows for unknown table %s", name)
+		}
+	}
+
+	var order []string
+	done := map[string]bool{}
+	for len(order) < len(s.tables) {
+		progress := false
+		for _, name := range s.tables {
+			if done[name] || !s.dependenciesDone(byName[name], done) {
+				continue
+			}
+			done[name] = true
+			order = append(order, name)
+			progress = true
+		}
+
+		if !progress {
+			var cycle []string
+			for _, name := range s.tables {
+				if !done[name] {
+					cycle = append(cycle, name)
+				}
+			}
+			return nil, errors.Errorf("foreign keys of tables %s form a cycle", strings.Join(cycle, ", "))
+		}
+	}
+
+	return order, nil
+}
+
+// dependenciesDone reports whether the tables of the set that t refers to,
+// other than itself, are done.
+func (s *Set) dependenciesDone(t Table, done map[string]bool) bool {
+	for _, fkey := range t.ForeignKeys {
+		if fkey.ForeignTable == t.Name {
+			continue
+		}
+		if _, ok := s.rows[fkey.ForeignTable]; ok && !done[fkey.ForeignTable] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Load inserts the rows of the set with exec, in a transaction, or a
+// savepoint if exec is one.
+func (s *Set) Load(exec boil.Executor, tables []Table) (Loaded, error) {
+	order, err := s.Tables(tables)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := tablesByName(tables)
+	var l *loader
+	err = boil.Transact(exec, func(tx boil.Transactor) error {
+		// Transact runs this again when the transaction is retried, the rows
+		// of a rolled back attempt are gone and have to be inserted again
+		l = &loader{set: s, tables: byName, loaded: Loaded{}, loading: map[string]bool{}}
+		for _, table := range order {
+			for _, label := range s.labels[table] {
+				if _, err := l.load(tx, table, label); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return l.loaded, nil
+}
+
+// Clean deletes all the rows of the tables the set has rows for, in the
+// reverse of the order they are loaded in.
+func (s *Set) Clean(exec boil.Executor, tables []Table) error {
+	order, err := s.Tables(tables)
+	if err != nil {
+		return err
+	}
+
+	byName := tablesByName(tables)
+	return boil.Transact(exec, func(tx boil.Transactor) error {
+		for i := len(order) - 1; i >= 0; i-- {
+			if err := byName[order[i]].Clean(tx); err != nil {
+				return errors.Wrapf(err, "unable to clean %s", order[i])
+			}
+		}
+		return nil
+	})
+}
+
+type loader struct {
+	set     *Set
+	tables  map[string]Table
+	loaded  Loaded
+	loading map[string]bool
+}
+
+// load inserts a row after the rows it refers to, unless it was already
+func (l *loader) load(exec boil.Executor, table, label string) (Row, error) {
+	if row, ok := l.loaded[table][label]; ok {
+		return row, nil
+	}
+
+	key := table + "." + label
+	if l.loading[key] {
+		return nil, errors.Errorf("row %s of %s refers back to itself", label, table)
+	}
+	l.loading[key] = true
+
+	t := l.tables[table]
+	row := Row{}
+	for col, val := range l.set.rows[table][label] {
+		row[col] = val
+	}
+
+	for _, fkey := range t.ForeignKeys {
+		ref := fkey.ref()
+		val, ok := row[ref]
+		if len(ref) == 0 || !ok {
+			continue
+		}
+
+		parentLabel, ok := val.(string)
+		if !ok {
+			return nil, errors.Errorf("%s of row %s of %s must be the label of a %s row", ref, label, table, fkey.ForeignTable)
+		}
+		if _, ok := l.set.rows[fkey.ForeignTable][parentLabel]; !ok {
+			return nil, errors.Errorf("%s of row %s of %s refers to unknown %s row %s", ref, label, table, fkey.ForeignTable, parentLabel)
+		}
+
+		parent, err := l.load(exec, fkey.ForeignTable, parentLabel)
+		if err != nil {
+			return nil, err
+		}
+
+		delete(row, ref)
+		for i, col := range fkey.Columns {
+			row[col] = parent[fkey.ForeignColumns[i]]
+		}
+	}
+
+	inserted, err := t.Insert(exec, row)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to insert row %s of %s", label, table)
+	}
+
+	if l.loaded[table] == nil {
+		l.loaded[table] = map[string]Row{}
+	}
+	l.loaded[table][label] = inserted
+	return inserted, nil
+}
+
+func tablesByName(tables []Table) map[string]Table {
+	byName := make(map[string]Table, len(tables))
+	for _, t := range tables {
+		byName[t.Name] = t
+	}
+	return byName
+}
+
+// sortedColumns returns the columns of a row in order
+func sortedColumns(row Row) []string {
+	cols := make([]string, 0, len(row))
+	for col := range row {
+		cols = append(cols, col)
+	}
+	sort.Strings(cols)
+	return cols
+}
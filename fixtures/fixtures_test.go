@@ -0,0 +1,328 @@
+package fixtures
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/curvegrid/sqlboiler/boil"
+	"github.com/curvegrid/sqlboiler/boil/boiltest"
+	"github.com/curvegrid/sqlboiler/queries"
+	"github.com/pkg/errors"
+)
+
+type pilot struct {
+	ID       int    `boil:"id" json:"id"`
+	Name     string `boil:"name" json:"name"`
+	MentorID int    `boil:"mentor_id" json:"mentorId,omitempty"`
+
+	R *struct{} `boil:"-" json:"-"`
+}
+
+type jet struct {
+	ID      int    `boil:"id" json:"id"`
+	PilotID int    `boil:"pilot_id" json:"pilot_id"`
+	Name    string `boil:"name" json:"name"`
+}
+
+// testTables describes pilots and jets, inserting them by giving them the
+// next id, and records what they do in log.
+func testTables(log *[]string) []Table {
+	ids := 0
+	insert := func(model interface{}, name string) func(boil.Executor, Row) (Row, error) {
+		return func(exec boil.Executor, row Row) (Row, error) {
+			o := reflect.New(reflect.TypeOf(model)).Interface()
+			if err := Bind(row, o); err != nil {
+				return nil, err
+			}
+			ids++
+			reflect.ValueOf(o).Elem().Field(0).SetInt(int64(ids))
+			*log = append(*log, "insert "+name)
+			return Values(o), nil
+		}
+	}
+	clean := func(name string) func(boil.Executor) error {
+		return func(boil.Executor) error {
+			*log = append(*log, "clean "+name)
+			return nil
+		}
+	}
+
+	return []Table{
+		{
+			Name:        "jets",
+			ForeignKeys: []ForeignKey{{Columns: []string{"pilot_id"}, ForeignTable: "pilots", ForeignColumns: []string{"id"}}},
+			Insert:      insert(jet{}, "jet"),
+			Clean:       clean("jets"),
+		},
+		{
+			Name:        "pilots",
+			ForeignKeys: []ForeignKey{{Columns: []string{"mentor_id"}, ForeignTable: "pilots", ForeignColumns: []string{"id"}}},
+			Insert:      insert(pilot{}, "pilot"),
+			Clean:       clean("pilots"),
+		},
+	}
+}
+
+const testFixtures = `
+jets:
+  bomber:
+    name: Bomber
+    pilot: alice
+pilots:
+  alice:
+    name: Alice
+    mentor: bob
+  bob:
+    name: Bob
+`
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	set, err := Parse([]byte(testFixtures))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var log []string
+	tables := testTables(&log)
+
+	order, err := set.Tables(tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"pilots", "jets"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("want: %v, got: %v", want, order)
+	}
+
+	exec := boiltest.New()
+	defer exec.Close()
+
+	loaded, err := set.Load(exec, tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"insert pilot", "insert pilot", "insert jet"}; !reflect.DeepEqual(log, want) {
+		t.Errorf("want: %v, got: %v", want, log)
+	}
+
+	bob, alice, bomber := loaded["pilots"]["bob"], loaded["pilots"]["alice"], loaded["jets"]["bomber"]
+	if bob["id"] != 1 || alice["id"] != 2 || alice["mentor_id"] != 1 || alice["name"] != "Alice" {
+		t.Errorf("wrong pilots, bob: %v, alice: %v", bob, alice)
+	}
+	if bomber["pilot_id"] != 2 || bomber["name"] != "Bomber" {
+		t.Errorf("wrong jet: %v", bomber)
+	}
+
+	log = nil
+	if err = set.Clean(exec, tables); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"clean jets", "clean pilots"}; !reflect.DeepEqual(log, want) {
+		t.Errorf("want: %v, got: %v", want, log)
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	t.Parallel()
+
+	set, err := Parse([]byte(`{"pilots": {"bob": {"name": "Bob", "mentor_id": null}, "alice": {"name": "Alice"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"bob", "alice"}; !reflect.DeepEqual(set.labels["pilots"], want) {
+		t.Errorf("want labels in file order %v, got: %v", want, set.labels["pilots"])
+	}
+	if want := (Row{"name": "Bob", "mentor_id": nil}); !reflect.DeepEqual(set.rows["pilots"]["bob"], want) {
+		t.Errorf("want: %v, got: %v", want, set.rows["pilots"]["bob"])
+	}
+}
+
+func TestLoadRetry(t *testing.T) {
+	t.Parallel()
+
+	set, err := Parse([]byte(testFixtures))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var log []string
+	tables := testTables(&log)
+
+	// The first jet conflicts with another transaction, so Transact rolls
+	// back the pilots and runs the load again
+	insertJet := tables[0].Insert
+	failed := false
+	tables[0].Insert = func(exec boil.Executor, row Row) (Row, error) {
+		if !failed {
+			failed = true
+			return nil, &boil.SerializationFailure{DBError: boil.DBError{Err: errors.New("could not serialize access")}}
+		}
+		return insertJet(exec, row)
+	}
+
+	exec := boiltest.New()
+	defer exec.Close()
+
+	loaded, err := set.Load(exec, tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"insert pilot", "insert pilot", "insert pilot", "insert pilot", "insert jet"}; !reflect.DeepEqual(log, want) {
+		t.Errorf("want: %v, got: %v", want, log)
+	}
+
+	bob, alice, bomber := loaded["pilots"]["bob"], loaded["pilots"]["alice"], loaded["jets"]["bomber"]
+	if bob["id"] != 3 || alice["id"] != 4 || alice["mentor_id"] != 3 {
+		t.Errorf("want the pilots of the retry, bob: %v, alice: %v", bob, alice)
+	}
+	if bomber["pilot_id"] != 4 {
+		t.Errorf("want the jet to refer to the retried alice: %v", bomber)
+	}
+}
+
+func TestLoadComposite(t *testing.T) {
+	t.Parallel()
+
+	set, err := Parse([]byte(`
+parkings:
+  overnight:
+    hangar_spot: north
+hangar_spots:
+  north:
+    hangar_id: 7
+    spot: 3
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	insert := func(exec boil.Executor, row Row) (Row, error) {
+		return row, nil
+	}
+	tables := []Table{
+		{
+			Name: "parkings",
+			ForeignKeys: []ForeignKey{{
+				Columns: []string{"hangar_id", "spot"}, ForeignTable: "hangar_spots", ForeignColumns: []string{"hangar_id", "spot"},
+				Ref: "hangar_spot",
+			}},
+			Insert: insert,
+		},
+		{Name: "hangar_spots", Insert: insert},
+	}
+
+	order, err := set.Tables(tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"hangar_spots", "parkings"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("want: %v, got: %v", want, order)
+	}
+
+	exec := boiltest.New()
+	defer exec.Close()
+
+	loaded, err := set.Load(exec, tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (Row{"hangar_id": 7, "spot": 3}); !reflect.DeepEqual(loaded["parkings"]["overnight"], want) {
+		t.Errorf("want: %v, got: %v", want, loaded["parkings"]["overnight"])
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"planes:\n  a:\n    name: A\n",
+		"jets:\n  a:\n    pilot: nobody\n",
+		"jets:\n  a:\n    pilot: 5\n",
+		"pilots:\n  a:\n    mentor: b\n  b:\n    mentor: a\n",
+		"pilots:\n  a:\n    rank: captain\n",
+	}
+
+	for i, test := range tests {
+		set, err := Parse([]byte(test))
+		if err != nil {
+			t.Errorf("%d) %v", i, err)
+			continue
+		}
+
+		var log []string
+		exec := boiltest.New()
+		if _, err = set.Load(exec, testTables(&log)); err == nil {
+			t.Errorf("%d) want an error", i)
+		}
+		exec.Close()
+	}
+
+	if _, err := Parse([]byte("pilots:\n  a:\n    name: A\n  a:\n    name: B\n")); err == nil {
+		t.Error("want an error for a duplicate label")
+	}
+}
+
+func TestTablesCycle(t *testing.T) {
+	t.Parallel()
+
+	set, err := Parse([]byte("a:\n  one: {}\nb:\n  two: {}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables := []Table{
+		{Name: "a", ForeignKeys: []ForeignKey{{Columns: []string{"b_id"}, ForeignTable: "b", ForeignColumns: []string{"id"}}}},
+		{Name: "b", ForeignKeys: []ForeignKey{{Columns: []string{"a_id"}, ForeignTable: "a", ForeignColumns: []string{"id"}}}},
+	}
+	if _, err = set.Tables(tables); err == nil {
+		t.Error("want an error for tables that refer to each other")
+	}
+}
+
+func TestBind(t *testing.T) {
+	t.Parallel()
+
+	var p pilot
+	if err := Bind(Row{"id": 3, "name": "Hogan", "mentor_id": 1}, &p); err != nil {
+		t.Fatal(err)
+	}
+	if want := (pilot{ID: 3, Name: "Hogan", MentorID: 1}); !reflect.DeepEqual(p, want) {
+		t.Errorf("want: %#v, got: %#v", want, p)
+	}
+
+	if want := (Row{"id": 3, "name": "Hogan", "mentor_id": 1}); !reflect.DeepEqual(Values(&p), want) {
+		t.Errorf("want: %v, got: %v", want, Values(&p))
+	}
+
+	if err := Bind(Row{"rank": "captain"}, &p); err == nil {
+		t.Error("want an error for an unknown column")
+	}
+}
+
+func TestInsertRaw(t *testing.T) {
+	t.Parallel()
+
+	exec := boiltest.New()
+	defer exec.Close()
+
+	exec.Expect(`INSERT INTO "pilot_languages" ("language_id","pilot_id") VALUES ($1,$2)`).WithArgs(4, 2)
+
+	dialect := queries.Dialect{LQ: '"', RQ: '"', IndexPlaceholders: true}
+	row := Row{"pilot_id": 2, "language_id": 4}
+	inserted, err := InsertRaw(exec, dialect, `"pilot_languages"`, row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(inserted, row) {
+		t.Errorf("want: %v, got: %v", row, inserted)
+	}
+
+	if err = exec.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
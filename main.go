@@ -87,25 +87,29 @@ func main() {
 	// Set up the cobra root command flags
 	rootCmd.PersistentFlags().StringVarP(&flagConfigFile, "config", "c", "", "Supply the name of the config file to override the default lookup")
 	rootCmd.PersistentFlags().StringP("output", "o", "models", "The name of the folder to output to")
-	rootCmd.PersistentFlags().StringP("schema", "s", "", "schema name for drivers that support it (default psql: public, mssql: dbo)")
+	rootCmd.PersistentFlags().StringSliceP("schema", "s", nil, "Schema names for drivers that support them, tables of every schema are generated (default psql: public, mssql: dbo)")
 	rootCmd.PersistentFlags().StringP("pkgname", "p", "models", "The name you wish to assign to your generated package")
-	rootCmd.PersistentFlags().StringP("basedir", "", "", "The base directory has the templates and templates_test folders")
+	rootCmd.PersistentFlags().StringP("basedir", "", "", "The base directory has the templates and templates_test folders (default built-in templates)")
+	rootCmd.PersistentFlags().StringSliceP("templatedir", "", nil, "Directories laid out like basedir whose templates are added to or replace the base templates")
 	rootCmd.PersistentFlags().StringSliceP("blacklist", "b", nil, "Do not include these tables in your generated package")
 	rootCmd.PersistentFlags().StringSliceP("whitelist", "w", nil, "Only include these tables in your generated package")
 	rootCmd.PersistentFlags().StringSliceP("tag", "t", nil, "Struct tags to be included on your models in addition to json, yaml, toml")
-	rootCmd.PersistentFlags().StringSliceP("replace", "", nil, "Replace templates by directory: relpath/to_file.tpl:relpath/to_replacement.tpl")
 	rootCmd.PersistentFlags().BoolP("debug", "d", false, "Debug mode prints stack traces on error")
 	rootCmd.PersistentFlags().BoolP("no-tests", "", false, "Disable generated go test files")
+	rootCmd.PersistentFlags().BoolP("test-transactions", "", false, "Run the generated tests in rolled back transactions on the configured database instead of a copy of its schema")
 	rootCmd.PersistentFlags().BoolP("no-hooks", "", false, "Disable hooks feature for your models")
 	rootCmd.PersistentFlags().BoolP("no-auto-timestamps", "", false, "Disable automatic timestamps for created_at/updated_at")
+	rootCmd.PersistentFlags().BoolP("no-validate", "", false, "Disable validating column limits before Insert, Update and Upsert")
+	rootCmd.PersistentFlags().BoolP("rows-affected", "", false, "Return the number of rows affected from Update, Delete, UpdateAll and DeleteAll")
+	rootCmd.PersistentFlags().BoolP("export-factories", "", false, "Generate the factories into the package rather than its tests, for other packages to use")
+	rootCmd.PersistentFlags().BoolP("export-fixtures", "", false, "Generate LoadFixtures and CleanFixtures into the package rather than its tests, for other packages to use")
 	rootCmd.PersistentFlags().BoolP("version", "", false, "Print the version")
 	rootCmd.PersistentFlags().BoolP("tinyint-as-bool", "", false, "Map MySQL tinyint(1) in Go to bool instead of int8")
-	rootCmd.PersistentFlags().BoolP("wipe", "", false, "Delete the output folder (rm -rf) before generation to ensure sanity")
+	rootCmd.PersistentFlags().BoolP("wipe", "", false, "Delete previously generated files in the output folder before generation to ensure sanity")
+	rootCmd.PersistentFlags().BoolP("check", "", false, "Verify the output folder is up to date instead of writing to it, prints a diff and fails if it is not")
+	rootCmd.PersistentFlags().BoolP("runtime-schema", "", false, "Look up the schema of queries from their executor at runtime, see boil.WithSchema (psql and mssql only)")
 	rootCmd.PersistentFlags().StringP("struct-tag-casing", "", "snake", "Decides the casing for go structure tag names. camel or snake (default snake)")
 
-	// hide flags not recommended for use
-	rootCmd.PersistentFlags().MarkHidden("replace")
-
 	viper.SetDefault("postgres.sslmode", "require")
 	viper.SetDefault("postgres.port", "5432")
 	viper.SetDefault("mysql.sslmode", "true")
@@ -148,21 +152,34 @@ func preRun(cmd *cobra.Command, args []string) error {
 	cmdConfig = &boilingcore.Config{
 		DriverName:       driverName,
 		OutFolder:        viper.GetString("output"),
-		Schema:           viper.GetString("schema"),
 		PkgName:          viper.GetString("pkgname"),
 		BaseDir:          viper.GetString("basedir"),
 		Debug:            viper.GetBool("debug"),
 		NoTests:          viper.GetBool("no-tests"),
+		TestTransactions: viper.GetBool("test-transactions"),
 		NoHooks:          viper.GetBool("no-hooks"),
 		NoAutoTimestamps: viper.GetBool("no-auto-timestamps"),
+		NoValidate:       viper.GetBool("no-validate"),
+		RowsAffected:     viper.GetBool("rows-affected"),
+		ExportFixtures:   viper.GetBool("export-fixtures"),
+		ExportFactories:  viper.GetBool("export-factories"),
 		Wipe:             viper.GetBool("wipe"),
+		RuntimeSchema:    viper.GetBool("runtime-schema"),
 		StructTagCasing:  strings.ToLower(viper.GetString("struct-tag-casing")), // camel | snake
 	}
 
 	// BUG: https://github.com/spf13/viper/issues/200
-	// Look up the value of blacklist, whitelist & tags directly from PFlags in Cobra if we
+	// Look up the value of schema, blacklist, whitelist, tags & templatedirs directly from PFlags in Cobra if we
 	// detect a malformed value coming out of viper.
 	// Once the bug is fixed we'll be able to move this into the init above
+	cmdConfig.Schemas = viper.GetStringSlice("schema")
+	if len(cmdConfig.Schemas) == 1 && strings.ContainsRune(cmdConfig.Schemas[0], ',') {
+		cmdConfig.Schemas, err = cmd.PersistentFlags().GetStringSlice("schema")
+		if err != nil {
+			return err
+		}
+	}
+
 	cmdConfig.BlacklistTables = viper.GetStringSlice("blacklist")
 	if len(cmdConfig.BlacklistTables) == 1 && strings.ContainsRune(cmdConfig.BlacklistTables[0], ',') {
 		cmdConfig.BlacklistTables, err = cmd.PersistentFlags().GetStringSlice("blacklist")
@@ -187,14 +204,18 @@ func preRun(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	cmdConfig.Replacements = viper.GetStringSlice("replace")
-	if len(cmdConfig.Replacements) == 1 && strings.ContainsRune(cmdConfig.Replacements[0], ',') {
-		cmdConfig.Replacements, err = cmd.PersistentFlags().GetStringSlice("replace")
+	cmdConfig.TemplateDirs = viper.GetStringSlice("templatedir")
+	if len(cmdConfig.TemplateDirs) == 1 && strings.ContainsRune(cmdConfig.TemplateDirs[0], ',') {
+		cmdConfig.TemplateDirs, err = cmd.PersistentFlags().GetStringSlice("templatedir")
 		if err != nil {
 			return err
 		}
 	}
 
+	if err = viper.UnmarshalKey("views", &cmdConfig.Views); err != nil {
+		return fmt.Errorf("unable to read views config: %v", err)
+	}
+
 	if driverName == "postgres" {
 		cmdConfig.Postgres = boilingcore.PostgresConfig{
 			User:    viper.GetString("postgres.user"),
@@ -218,10 +239,6 @@ func preRun(cmd *cobra.Command, args []string) error {
 			viper.Set("postgres.port", cmdConfig.Postgres.Port)
 		}
 
-		if len(cmdConfig.Schema) == 0 {
-			cmdConfig.Schema = "public"
-		}
-
 		err = vala.BeginValidation().Validate(
 			vala.StringNotEmpty(cmdConfig.Postgres.User, "postgres.user"),
 			vala.StringNotEmpty(cmdConfig.Postgres.Host, "postgres.host"),
@@ -249,7 +266,11 @@ func preRun(cmd *cobra.Command, args []string) error {
 		drivers.TinyintAsBool = viper.GetBool("tinyint-as-bool")
 
 		// MySQL doesn't have schemas, just databases
-		cmdConfig.Schema = cmdConfig.MySQL.DBName
+		cmdConfig.Schemas = []string{cmdConfig.MySQL.DBName}
+
+		if cmdConfig.RuntimeSchema {
+			return commandFailure("runtime-schema is not supported by mysql")
+		}
 
 		// BUG: https://github.com/spf13/viper/issues/71
 		// Despite setting defaults, nested values don't get defaults
@@ -300,10 +321,6 @@ func preRun(cmd *cobra.Command, args []string) error {
 			viper.Set("mssql.port", cmdConfig.MSSQL.Port)
 		}
 
-		if len(cmdConfig.Schema) == 0 {
-			cmdConfig.Schema = "dbo"
-		}
-
 		err = vala.BeginValidation().Validate(
 			vala.StringNotEmpty(cmdConfig.MSSQL.User, "mssql.user"),
 			vala.StringNotEmpty(cmdConfig.MSSQL.Host, "mssql.host"),
@@ -322,7 +339,21 @@ func preRun(cmd *cobra.Command, args []string) error {
 }
 
 func run(cmd *cobra.Command, args []string) error {
-	return cmdState.Run(true)
+	if !viper.GetBool("check") {
+		return cmdState.Run(true)
+	}
+
+	diff, err := cmdState.Check(true)
+	if err != nil {
+		return err
+	}
+
+	if len(diff) != 0 {
+		os.Stdout.Write(diff)
+		return fmt.Errorf("generated code in %s is out of date", cmdConfig.OutFolder)
+	}
+
+	return nil
 }
 
 func postRun(cmd *cobra.Command, args []string) error {
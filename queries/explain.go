@@ -0,0 +1,127 @@
+package queries
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/curvegrid/sqlboiler/boil"
+	"github.com/pkg/errors"
+)
+
+// Explain runs the query's plan on exec rather than the query itself and
+// returns the rows of the plan, one string per row:
+//
+//	postgres: EXPLAIN (FORMAT JSON), a single JSON document
+//	mysql:    EXPLAIN FORMAT=JSON, or EXPLAIN ANALYZE (MySQL 8.0.18+)
+//	mssql:    SET SHOWPLAN_XML, a single XML document
+//
+// With analyze the statement is run to report actual timings, so explaining
+// an update or delete this way changes data unless exec is a transaction
+// that is rolled back. MS SQL Server plans cannot be analyzed.
+func (q *Query) Explain(exec boil.Executor, analyze bool) ([]string, error) {
+	if q.dialect == nil {
+		return nil, errors.New("unable to explain a query without a dialect, see SetDialect")
+	}
+
+	qs, args := q.SQL()
+
+	switch {
+	case q.dialect.UseTopClause:
+		if analyze {
+			return nil, errors.New("mssql plans cannot be analyzed")
+		}
+		return explainShowplan(exec, qs, args)
+	case q.dialect.IndexPlaceholders && analyze:
+		qs = "EXPLAIN (ANALYZE, FORMAT JSON) " + qs
+	case q.dialect.IndexPlaceholders:
+		qs = "EXPLAIN (FORMAT JSON) " + qs
+	case analyze:
+		qs = "EXPLAIN ANALYZE " + qs
+	default:
+		qs = "EXPLAIN FORMAT=JSON " + qs
+	}
+
+	return explainRows(exec, qs, args)
+}
+
+// ExplainP explains the query, see Explain. It will panic on error.
+func (q *Query) ExplainP(exec boil.Executor, analyze bool) []string {
+	plan, err := q.Explain(exec, analyze)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return plan
+}
+
+// explainShowplan turns SHOWPLAN_XML on around the query. The setting is
+// per connection, so unless exec is already a transaction one is begun to
+// keep the three statements on the same connection, also when exec wraps the
+// database as WithSchema and SkipHooks do.
+func explainShowplan(exec boil.Executor, qs string, args []interface{}) ([]string, error) {
+	if beginner, ok := boil.BeginnerOf(exec); ok {
+		tx, err := beginner.Begin()
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to begin a transaction to explain in")
+		}
+		defer tx.Rollback()
+		exec = tx
+	}
+
+	if _, err := exec.Exec("SET SHOWPLAN_XML ON"); err != nil {
+		return nil, errors.Wrap(err, "unable to turn showplan on")
+	}
+
+	plan, err := explainRows(exec, qs, args)
+
+	if _, offErr := exec.Exec("SET SHOWPLAN_XML OFF"); offErr != nil && err == nil {
+		err = errors.Wrap(offErr, "unable to turn showplan off")
+	}
+
+	return plan, err
+}
+
+// explainRows runs an explain statement and returns its rows, the columns of
+// a row separated by tabs.
+func explainRows(exec boil.Executor, qs string, args []interface{}) ([]string, error) {
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, qs)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	rows, err := exec.Query(qs, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to explain query")
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get the columns of the plan")
+	}
+
+	var plan []string
+	values := make([]sql.RawBytes, len(cols))
+	ptrs := make([]interface{}, len(cols))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	for rows.Next() {
+		if err = rows.Scan(ptrs...); err != nil {
+			return nil, errors.Wrap(err, "unable to scan the plan")
+		}
+
+		row := make([]string, len(values))
+		for i, v := range values {
+			row[i] = string(v)
+		}
+		plan = append(plan, strings.Join(row, "\t"))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "error from rows of the plan")
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,104 @@
+package queries
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/curvegrid/sqlboiler/boil"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dialect Dialect
+		analyze bool
+		explain string
+	}{
+		{Dialect{LQ: '"', RQ: '"', IndexPlaceholders: true}, false, `EXPLAIN \(FORMAT JSON\) SELECT \* FROM "pilots" WHERE \(id=\$1\);`},
+		{Dialect{LQ: '"', RQ: '"', IndexPlaceholders: true}, true, `EXPLAIN \(ANALYZE, FORMAT JSON\) SELECT \* FROM "pilots"`},
+		{Dialect{LQ: '`', RQ: '`'}, false, "EXPLAIN FORMAT=JSON SELECT \\* FROM `pilots` WHERE \\(id=\\?\\);"},
+		{Dialect{LQ: '`', RQ: '`'}, true, "EXPLAIN ANALYZE SELECT \\* FROM `pilots`"},
+	}
+
+	for i, test := range tests {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mock.ExpectQuery(test.explain).WithArgs(5).
+			WillReturnRows(sqlmock.NewRows([]string{"plan", "cost"}).AddRow("scan", 1).AddRow("filter", 2))
+
+		q := &Query{
+			from:    []string{"pilots"},
+			where:   []where{{clause: "id=?", args: []interface{}{5}}},
+			dialect: &test.dialect,
+		}
+
+		plan, err := q.Explain(db, test.analyze)
+		if err != nil {
+			t.Errorf("%d) %v", i, err)
+			continue
+		}
+		if want := []string{"scan\t1", "filter\t2"}; !reflect.DeepEqual(plan, want) {
+			t.Errorf("%d) want: %q, got: %q", i, want, plan)
+		}
+
+		if err = mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("%d) %v", i, err)
+		}
+	}
+}
+
+func TestExplainShowplan(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SET SHOWPLAN_XML ON").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT TOP \(1\) \* FROM \[pilots\]`).
+		WillReturnRows(sqlmock.NewRows([]string{"plan"}).AddRow("<ShowPlanXML/>"))
+	mock.ExpectExec("SET SHOWPLAN_XML OFF").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	q := &Query{
+		from:    []string{"pilots"},
+		limit:   1,
+		dialect: &Dialect{LQ: '[', RQ: ']', IndexPlaceholders: true, UseTopClause: true},
+	}
+
+	plan, err := q.Explain(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"<ShowPlanXML/>"}; !reflect.DeepEqual(plan, want) {
+		t.Errorf("want: %q, got: %q", want, plan)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SET SHOWPLAN_XML ON").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT TOP \(1\) \* FROM \[pilots\]`).
+		WillReturnRows(sqlmock.NewRows([]string{"plan"}).AddRow("<ShowPlanXML/>"))
+	mock.ExpectExec("SET SHOWPLAN_XML OFF").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	if _, err = q.Explain(boil.SkipHooks(boil.WithSchema(db, "tenant_a")), false); err != nil {
+		t.Fatal(err)
+	}
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error("want a transaction begun on the wrapped database:", err)
+	}
+
+	if _, err = q.Explain(db, true); err == nil {
+		t.Error("want an error analyzing an mssql plan")
+	}
+}
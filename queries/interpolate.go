@@ -0,0 +1,145 @@
+package queries
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Interpolate returns query with its placeholders replaced by args written
+// as literals of the dialect's database, for logging and debugging. Quoted
+// strings and identifiers are left alone, so placeholder characters inside
+// them are not replaced. Arguments are converted the way database/sql
+// converts them, so Valuers such as the null types are written as their
+// values.
+//
+// The result is meant to be read, never run: statements should always be
+// run with their arguments kept separate.
+func Interpolate(query string, args []interface{}, dialect Dialect) (string, error) {
+	values := make([]driver.Value, len(args))
+	for i, a := range args {
+		v, err := driver.DefaultParameterConverter.ConvertValue(a)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to convert argument %d", i+1)
+		}
+		values[i] = v
+	}
+
+	buf := &bytes.Buffer{}
+	next := 0
+	var quote byte
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+
+		switch {
+		case quote == '\'' && c == '\\' && !dialect.IndexPlaceholders && i+1 < len(query):
+			buf.WriteString(query[i : i+2])
+			i++
+			continue
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'':
+			quote = '\''
+		case c == dialect.LQ:
+			quote = dialect.RQ
+		case c == '$' && dialect.IndexPlaceholders:
+			end := i + 1
+			for end < len(query) && query[end] >= '0' && query[end] <= '9' {
+				end++
+			}
+			if end == i+1 {
+				break
+			}
+
+			n, _ := strconv.Atoi(query[i+1 : end])
+			if n < 1 || n > len(values) {
+				return "", errors.Errorf("placeholder $%d has no argument, got %d arguments", n, len(values))
+			}
+			if err := writeLiteral(buf, values[n-1], dialect); err != nil {
+				return "", errors.Wrapf(err, "unable to interpolate argument %d", n)
+			}
+			i = end - 1
+			continue
+		case c == '?' && !dialect.IndexPlaceholders:
+			if next >= len(values) {
+				return "", errors.Errorf("placeholder %d has no argument, got %d arguments", next+1, len(values))
+			}
+			if err := writeLiteral(buf, values[next], dialect); err != nil {
+				return "", errors.Wrapf(err, "unable to interpolate argument %d", next+1)
+			}
+			next++
+			continue
+		}
+
+		buf.WriteByte(c)
+	}
+
+	if !dialect.IndexPlaceholders && next != len(values) {
+		return "", errors.Errorf("query has %d placeholders for %d arguments", next, len(values))
+	}
+
+	return buf.String(), nil
+}
+
+// writeLiteral writes v as a literal. MS SQL (which uses TOP clauses) has no
+// boolean literals and wants unicode strings prefixed, MySQL (which has no
+// indexed placeholders) treats backslashes in strings as escapes.
+func writeLiteral(buf *bytes.Buffer, v driver.Value, dialect Dialect) error {
+	mssql := dialect.UseTopClause
+	mysql := !dialect.IndexPlaceholders
+
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("NULL")
+	case int64:
+		buf.WriteString(strconv.FormatInt(v, 10))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case bool:
+		switch {
+		case mssql && v:
+			buf.WriteByte('1')
+		case mssql:
+			buf.WriteByte('0')
+		case v:
+			buf.WriteString("TRUE")
+		default:
+			buf.WriteString("FALSE")
+		}
+	case []byte:
+		switch {
+		case mssql:
+			buf.WriteString("0x" + hex.EncodeToString(v))
+		case mysql:
+			buf.WriteString("X'" + hex.EncodeToString(v) + "'")
+		default:
+			buf.WriteString(`'\x` + hex.EncodeToString(v) + "'")
+		}
+	case string:
+		if mssql {
+			buf.WriteByte('N')
+		}
+		if mysql {
+			v = strings.Replace(v, `\`, `\\`, -1)
+		}
+		buf.WriteString("'" + strings.Replace(v, "'", "''", -1) + "'")
+	case time.Time:
+		if mssql || mysql {
+			buf.WriteString("'" + v.Format("2006-01-02 15:04:05.999999") + "'")
+		} else {
+			buf.WriteString("'" + v.Format("2006-01-02 15:04:05.999999-07:00") + "'")
+		}
+	default:
+		return errors.Errorf("unsupported type %T", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	null "gopkg.in/volatiletech/null.v6"
+)
+
+func TestInterpolate(t *testing.T) {
+	t.Parallel()
+
+	postgres := Dialect{LQ: '"', RQ: '"', IndexPlaceholders: true}
+	mysql := Dialect{LQ: '`', RQ: '`'}
+	mssql := Dialect{LQ: '[', RQ: ']', IndexPlaceholders: true, UseTopClause: true}
+	at := time.Date(2017, 3, 4, 5, 6, 7, 8000, time.UTC)
+
+	tests := []struct {
+		dialect Dialect
+		query   string
+		args    []interface{}
+		out     string
+	}{
+		{
+			postgres,
+			`SELECT * FROM "pilots" WHERE "id"=$2 AND "name"=$1 AND '$1'='$1' AND "a$1"=$3;`,
+			[]interface{}{"O'Hare", 5, null.String{}},
+			`SELECT * FROM "pilots" WHERE "id"=5 AND "name"='O''Hare' AND '$1'='$1' AND "a$1"=NULL;`,
+		},
+		{
+			postgres,
+			`UPDATE "jets" SET "active"=$1, "at"=$2, "data"=$3, "ratio"=$4`,
+			[]interface{}{true, at, []byte{0xde, 0xad}, 0.5},
+			`UPDATE "jets" SET "active"=TRUE, "at"='2017-03-04 05:06:07.000008+00:00', "data"='\xdead', "ratio"=0.5`,
+		},
+		{
+			mysql,
+			"SELECT * FROM `pilots?` WHERE `id`=? AND 'it\\'s?'=? AND `data`=? AND `at`=?",
+			[]interface{}{int8(5), `a\b`, []byte{0xbe, 0xef}, at},
+			"SELECT * FROM `pilots?` WHERE `id`=5 AND 'it\\'s?'='a\\\\b' AND `data`=X'beef' AND `at`='2017-03-04 05:06:07.000008'",
+		},
+		{
+			mssql,
+			`SELECT TOP (1) * FROM [pilots] WHERE [name]=$1 AND [active]=$2 AND [data]=$3`,
+			[]interface{}{null.StringFrom("Hogan"), false, []byte{0x01}},
+			`SELECT TOP (1) * FROM [pilots] WHERE [name]=N'Hogan' AND [active]=0 AND [data]=0x01`,
+		},
+	}
+
+	for i, test := range tests {
+		out, err := Interpolate(test.query, test.args, test.dialect)
+		if err != nil {
+			t.Errorf("%d) %v", i, err)
+			continue
+		}
+		if out != test.out {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.out, out)
+		}
+	}
+}
+
+func TestInterpolateErrors(t *testing.T) {
+	t.Parallel()
+
+	postgres := Dialect{LQ: '"', RQ: '"', IndexPlaceholders: true}
+	mysql := Dialect{LQ: '`', RQ: '`'}
+
+	tests := []struct {
+		dialect Dialect
+		query   string
+		args    []interface{}
+	}{
+		{postgres, `SELECT $2`, []interface{}{1}},
+		{postgres, `SELECT $1`, []interface{}{struct{}{}}},
+		{mysql, `SELECT ?, ?`, []interface{}{1}},
+		{mysql, `SELECT ?`, []interface{}{1, 2}},
+	}
+
+	for i, test := range tests {
+		if _, err := Interpolate(test.query, test.args, test.dialect); err == nil {
+			t.Errorf("%d) want an error", i)
+		}
+	}
+}
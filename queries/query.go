@@ -131,6 +131,13 @@ func (q *Query) Query() (*sql.Rows, error) {
 	return q.executor.Query(qs, args...)
 }
 
+// SQL returns the statement and arguments the query runs with, without
+// running it. See Interpolate to log them as a single statement.
+func (q *Query) SQL() (string, []interface{}) {
+	cp := *q
+	return buildQuery(&cp)
+}
+
 // ExecP executes a query that does not need a row returned
 // It will panic on error
 func (q *Query) ExecP() sql.Result {
@@ -168,6 +175,11 @@ func SetDialect(q *Query, dialect *Dialect) {
 	q.dialect = dialect
 }
 
+// GetDialect from the query.
+func GetDialect(q *Query) *Dialect {
+	return q.dialect
+}
+
 // SetSQL on the query.
 func SetSQL(q *Query, sql string, args ...interface{}) {
 	q.rawSQL = rawSQL{sql: sql, args: args}
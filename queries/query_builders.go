@@ -15,6 +15,14 @@ var (
 	rgxInClause   = regexp.MustCompile(`^(?i)(.*[\s|\)|\?])IN([\s|\(|\?].*)$`)
 )
 
+// Render returns the SQL and arguments q builds to under dialect. It leaves q
+// alone, neither its dialect nor the statement it caches once executed change.
+func Render(q *Query, dialect Dialect) (string, []interface{}) {
+	cp := *q
+	cp.dialect = &dialect
+	return buildQuery(&cp)
+}
+
 func buildQuery(q *Query) (string, []interface{}) {
 	var buf *bytes.Buffer
 	var args []interface{}
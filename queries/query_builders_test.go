@@ -124,6 +124,38 @@ func TestBuildQuery(t *testing.T) {
 	}
 }
 
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{
+		from:  []string{"pilots"},
+		where: []where{{clause: "id=? and name=?", args: []interface{}{5, "Hogan"}}},
+		limit: 1,
+	}
+
+	tests := []struct {
+		dialect Dialect
+		sql     string
+	}{
+		{Dialect{LQ: '"', RQ: '"', IndexPlaceholders: true}, `SELECT * FROM "pilots" WHERE (id=$1 and name=$2) LIMIT 1;`},
+		{Dialect{LQ: '`', RQ: '`'}, "SELECT * FROM `pilots` WHERE (id=? and name=?) LIMIT 1;"},
+	}
+
+	for i, test := range tests {
+		sql, args := Render(q, test.dialect)
+		if sql != test.sql {
+			t.Errorf("%d) want: %s, got: %s", i, test.sql, sql)
+		}
+		if !reflect.DeepEqual(args, []interface{}{5, "Hogan"}) {
+			t.Errorf("%d) wrong args: %#v", i, args)
+		}
+	}
+
+	if q.dialect != nil || len(q.rawSQL.sql) != 0 {
+		t.Error("want the query left alone")
+	}
+}
+
 func TestWriteStars(t *testing.T) {
 	t.Parallel()
 
@@ -45,6 +45,27 @@ func TestSetSQL(t *testing.T) {
 	}
 }
 
+func TestQuerySQL(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{dialect: &Dialect{LQ: '"', RQ: '"', IndexPlaceholders: true}}
+	SetFrom(q, "pilots")
+	AppendWhere(q, "id=?", 5)
+
+	sql, args := q.SQL()
+	if expect := `SELECT * FROM "pilots" WHERE (id=$1);`; sql != expect {
+		t.Errorf("Expected %s, got %s", expect, sql)
+	}
+	if !reflect.DeepEqual(args, []interface{}{5}) {
+		t.Errorf("Expected args [5], got %v", args)
+	}
+
+	AppendWhere(q, "name=?", "Hogan")
+	if sql, _ = q.SQL(); sql != `SELECT * FROM "pilots" WHERE (id=$1) AND (name=$2);` {
+		t.Errorf("Expected the query built again, got %s", sql)
+	}
+}
+
 func TestSetLoad(t *testing.T) {
 	t.Parallel()
 
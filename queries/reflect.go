@@ -59,6 +59,9 @@ func (q *Query) BindP(obj interface{}) {
 //   - If the "name" of the struct tag is "-", this field will not be bound to.
 //   - If the ",bind" option is specified on a struct field and that field
 //     is a struct itself, it will be recursed into to look for fields for binding.
+//   - Structs with a Snapshot() method, like the generated models, have it
+//     called once they are bound so they can tell which of their fields
+//     are changed afterwards.
 //
 // Example Query:
 //
@@ -257,10 +260,13 @@ Rows:
 
 		switch bkind {
 		case kindStruct:
+			snapshot(obj)
 			break Rows
 		case kindSliceStruct:
+			snapshot(oneStruct.Addr().Interface())
 			ptrSlice.Set(reflect.Append(ptrSlice, oneStruct))
 		case kindPtrSliceStruct:
+			snapshot(newStruct.Interface())
 			ptrSlice.Set(reflect.Append(ptrSlice, newStruct))
 		}
 	}
@@ -0,0 +1,98 @@
+package queries
+
+import "reflect"
+
+// snapshotter is implemented by structs that remember the values they were
+// loaded with, bind calls Snapshot on every struct it fills in.
+type snapshotter interface {
+	Snapshot()
+}
+
+// snapshot calls Snapshot on obj if it has the method, obj must be a
+// pointer to a struct.
+func snapshot(obj interface{}) {
+	if s, ok := obj.(snapshotter); ok {
+		s.Snapshot()
+	}
+}
+
+// SnapshotFromMapping copies the values of the struct referred to by the
+// mapping, like ValuesFromMapping, for them to be compared against later
+// with ChangedFromMapping. Slices and maps are copied so changing them in
+// place is noticed.
+func SnapshotFromMapping(val reflect.Value, mapping []uint64) []interface{} {
+	values := make([]interface{}, len(mapping))
+	for i, m := range mapping {
+		values[i] = copyValue(ptrFromMapping(val, m, false))
+	}
+	return values
+}
+
+// RefreshSnapshotFromMapping returns a copy of the snapshot with the values of
+// the given columns taken again from the struct referred to by the mapping,
+// for when only those were written. Columns, mapping and the snapshot are
+// matched by index.
+func RefreshSnapshotFromMapping(val reflect.Value, mapping []uint64, columns []string, snapshot []interface{}, refresh []string) []interface{} {
+	refreshed := make([]interface{}, len(snapshot))
+	copy(refreshed, snapshot)
+	for i, m := range mapping {
+		if i >= len(refreshed) {
+			break
+		}
+		for _, c := range refresh {
+			if c == columns[i] {
+				refreshed[i] = copyValue(ptrFromMapping(val, m, false))
+				break
+			}
+		}
+	}
+	return refreshed
+}
+
+// ChangedFromMapping returns the columns whose values in the struct referred
+// to by the mapping differ from those of the snapshot. Columns, mapping and
+// the snapshot are matched by index.
+func ChangedFromMapping(val reflect.Value, mapping []uint64, columns []string, snapshot []interface{}) []string {
+	var changed []string
+	for i, m := range mapping {
+		if i >= len(snapshot) || !reflect.DeepEqual(ptrFromMapping(val, m, false).Interface(), snapshot[i]) {
+			changed = append(changed, columns[i])
+		}
+	}
+	return changed
+}
+
+// copyValue returns the value of v with slices and maps copied, along with
+// those of structs such as null.Bytes
+func copyValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < c.NumField(); i++ {
+			f := c.Field(i)
+			if f.CanSet() && (f.Kind() == reflect.Slice || f.Kind() == reflect.Map) {
+				f.Set(reflect.ValueOf(copyValue(f)))
+			}
+		}
+		return c.Interface()
+	case reflect.Slice:
+		if v.IsNil() {
+			break
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(c, v)
+		return c.Interface()
+	case reflect.Map:
+		if v.IsNil() {
+			break
+		}
+		c := reflect.MakeMap(v.Type())
+		for _, k := range v.MapKeys() {
+			c.SetMapIndex(k, v.MapIndex(k))
+		}
+		return c.Interface()
+	}
+
+	return v.Interface()
+}
@@ -0,0 +1,134 @@
+package queries
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	null "gopkg.in/volatiletech/null.v6"
+)
+
+type snapshotStruct struct {
+	ID   int
+	Name string `boil:"test"`
+
+	snapshot []interface{} `boil:"-"`
+}
+
+func (s *snapshotStruct) Snapshot() {
+	s.snapshot = []interface{}{s.ID, s.Name}
+}
+
+func TestBindSnapshot(t *testing.T) {
+	t.Parallel()
+
+	var one snapshotStruct
+	var slice []snapshotStruct
+	var ptrSlice []*snapshotStruct
+
+	for _, obj := range []interface{}{&one, &slice, &ptrSlice} {
+		query := &Query{
+			from:    []string{"fun"},
+			dialect: &Dialect{LQ: '"', RQ: '"', IndexPlaceholders: true},
+		}
+
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ret := sqlmock.NewRows([]string{"id", "test"})
+		ret.AddRow(driver.Value(int64(35)), driver.Value("pat"))
+		ret.AddRow(driver.Value(int64(12)), driver.Value("cat"))
+		mock.ExpectQuery(`SELECT \* FROM "fun";`).WillReturnRows(ret)
+
+		SetExecutor(query, db)
+		if err = query.Bind(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if want := []interface{}{35, "pat"}; !reflect.DeepEqual(one.snapshot, want) {
+		t.Errorf("want snapshot: %v, got: %v", want, one.snapshot)
+	}
+	if len(slice) != 2 || len(ptrSlice) != 2 {
+		t.Fatal("wrong number of results:", len(slice), len(ptrSlice))
+	}
+	if want := []interface{}{12, "cat"}; !reflect.DeepEqual(slice[1].snapshot, want) {
+		t.Errorf("want snapshot: %v, got: %v", want, slice[1].snapshot)
+	}
+	if want := []interface{}{35, "pat"}; !reflect.DeepEqual(slice[0].snapshot, want) {
+		t.Errorf("want snapshot: %v, got: %v", want, slice[0].snapshot)
+	}
+	if want := []interface{}{12, "cat"}; !reflect.DeepEqual(ptrSlice[1].snapshot, want) {
+		t.Errorf("want snapshot: %v, got: %v", want, ptrSlice[1].snapshot)
+	}
+}
+
+func TestChangedFromMapping(t *testing.T) {
+	t.Parallel()
+
+	type changeStruct struct {
+		ID    int
+		Data  []byte
+		Bytes null.Bytes
+		Meta  map[string]string
+	}
+
+	o := &changeStruct{
+		ID:    1,
+		Data:  []byte("abc"),
+		Bytes: null.BytesFrom([]byte("xyz")),
+		Meta:  map[string]string{"a": "b"},
+	}
+	columns := []string{"id", "data", "bytes", "meta"}
+	mapping := []uint64{testMakeMapping(0), testMakeMapping(1), testMakeMapping(2), testMakeMapping(3)}
+
+	val := reflect.Indirect(reflect.ValueOf(o))
+	snapshot := SnapshotFromMapping(val, mapping)
+
+	if changed := ChangedFromMapping(val, mapping, columns, snapshot); len(changed) != 0 {
+		t.Error("want nothing changed, got:", changed)
+	}
+
+	o.Data[0] = 'x'
+	o.Bytes.Bytes[0] = 'a'
+	o.Meta["a"] = "c"
+	if changed := ChangedFromMapping(val, mapping, columns, snapshot); !reflect.DeepEqual(changed, []string{"data", "bytes", "meta"}) {
+		t.Error("want in place changes noticed, got:", changed)
+	}
+
+	o.Data[0], o.Bytes.Bytes[0], o.Meta["a"] = 'a', 'x', "b"
+	o.ID = 2
+	if changed := ChangedFromMapping(val, mapping, columns, snapshot); !reflect.DeepEqual(changed, []string{"id"}) {
+		t.Error("want id changed, got:", changed)
+	}
+}
+
+func TestRefreshSnapshotFromMapping(t *testing.T) {
+	t.Parallel()
+
+	type refreshStruct struct {
+		ID   int
+		Name string
+		Data []byte
+	}
+
+	o := &refreshStruct{ID: 1, Name: "a", Data: []byte("abc")}
+	columns := []string{"id", "name", "data"}
+	mapping := []uint64{testMakeMapping(0), testMakeMapping(1), testMakeMapping(2)}
+
+	val := reflect.Indirect(reflect.ValueOf(o))
+	snapshot := SnapshotFromMapping(val, mapping)
+
+	o.Name, o.Data[0] = "b", 'x'
+	refreshed := RefreshSnapshotFromMapping(val, mapping, columns, snapshot, []string{"name"})
+
+	if changed := ChangedFromMapping(val, mapping, columns, refreshed); !reflect.DeepEqual(changed, []string{"data"}) {
+		t.Error("want only the columns not refreshed changed, got:", changed)
+	}
+	if changed := ChangedFromMapping(val, mapping, columns, snapshot); !reflect.DeepEqual(changed, []string{"name", "data"}) {
+		t.Error("want the original snapshot left alone, got:", changed)
+	}
+}
@@ -0,0 +1,157 @@
+package randomize
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/curvegrid/sqlboiler/boil"
+)
+
+// limiter is implemented by the generated models, it returns the limits the
+// database puts on their columns keyed by column name.
+type limiter interface {
+	ColumnLimits() map[string]boil.ColumnLimits
+}
+
+// columnName returns the column a struct field is mapped to
+func columnName(field reflect.StructField) string {
+	name := field.Tag.Get("boil")
+	if i := strings.IndexByte(name, ','); i >= 0 {
+		name = name[:i]
+	}
+
+	return name
+}
+
+// limitField changes the random value of field so it fits within lim: strings
+// are cut to length, numbers are moved into range and fitted to the digits
+// of their decimal, and values are picked from lists of allowed values.
+// Null values are left alone.
+func limitField(s *Seed, field reflect.Value, lim boil.ColumnLimits) {
+	if field.Kind() == reflect.Struct {
+		valid := field.FieldByName("Valid")
+		if !valid.IsValid() || valid.Kind() != reflect.Bool || !valid.Bool() {
+			return
+		}
+		field = field.FieldByName(field.Type().Name())
+		if !field.IsValid() || !field.CanSet() {
+			return
+		}
+	}
+
+	isNumber := lim.HasMin || lim.HasMax || lim.Precision > 0
+
+	switch field.Kind() {
+	case reflect.String:
+		if len(lim.In) != 0 {
+			field.SetString(lim.In[s.nextInt()%len(lim.In)])
+			return
+		}
+		if isNumber {
+			f, err := strconv.ParseFloat(field.String(), 64)
+			if err != nil {
+				f = float64(s.nextInt())
+			}
+			f = fitNumber(f, lim, lim.Scale == 0)
+			field.SetString(strconv.FormatFloat(f, 'f', lim.Scale, 64))
+			return
+		}
+		if lim.MaxLength > 0 {
+			if runes := []rune(field.String()); len(runes) > lim.MaxLength {
+				field.SetString(string(runes[:lim.MaxLength]))
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if f, ok := pickNumber(s, lim.In); ok {
+			field.SetInt(int64(f))
+		} else if isNumber {
+			field.SetInt(int64(fitNumber(float64(field.Int()), lim, true)))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if f, ok := pickNumber(s, lim.In); ok {
+			field.SetUint(uint64(f))
+		} else if isNumber {
+			field.SetUint(uint64(math.Max(0, fitNumber(float64(field.Uint()), lim, true))))
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, ok := pickNumber(s, lim.In); ok {
+			field.SetFloat(f)
+		} else if isNumber {
+			f := fitNumber(field.Float(), lim, false)
+			if lim.Precision > 0 {
+				f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'f', lim.Scale, 64), 64)
+			}
+			field.SetFloat(f)
+		}
+	}
+}
+
+// pickNumber picks a number from a list of allowed values
+func pickNumber(s *Seed, in []string) (float64, bool) {
+	if len(in) == 0 {
+		return 0, false
+	}
+
+	f, err := strconv.ParseFloat(in[s.nextInt()%len(in)], 64)
+	return f, err == nil
+}
+
+// fitNumber moves f into the range allowed by lim, keeping distinct values
+// of f apart where the range allows it.
+func fitNumber(f float64, lim boil.ColumnLimits, integer bool) float64 {
+	step := 0.01
+	if integer {
+		step = 1
+	} else if lim.Scale > 0 {
+		step = math.Pow10(-lim.Scale)
+	}
+
+	lo, hi := math.Inf(-1), math.Inf(1)
+	if lim.Precision > 0 {
+		hi = math.Pow10(lim.Precision-lim.Scale) - step
+		lo = -hi
+	}
+	if lim.HasMin {
+		min := lim.Min
+		if integer {
+			min = math.Ceil(min)
+		}
+		if lim.ExclusiveMin && min == lim.Min {
+			min += step
+		}
+		lo = math.Max(lo, min)
+	}
+	if lim.HasMax {
+		max := lim.Max
+		if integer {
+			max = math.Floor(max)
+		}
+		if lim.ExclusiveMax && max == lim.Max {
+			max -= step
+		}
+		hi = math.Min(hi, max)
+	}
+
+	if integer {
+		f = math.Trunc(f)
+	}
+	if f >= lo && f <= hi {
+		return f
+	}
+
+	abs := math.Abs(f)
+	switch {
+	case math.IsInf(lo, -1):
+		return hi - abs
+	case math.IsInf(hi, 1):
+		return lo + abs
+	case hi <= lo:
+		return lo
+	case integer:
+		return lo + math.Mod(abs, hi-lo+1)
+	default:
+		return lo + math.Mod(abs, hi-lo)
+	}
+}
@@ -0,0 +1,84 @@
+package randomize
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/curvegrid/sqlboiler/boil"
+	null "gopkg.in/volatiletech/null.v6"
+)
+
+type limitedStruct struct {
+	Name   string      `boil:"name"`
+	Size   int         `boil:"size"`
+	Rating null.Int    `boil:"rating"`
+	Price  string      `boil:"price"`
+	Weight float64     `boil:"weight"`
+	Status null.String `boil:"status"`
+}
+
+func (l *limitedStruct) ColumnLimits() map[string]boil.ColumnLimits {
+	return map[string]boil.ColumnLimits{
+		"name":   {MaxLength: 2},
+		"size":   {HasMin: true, Min: 1, HasMax: true, Max: 10, ExclusiveMax: true},
+		"rating": {HasMin: true, Min: 0, ExclusiveMin: true},
+		"price":  {Precision: 4, Scale: 2},
+		"weight": {Precision: 3, Scale: 1, HasMin: true, Min: 0},
+		"status": {In: []string{"new", "done"}},
+	}
+}
+
+func TestStructLimits(t *testing.T) {
+	t.Parallel()
+
+	s := NewSeed()
+	colTypes := map[string]string{
+		"Name":   "character varying",
+		"Size":   "integer",
+		"Rating": "integer",
+		"Price":  "numeric",
+		"Weight": "double precision",
+		"Status": "text",
+	}
+
+	for i := 0; i < 50; i++ {
+		var l limitedStruct
+		if err := Struct(s, &l, colTypes, true); err != nil {
+			t.Fatal(err)
+		}
+
+		columns := []string{"name", "size", "rating", "price", "weight", "status"}
+		values := []interface{}{l.Name, l.Size, l.Rating, l.Price, l.Weight, l.Status}
+		if err := boil.ValidateColumns("limited", columns, values, l.ColumnLimits()); err != nil {
+			t.Errorf("%d) %v: %#v", i, err, l)
+		}
+
+		if _, err := strconv.ParseFloat(l.Price, 64); err != nil {
+			t.Errorf("%d) want a number for price, got: %q", i, l.Price)
+		}
+	}
+}
+
+func TestFitNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In      float64
+		Limits  boil.ColumnLimits
+		Integer bool
+		Want    float64
+	}{
+		{5, boil.ColumnLimits{HasMin: true, Min: 1, HasMax: true, Max: 10}, true, 5},
+		{25, boil.ColumnLimits{HasMin: true, Min: 1, HasMax: true, Max: 10}, true, 6},
+		{-3, boil.ColumnLimits{HasMin: true, Min: 0, ExclusiveMin: true}, true, 4},
+		{3, boil.ColumnLimits{HasMax: true, Max: 0}, true, -3},
+		{12345, boil.ColumnLimits{Precision: 3}, true, -648},
+		{7, boil.ColumnLimits{HasMin: true, Min: 5, HasMax: true, Max: 5}, true, 5},
+	}
+
+	for i, test := range tests {
+		if got := fitNumber(test.In, test.Limits, test.Integer); got != test.Want {
+			t.Errorf("%d) want: %v, got: %v", i, test.Want, got)
+		}
+	}
+}
@@ -47,41 +47,38 @@ func randByteSlice(s *Seed, ln int) []byte {
 	return str
 }
 
-func randPoint() string {
-	a := rand.Intn(100)
+func randPoint(s *Seed) string {
+	a := s.intn(100)
 	b := a + 1
 	return fmt.Sprintf("(%d,%d)", a, b)
 }
 
-func randBox() string {
-	a := rand.Intn(100)
+func randBox(s *Seed) string {
+	a := s.intn(100)
 	b := a + 1
 	c := a + 2
 	d := a + 3
 	return fmt.Sprintf("(%d,%d),(%d,%d)", a, b, c, d)
 }
 
-func randCircle() string {
-	a, b, c := rand.Intn(100), rand.Intn(100), rand.Intn(100)
+func randCircle(s *Seed) string {
+	a, b, c := s.intn(100), s.intn(100), s.intn(100)
 	return fmt.Sprintf("((%d,%d),%d)", a, b, c)
 }
 
-func randNetAddr() string {
+func randNetAddr(s *Seed) string {
 	return fmt.Sprintf(
 		"%d.%d.%d.%d",
-		rand.Intn(254)+1,
-		rand.Intn(254)+1,
-		rand.Intn(254)+1,
-		rand.Intn(254)+1,
+		s.intn(254)+1,
+		s.intn(254)+1,
+		s.intn(254)+1,
+		s.intn(254)+1,
 	)
 }
 
-func randMacAddr() string {
+func randMacAddr(s *Seed) string {
 	buf := make([]byte, 6)
-	_, err := rand.Read(buf)
-	if err != nil {
-		panic(err)
-	}
+	s.read(buf)
 
 	// Set the local bit
 	buf[0] |= 2
@@ -91,21 +88,31 @@ func randMacAddr() string {
 	)
 }
 
-func randLsn() string {
-	a := rand.Int63n(9000000)
-	b := rand.Int63n(9000000)
+func randLsn(s *Seed) string {
+	a := s.int63n(9000000)
+	b := s.int63n(9000000)
 	return fmt.Sprintf("%d/%d", a, b)
 }
 
-func randTxID() string {
+func randTxID(s *Seed) string {
 	// Order of integers is relevant
-	a := rand.Intn(200) + 100
+	a := s.intn(200) + 100
 	b := a + 100
 	c := a
 	d := a + 50
 	return fmt.Sprintf("%d:%d:%d,%d", a, b, c, d)
 }
 
+// randUUID returns a version 4 UUID
+func randUUID(s *Seed) string {
+	buf := make([]byte, 16)
+	s.read(buf)
+
+	buf[6] = buf[6]&0x0f | 0x40
+	buf[8] = buf[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buf[0:4], buf[4:6], buf[6:8], buf[8:10], buf[10:])
+}
+
 func randMoney(s *Seed) string {
 	return fmt.Sprintf("%d.00", s.nextInt())
 }
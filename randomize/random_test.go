@@ -1,6 +1,9 @@
 package randomize
 
-import "testing"
+import (
+	"regexp"
+	"testing"
+)
 
 func TestStableDBName(t *testing.T) {
 	t.Parallel()
@@ -17,3 +20,16 @@ func TestStableDBName(t *testing.T) {
 		t.Error("it should always produce the same value")
 	}
 }
+
+func TestRandUUID(t *testing.T) {
+	t.Parallel()
+
+	rgx := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	s := NewSeedFrom(1)
+	for i := 0; i < 10; i++ {
+		if u := randUUID(s); !rgx.MatchString(u) {
+			t.Error("want a version 4 uuid, got:", u)
+		}
+	}
+}
@@ -6,20 +6,22 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"os"
 	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	null "gopkg.in/volatiletech/null.v6"
 
+	"github.com/curvegrid/sqlboiler/boil"
 	"github.com/curvegrid/sqlboiler/strmangle"
 	"github.com/curvegrid/sqlboiler/types"
 	"github.com/pkg/errors"
-	"github.com/satori/go.uuid"
 )
 
 var (
@@ -61,25 +63,93 @@ var (
 	MaxPortNum = 65535
 )
 
-// Seed is an atomic counter for pseudo-randomization structs. Using full
-// randomization leads to collisions in a domain where uniqueness is an
-// important factor.
-type Seed int64
+// SeedEnv is the environment variable that fixes the start of the seeds
+// NewSeed creates, to reproduce the values of an earlier run.
+const SeedEnv = "SQLBOILER_SEED"
 
-// NewSeed creates a new seed for pseudo-randomization.
+var defaultSeed = seedFromEnv()
+
+func seedFromEnv() int64 {
+	env := os.Getenv(SeedEnv)
+	if len(env) == 0 {
+		return time.Now().Unix()
+	}
+
+	start, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("randomize: %s must be an integer, got: %q", SeedEnv, env))
+	}
+
+	return start
+}
+
+// Seed is the source of pseudo-randomization for structs. Most values count
+// up from its start atomically, as full randomization leads to collisions in
+// a domain where uniqueness is an important factor. The rest are drawn from a
+// PRNG seeded with the same start, so equal seeds produce equal values.
+type Seed struct {
+	counter int64
+	start   int64
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewSeed creates a new seed for pseudo-randomization starting from
+// DefaultSeed.
 func NewSeed() *Seed {
-	s := new(int64)
-	*s = time.Now().Unix()
-	return (*Seed)(s)
+	return NewSeedFrom(defaultSeed)
+}
+
+// NewSeedFrom creates a new seed for pseudo-randomization starting from start
+func NewSeedFrom(start int64) *Seed {
+	return &Seed{
+		counter: start,
+		start:   start,
+		rng:     rand.New(rand.NewSource(start)),
+	}
+}
+
+// DefaultSeed returns the start of the seeds created by NewSeed, the value
+// of the SQLBOILER_SEED environment variable if it is set or else the time
+// the program started. Printing it when tests fail lets them be rerun with
+// the same values.
+func DefaultSeed() int64 {
+	return defaultSeed
+}
+
+// Start returns the value the seed was created from
+func (s *Seed) Start() int64 {
+	return s.start
 }
 
 func (s *Seed) nextInt() int {
-	return int(atomic.AddInt64((*int64)(s), 1) % math.MaxInt32)
+	return int(atomic.AddInt64(&s.counter, 1) % math.MaxInt32)
+}
+
+func (s *Seed) intn(n int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rng.Intn(n)
+}
+
+func (s *Seed) int63n(n int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rng.Int63n(n)
+}
+
+func (s *Seed) read(b []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rng.Read(b)
 }
 
 // Struct gets its fields filled with random data based on the seed.
 // It will ignore the fields in the blacklist.
 // It will ignore fields that have the struct tag boil:"-"
+// If str has a ColumnLimits() method, as the generated models do, the
+// random values are kept within the limits of their columns.
 func Struct(s *Seed, str interface{}, colTypes map[string]string, canBeNull bool, blacklist ...string) error {
 	// Don't modify blacklist
 	copyBlacklist := make([]string, len(blacklist))
@@ -102,6 +172,11 @@ func Struct(s *Seed, str interface{}, colTypes map[string]string, canBeNull bool
 		return errors.Errorf("Inner element should be a struct, given a non-struct: %T", str)
 	}
 
+	var limits map[string]boil.ColumnLimits
+	if l, ok := str.(limiter); ok {
+		limits = l.ColumnLimits()
+	}
+
 	typ := value.Type()
 	nFields := value.NumField()
 
@@ -130,6 +205,9 @@ func Struct(s *Seed, str interface{}, colTypes map[string]string, canBeNull bool
 		if err := randomizeField(s, fieldVal, fieldDBType, canBeNull, fieldTyp.Name); err != nil {
 			return err
 		}
+		if lim, ok := limits[columnName(fieldTyp)]; ok {
+			limitField(s, fieldVal, lim)
+		}
 	}
 
 	return nil
@@ -237,49 +315,43 @@ func randomizeField(s *Seed, field reflect.Value, fieldType string, canBeNull bo
 					return nil
 				}
 				if fieldType == "uuid" {
-					randomUuid := uuid.NewV4()
-					defer func() {
-						if r := recover(); r != nil {
-							err = errors.Errorf("Critical error generating new UUID")
-						}
-					}()
-					value = null.NewString(randomUuid.String(), true)
+					value = null.NewString(randUUID(s), true)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "box" || fieldType == "line" || fieldType == "lseg" ||
 					fieldType == "path" || fieldType == "polygon" {
-					value = null.NewString(randBox(), true)
+					value = null.NewString(randBox(s), true)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "cidr" || fieldType == "inet" {
-					value = null.NewString(randNetAddr(), true)
+					value = null.NewString(randNetAddr(s), true)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "macaddr" {
-					value = null.NewString(randMacAddr(), true)
+					value = null.NewString(randMacAddr(s), true)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "circle" {
-					value = null.NewString(randCircle(), true)
+					value = null.NewString(randCircle(s), true)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "pg_lsn" {
-					value = null.NewString(randLsn(), true)
+					value = null.NewString(randLsn(s), true)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "point" {
-					value = null.NewString(randPoint(), true)
+					value = null.NewString(randPoint(s), true)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "txid_snapshot" {
-					value = null.NewString(randTxID(), true)
+					value = null.NewString(randTxID(s), true)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
@@ -316,48 +388,42 @@ func randomizeField(s *Seed, field reflect.Value, fieldType string, canBeNull bo
 					return nil
 				}
 				if fieldType == "uuid" {
-					value := uuid.NewV4()
-					defer func() {
-						if r := recover(); r != nil {
-							err = errors.Errorf("Critical error generating new UUID")
-						}
-					}()
-					field.Set(reflect.ValueOf(value.String()))
+					field.Set(reflect.ValueOf(randUUID(s)))
 					return nil
 				}
 				if fieldType == "box" || fieldType == "line" || fieldType == "lseg" ||
 					fieldType == "path" || fieldType == "polygon" {
-					value = randBox()
+					value = randBox(s)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "cidr" || fieldType == "inet" {
-					value = randNetAddr()
+					value = randNetAddr(s)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "macaddr" {
-					value = randMacAddr()
+					value = randMacAddr(s)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "circle" {
-					value = randCircle()
+					value = randCircle(s)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "pg_lsn" {
-					value = randLsn()
+					value = randLsn(s)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "point" {
-					value = randPoint()
+					value = randPoint(s)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
 				if fieldType == "txid_snapshot" {
-					value = randTxID()
+					value = randTxID(s)
 					field.Set(reflect.ValueOf(value))
 					return nil
 				}
@@ -443,42 +509,36 @@ func getArrayRandValue(s *Seed, typ reflect.Type, fieldType string) (randArray i
 			return types.StringArray{value, value}
 		}
 		if fieldType == "uuid" {
-			randomUuid := uuid.NewV4()
-			defer func() {
-				if r := recover(); r != nil {
-					randArray = errors.Errorf("Critical error generating new UUID")
-				}
-			}()
-			value := randomUuid.String()
+			value := randUUID(s)
 			return types.StringArray{value, value}
 		}
 		if fieldType == "box" || fieldType == "line" || fieldType == "lseg" ||
 			fieldType == "path" || fieldType == "polygon" {
-			value := randBox()
+			value := randBox(s)
 			return types.StringArray{value, value}
 		}
 		if fieldType == "cidr" || fieldType == "inet" {
-			value := randNetAddr()
+			value := randNetAddr(s)
 			return types.StringArray{value, value}
 		}
 		if fieldType == "macaddr" {
-			value := randMacAddr()
+			value := randMacAddr(s)
 			return types.StringArray{value, value}
 		}
 		if fieldType == "circle" {
-			value := randCircle()
+			value := randCircle(s)
 			return types.StringArray{value, value}
 		}
 		if fieldType == "pg_lsn" {
-			value := randLsn()
+			value := randLsn(s)
 			return types.StringArray{value, value}
 		}
 		if fieldType == "point" {
-			value := randPoint()
+			value := randPoint(s)
 			return types.StringArray{value, value}
 		}
 		if fieldType == "txid_snapshot" {
-			value := randTxID()
+			value := randTxID(s)
 			return types.StringArray{value, value}
 		}
 		if fieldType == "money" || fieldType == "numeric" {
@@ -582,7 +642,7 @@ func getStructRandValue(s *Seed, typ reflect.Type) interface{} {
 	case typeNullBytes:
 		return null.NewBytes(randByteSlice(s, 1), true)
 	case typeNullByte:
-		return null.NewByte(byte(rand.Intn(125-65)+65), true)
+		return null.NewByte(byte(s.intn(125-65)+65), true)
 	}
 
 	return nil
@@ -638,7 +698,7 @@ func getVariableZeroValue(s *Seed, kind reflect.Kind, typ reflect.Type) interfac
 func getVariableRandValue(s *Seed, kind reflect.Kind, typ reflect.Type) interface{} {
 	switch typ.String() {
 	case "types.Byte":
-		return types.Byte(rand.Intn(125-65) + 65)
+		return types.Byte(s.intn(125-65) + 65)
 	}
 
 	switch kind {
@@ -132,7 +132,7 @@ func TestRandomizeField(t *testing.T) {
 
 			// Make sure we never get back values that would be considered null
 			// by the boil whitelist generator, or by the database driver
-			if err := randomizeField(s, field, typ, false); err != nil {
+			if err := randomizeField(s, field, typ, false, ""); err != nil {
 				t.Errorf("%d) %s", i, err)
 			}
 
@@ -179,3 +179,46 @@ func TestRandEnumValue(t *testing.T) {
 		t.Errorf("Expected monday got: %q", r3)
 	}
 }
+
+func TestNewSeedFrom(t *testing.T) {
+	t.Parallel()
+
+	type values struct {
+		ID      int
+		UUID    string
+		Inet    string
+		MacAddr null.String
+		Box     string
+		Lsn     string
+	}
+	fieldTypes := map[string]string{
+		"ID":      "integer",
+		"UUID":    "uuid",
+		"Inet":    "inet",
+		"MacAddr": "macaddr",
+		"Box":     "box",
+		"Lsn":     "pg_lsn",
+	}
+
+	var one, two, other values
+	for i, v := range []*values{&one, &two, &other} {
+		s := NewSeedFrom(42)
+		if i == 2 {
+			s = NewSeedFrom(43)
+		}
+		if err := Struct(s, v, fieldTypes, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if one != two {
+		t.Errorf("want equal values from equal seeds\none: %#v\ntwo: %#v", one, two)
+	}
+	if one.UUID == other.UUID || one.Inet == other.Inet && one.Lsn == other.Lsn {
+		t.Errorf("want different values from a different seed, got: %#v", other)
+	}
+
+	if s := NewSeed(); s.Start() != DefaultSeed() {
+		t.Errorf("want NewSeed to start from %d, got: %d", DefaultSeed(), s.Start())
+	}
+}
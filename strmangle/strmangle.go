@@ -632,6 +632,22 @@ func GenerateIgnoreTags(tags []string) string {
 	return buf.String()
 }
 
+// DocComment turns a comment from the database into a Go line comment, every
+// line of the comment is prefixed with // and trailing whitespace is removed.
+func DocComment(s string) string {
+	lines := strings.Split(strings.TrimSpace(strings.Replace(s, "\r", "", -1)), "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		if len(line) == 0 {
+			lines[i] = "//"
+			continue
+		}
+		lines[i] = "// " + line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // ParseEnumVals returns the values from an enum string
 //
 // Postgres and MySQL drivers return different values
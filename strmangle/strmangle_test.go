@@ -516,6 +516,25 @@ func TestGenerateIgnoreTags(t *testing.T) {
 	}
 }
 
+func TestDocComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In  string
+		Out string
+	}{
+		{"name of the pilot", "// name of the pilot"},
+		{"first\nsecond  ", "// first\n// second"},
+		{"first\r\n\r\nthird\n", "// first\n//\n// third"},
+	}
+
+	for i, test := range tests {
+		if out := DocComment(test.In); out != test.Out {
+			t.Errorf("%d) want: %q, got: %q", i, test.Out, out)
+		}
+	}
+}
+
 func TestParseEnum(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,7372 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package templatebin
+
+var files = map[string]string{
+	"templates/00_struct.tpl": "{{- define \"relationship_to_one_struct_helper\" -}}\n" +
+		"{{- end -}}\n" +
+		"\n" +
+		"{{- $dot := . -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular -}}\n" +
+		"{{- $modelName := $tableNameSingular | titleCase -}}\n" +
+		"{{- $modelNameCamel := $tableNameSingular | camelCase -}}\n" +
+		"\n" +
+		"// {{$modelName}} is an object representing the database table.\n" +
+		"{{- if .Table.Comment}}\n" +
+		"//\n" +
+		"{{docComment .Table.Comment}}\n" +
+		"{{- end}}\n" +
+		"type {{$modelName}} struct {\n" +
+		"\t{{range $column := .Table.Columns }}\n" +
+		"\t{{- if $column.Comment}}\n" +
+		"\t{{docComment $column.Comment}}\n" +
+		"\t{{- end}}\n" +
+		"\t{{- if eq $dot.StructTagCasing \"camel\"}}\n" +
+		"\t{{titleCase $column.Name}} {{$column.Type}} `{{generateTags $dot.Tags $column.Name}}boil:\"{{$column.Name}}\" json:\"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}\" toml:\"{{$column.Name | camelCase}}\" yaml:\"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}\"`\n" +
+		"\t{{- else}}\n" +
+		"\t{{titleCase $column.Name}} {{$column.Type}} `{{generateTags $dot.Tags $column.Name}}boil:\"{{$column.Name}}\" json:\"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}\" toml:\"{{$column.Name}}\" yaml:\"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}\"`\n" +
+		"\t{{- end}}\n" +
+		"\t{{- end}}\n" +
+		"\t{{- if .Table.IsJoinTable -}}\n" +
+		"\t{{- else}}\n" +
+		"\tR *{{$modelNameCamel}}R `{{generateIgnoreTags $dot.Tags}}boil:\"-\" json:\"-\" toml:\"-\" yaml:\"-\"`\n" +
+		"\tL {{$modelNameCamel}}L `{{generateIgnoreTags $dot.Tags}}boil:\"-\" json:\"-\" toml:\"-\" yaml:\"-\"`\n" +
+		"\t{{- if not .Table.IsView}}\n" +
+		"\n" +
+		"\t// snapshot is a pointer so that == still compiles for models, but loaded\n" +
+		"\t// models each have their own and never compare equal\n" +
+		"\tsnapshot *[]interface{} `boil:\"-\"`\n" +
+		"\t{{- end}}\n" +
+		"\t{{end -}}\n" +
+		"}\n" +
+		"\n" +
+		"var {{$modelName}}Columns = struct {\n" +
+		"\t{{range $column := .Table.Columns -}}\n" +
+		"\t{{if $column.Comment -}}\n" +
+		"\t{{docComment $column.Comment}}\n" +
+		"\t{{end -}}\n" +
+		"\t{{titleCase $column.Name}} string\n" +
+		"\t{{end -}}\n" +
+		"}{\n" +
+		"\t{{range $column := .Table.Columns -}}\n" +
+		"\t{{titleCase $column.Name}}: \"{{$column.Name}}\",\n" +
+		"\t{{end -}}\n" +
+		"}\n" +
+		"\n" +
+		"{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else}}\n" +
+		"// {{$modelNameCamel}}R is where relationships are stored.\n" +
+		"type {{$modelNameCamel}}R struct {\n" +
+		"\t{{range .Table.FKeys -}}\n" +
+		"\t{{- $txt := txtsFromFKey $dot.Tables $dot.Table . -}}\n" +
+		"\t{{$txt.Function.Name}} *{{$txt.ForeignTable.NameGo}}\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\t{{range .Table.ToOneRelationships -}}\n" +
+		"\t{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table . -}}\n" +
+		"\t{{$txt.Function.Name}} *{{$txt.ForeignTable.NameGo}}\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\t{{range .Table.ToManyRelationships -}}\n" +
+		"\t{{- $txt := txtsFromToMany $dot.Tables $dot.Table . -}}\n" +
+		"\t{{$txt.Function.Name}} {{$txt.ForeignTable.Slice}}\n" +
+		"\t{{end -}}{{/* range tomany */}}\n" +
+		"}\n" +
+		"\n" +
+		"// {{$modelNameCamel}}L is where Load methods for each relationship are stored.\n" +
+		"type {{$modelNameCamel}}L struct{}\n" +
+		"{{end -}}\n",
+	"templates/01_types.tpl": "{{if .Table.IsJoinTable -}}\n" +
+		"{{else -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"var (\n" +
+		"\t{{$varNameSingular}}Columns               = []string{{\"{\"}}{{.Table.Columns | columnNames | stringMap .StringFuncs.quoteWrap | join \", \"}}{{\"}\"}}\n" +
+		"\t{{if eq .DriverName \"mssql\" -}}\n" +
+		"\t{{$varNameSingular}}ColumnsWithAuto = []string{{\"{\"}}{{.Table.Columns | filterColumnsByAuto true | columnNames | stringMap .StringFuncs.quoteWrap | join \",\"}}{{\"}\"}}\n" +
+		"\t{{end -}}\n" +
+		"\t{{$varNameSingular}}ColumnsWithoutDefault = []string{{\"{\"}}{{.Table.Columns | filterColumnsByDefault false | columnNames | stringMap .StringFuncs.quoteWrap | join \",\"}}{{\"}\"}}\n" +
+		"\t{{$varNameSingular}}ColumnsWithDefault    = []string{{\"{\"}}{{.Table.Columns | filterColumnsByDefault true | columnNames | stringMap .StringFuncs.quoteWrap | join \",\"}}{{\"}\"}}\n" +
+		"\t{{if .Table.PKey -}}\n" +
+		"\t{{$varNameSingular}}PrimaryKeyColumns     = []string{{\"{\"}}{{.Table.PKey.Columns | stringMap .StringFuncs.quoteWrap | join \", \"}}{{\"}\"}}\n" +
+		"\t{{end -}}\n" +
+		"\t{{if not .Table.IsView -}}\n" +
+		"\t{{$varNameSingular}}ColumnLimits = map[string]boil.ColumnLimits{\n" +
+		"\t\t{{range columnLimits .Table.Columns -}}\n" +
+		"\t\t{{.}},\n" +
+		"\t\t{{end -}}\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		")\n" +
+		"\n" +
+		"type (\n" +
+		"\t// {{$tableNameSingular}}Slice is an alias for a slice of pointers to {{$tableNameSingular}}.\n" +
+		"\t// This should generally be used opposed to []{{$tableNameSingular}}.\n" +
+		"\t{{$tableNameSingular}}Slice []*{{$tableNameSingular}}\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\t// {{$tableNameSingular}}Hook is the signature for custom {{$tableNameSingular}} hook methods\n" +
+		"\t{{$tableNameSingular}}Hook func(boil.Executor, *{{$tableNameSingular}}) error\n" +
+		"\t{{- if not .Table.IsView}}\n" +
+		"\t// {{$tableNameSingular}}BulkHook is the signature for custom {{$tableNameSingular}} hook methods\n" +
+		"\t// run around UpdateAll and DeleteAll. The query methods pass their query and a nil slice,\n" +
+		"\t// the slice methods a nil query and their slice. cols is nil for DeleteAll.\n" +
+		"\t{{$tableNameSingular}}BulkHook func(exec boil.Executor, q *queries.Query, o {{$tableNameSingular}}Slice, cols M) error\n" +
+		"\t{{- end}}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{$varNameSingular}}Query struct {\n" +
+		"\t\t*queries.Query\n" +
+		"\t}\n" +
+		")\n" +
+		"\n" +
+		"// Cache for insert, update and upsert\n" +
+		"var (\n" +
+		"\t{{$varNameSingular}}Type = reflect.TypeOf(&{{$tableNameSingular}}{})\n" +
+		"\t{{$varNameSingular}}Mapping = queries.MakeStructMapping({{$varNameSingular}}Type)\n" +
+		"\t{{if .Table.PKey -}}\n" +
+		"\t{{$varNameSingular}}PrimaryKeyMapping, _ = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, {{$varNameSingular}}PrimaryKeyColumns)\n" +
+		"\t{{end -}}\n" +
+		"\t{{if not .Table.IsView -}}\n" +
+		"\t{{$varNameSingular}}ColumnsMapping, _ = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, {{$varNameSingular}}Columns)\n" +
+		"\t{{$varNameSingular}}InsertCacheMut sync.RWMutex\n" +
+		"\t{{$varNameSingular}}InsertCache = make(map[string]insertCache)\n" +
+		"\t{{$varNameSingular}}UpdateCacheMut sync.RWMutex\n" +
+		"\t{{$varNameSingular}}UpdateCache = make(map[string]updateCache)\n" +
+		"\t{{$varNameSingular}}UpsertCacheMut sync.RWMutex\n" +
+		"\t{{$varNameSingular}}UpsertCache = make(map[string]insertCache)\n" +
+		"\t{{end -}}\n" +
+		")\n" +
+		"\n" +
+		"var (\n" +
+		"\t// Force time package dependency for automated UpdatedAt/CreatedAt.\n" +
+		"\t_ = time.Second\n" +
+		"\t// Force bytes in case of primary key column that uses []byte (for relationship compares)\n" +
+		"\t_ = bytes.MinRead\n" +
+		"\t{{- if .Table.IsView}}\n" +
+		"\t// Force the dependencies of the write operations and Find which views\n" +
+		"\t// may go without.\n" +
+		"\t_ sync.Mutex\n" +
+		"\t_ = fmt.Sprintf\n" +
+		"\t_ = strings.Join\n" +
+		"\t_ = strmangle.IdentQuoteSlice\n" +
+		"\t{{- end}}\n" +
+		")\n" +
+		"{{end -}}\n",
+	"templates/02_hooks.tpl": "{{- if not .NoHooks -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- if .Table.IsView}}\n" +
+		"var {{$varNameSingular}}AfterSelectHooks []{{$tableNameSingular}}Hook\n" +
+		"{{- else}}\n" +
+		"var {{$varNameSingular}}BeforeInsertHooks []{{$tableNameSingular}}Hook\n" +
+		"var {{$varNameSingular}}BeforeUpdateHooks []{{$tableNameSingular}}Hook\n" +
+		"var {{$varNameSingular}}BeforeDeleteHooks []{{$tableNameSingular}}Hook\n" +
+		"var {{$varNameSingular}}BeforeUpsertHooks []{{$tableNameSingular}}Hook\n" +
+		"\n" +
+		"var {{$varNameSingular}}AfterInsertHooks []{{$tableNameSingular}}Hook\n" +
+		"var {{$varNameSingular}}AfterSelectHooks []{{$tableNameSingular}}Hook\n" +
+		"var {{$varNameSingular}}AfterUpdateHooks []{{$tableNameSingular}}Hook\n" +
+		"var {{$varNameSingular}}AfterDeleteHooks []{{$tableNameSingular}}Hook\n" +
+		"var {{$varNameSingular}}AfterUpsertHooks []{{$tableNameSingular}}Hook\n" +
+		"\n" +
+		"var {{$varNameSingular}}BeforeUpdateAllHooks []{{$tableNameSingular}}BulkHook\n" +
+		"var {{$varNameSingular}}BeforeDeleteAllHooks []{{$tableNameSingular}}BulkHook\n" +
+		"\n" +
+		"var {{$varNameSingular}}AfterUpdateAllHooks []{{$tableNameSingular}}BulkHook\n" +
+		"var {{$varNameSingular}}AfterDeleteAllHooks []{{$tableNameSingular}}BulkHook\n" +
+		"\n" +
+		"var {{$varNameSingular}}AfterCommitHooks []{{$tableNameSingular}}Hook\n" +
+		"var {{$varNameSingular}}AfterRollbackHooks []{{$tableNameSingular}}Hook\n" +
+		"{{- end}}\n" +
+		"\n" +
+		"{{if not .Table.IsView -}}\n" +
+		"// doBeforeInsertHooks executes all \"before insert\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doBeforeInsertHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}BeforeInsertHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// doBeforeUpdateHooks executes all \"before Update\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doBeforeUpdateHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}BeforeUpdateHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// doBeforeDeleteHooks executes all \"before Delete\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doBeforeDeleteHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}BeforeDeleteHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// doBeforeUpsertHooks executes all \"before Upsert\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doBeforeUpsertHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}BeforeUpsertHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// doAfterInsertHooks executes all \"after Insert\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doAfterInsertHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}AfterInsertHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.doAfterTxHooks(exec)\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"{{end -}}\n" +
+		"// doAfterSelectHooks executes all \"after Select\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doAfterSelectHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}AfterSelectHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"{{if not .Table.IsView -}}\n" +
+		"// doAfterUpdateHooks executes all \"after Update\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doAfterUpdateHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}AfterUpdateHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.doAfterTxHooks(exec)\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// doAfterDeleteHooks executes all \"after Delete\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doAfterDeleteHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}AfterDeleteHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.doAfterTxHooks(exec)\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// doAfterUpsertHooks executes all \"after Upsert\" hooks.\n" +
+		"func (o *{{$tableNameSingular}}) doAfterUpsertHooks(exec boil.Executor) (err error) {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range {{$varNameSingular}}AfterUpsertHooks {\n" +
+		"\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.doAfterTxHooks(exec)\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// doAfterTxHooks registers the \"after commit\" and \"after rollback\" hooks to run\n" +
+		"// once the transaction o was written in ends, it is called once the write and\n" +
+		"// its \"after\" hooks succeeded. Their errors are dropped, the transaction being\n" +
+		"// over by then.\n" +
+		"func (o *{{$tableNameSingular}}) doAfterTxHooks(exec boil.Executor) {\n" +
+		"\tif len({{$varNameSingular}}AfterCommitHooks) != 0 {\n" +
+		"\t\tboil.AfterCommit(exec, func() {\n" +
+		"\t\t\tfor _, hook := range {{$varNameSingular}}AfterCommitHooks {\n" +
+		"\t\t\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\t\t\treturn\n" +
+		"\t\t\t\t}\n" +
+		"\t\t\t}\n" +
+		"\t\t})\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif len({{$varNameSingular}}AfterRollbackHooks) != 0 {\n" +
+		"\t\tboil.AfterRollback(exec, func() {\n" +
+		"\t\t\tfor _, hook := range {{$varNameSingular}}AfterRollbackHooks {\n" +
+		"\t\t\t\tif err := hook(exec, o); err != nil {\n" +
+		"\t\t\t\t\treturn\n" +
+		"\t\t\t\t}\n" +
+		"\t\t\t}\n" +
+		"\t\t})\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// do{{$tableNameSingular}}BulkHooks executes the given bulk hooks, q or o is nil\n" +
+		"// depending on whether they run for a query or a slice.\n" +
+		"func do{{$tableNameSingular}}BulkHooks(hooks []{{$tableNameSingular}}BulkHook, exec boil.Executor, q *queries.Query, o {{$tableNameSingular}}Slice, cols M) error {\n" +
+		"\tif boil.HooksAreSkipped(exec) {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, hook := range hooks {\n" +
+		"\t\tif err := hook(exec, q, o, cols); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"{{end -}}\n" +
+		"// Add{{$tableNameSingular}}Hook registers your hook function for all future operations.\n" +
+		"{{- if not .Table.IsView}}\n" +
+		"// The boil.AfterCommitHook and boil.AfterRollbackHook hooks run once the transaction\n" +
+		"// ends, see boil.AfterCommit, so the errors they return are dropped.\n" +
+		"{{- end}}\n" +
+		"func Add{{$tableNameSingular}}Hook(hookPoint boil.HookPoint, {{$varNameSingular}}Hook {{$tableNameSingular}}Hook) {\n" +
+		"\tswitch hookPoint {\n" +
+		"\t\t{{- if not .Table.IsView}}\n" +
+		"\t\tcase boil.BeforeInsertHook:\n" +
+		"\t\t\t{{$varNameSingular}}BeforeInsertHooks = append({{$varNameSingular}}BeforeInsertHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\tcase boil.BeforeUpdateHook:\n" +
+		"\t\t\t{{$varNameSingular}}BeforeUpdateHooks = append({{$varNameSingular}}BeforeUpdateHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\tcase boil.BeforeDeleteHook:\n" +
+		"\t\t\t{{$varNameSingular}}BeforeDeleteHooks = append({{$varNameSingular}}BeforeDeleteHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\tcase boil.BeforeUpsertHook:\n" +
+		"\t\t\t{{$varNameSingular}}BeforeUpsertHooks = append({{$varNameSingular}}BeforeUpsertHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\tcase boil.AfterInsertHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterInsertHooks = append({{$varNameSingular}}AfterInsertHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\t{{- end}}\n" +
+		"\t\tcase boil.AfterSelectHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterSelectHooks = append({{$varNameSingular}}AfterSelectHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\t{{- if not .Table.IsView}}\n" +
+		"\t\tcase boil.AfterUpdateHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterUpdateHooks = append({{$varNameSingular}}AfterUpdateHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\tcase boil.AfterDeleteHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterDeleteHooks = append({{$varNameSingular}}AfterDeleteHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\tcase boil.AfterUpsertHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterUpsertHooks = append({{$varNameSingular}}AfterUpsertHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\tcase boil.AfterCommitHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterCommitHooks = append({{$varNameSingular}}AfterCommitHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\tcase boil.AfterRollbackHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterRollbackHooks = append({{$varNameSingular}}AfterRollbackHooks, {{$varNameSingular}}Hook)\n" +
+		"\t\t{{- end}}\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{- if not .Table.IsView}}\n" +
+		"\n" +
+		"// Add{{$tableNameSingular}}BulkHook registers your hook function for all future UpdateAll\n" +
+		"// and DeleteAll operations, hookPoint is one of boil.BeforeUpdateAllHook, boil.AfterUpdateAllHook,\n" +
+		"// boil.BeforeDeleteAllHook and boil.AfterDeleteAllHook.\n" +
+		"func Add{{$tableNameSingular}}BulkHook(hookPoint boil.HookPoint, {{$varNameSingular}}BulkHook {{$tableNameSingular}}BulkHook) {\n" +
+		"\tswitch hookPoint {\n" +
+		"\t\tcase boil.BeforeUpdateAllHook:\n" +
+		"\t\t\t{{$varNameSingular}}BeforeUpdateAllHooks = append({{$varNameSingular}}BeforeUpdateAllHooks, {{$varNameSingular}}BulkHook)\n" +
+		"\t\tcase boil.AfterUpdateAllHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterUpdateAllHooks = append({{$varNameSingular}}AfterUpdateAllHooks, {{$varNameSingular}}BulkHook)\n" +
+		"\t\tcase boil.BeforeDeleteAllHook:\n" +
+		"\t\t\t{{$varNameSingular}}BeforeDeleteAllHooks = append({{$varNameSingular}}BeforeDeleteAllHooks, {{$varNameSingular}}BulkHook)\n" +
+		"\t\tcase boil.AfterDeleteAllHook:\n" +
+		"\t\t\t{{$varNameSingular}}AfterDeleteAllHooks = append({{$varNameSingular}}AfterDeleteAllHooks, {{$varNameSingular}}BulkHook)\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{- end}}\n" +
+		"{{- end}}\n",
+	"templates/03_finishers.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"// OneP returns a single {{$varNameSingular}} record from the query, and panics on error.\n" +
+		"func (q {{$varNameSingular}}Query) OneP() (*{{$tableNameSingular}}) {\n" +
+		"\to, err := q.One()\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o\n" +
+		"}\n" +
+		"\n" +
+		"// One returns a single {{$varNameSingular}} record from the query.\n" +
+		"func (q {{$varNameSingular}}Query) One() (*{{$tableNameSingular}}, error) {\n" +
+		"\to := &{{$tableNameSingular}}{}\n" +
+		"\n" +
+		"\tqueries.SetLimit(q.Query, 1)\n" +
+		"\n" +
+		"\terr := q.Bind(o)\n" +
+		"\tif err != nil {\n" +
+		"\t\tif errors.Cause(err) == sql.ErrNoRows {\n" +
+		"\t\t\treturn nil, sql.ErrNoRows\n" +
+		"\t\t}\n" +
+		"\t\treturn nil, errors.Wrap(err, \"{{.PkgName}}: failed to execute a one query for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err := o.doAfterSelectHooks(queries.GetExecutor(q.Query)); err != nil {\n" +
+		"\t\treturn o, err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\treturn o, nil\n" +
+		"}\n" +
+		"\n" +
+		"// AllP returns all {{$tableNameSingular}} records from the query, and panics on error.\n" +
+		"func (q {{$varNameSingular}}Query) AllP() {{$tableNameSingular}}Slice {\n" +
+		"\to, err := q.All()\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o\n" +
+		"}\n" +
+		"\n" +
+		"// All returns all {{$tableNameSingular}} records from the query.\n" +
+		"func (q {{$varNameSingular}}Query) All() ({{$tableNameSingular}}Slice, error) {\n" +
+		"\tvar o []*{{$tableNameSingular}}\n" +
+		"\n" +
+		"\terr := q.Bind(&o)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn nil, errors.Wrap(err, \"{{.PkgName}}: failed to assign all query results to {{$tableNameSingular}} slice\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif len({{$varNameSingular}}AfterSelectHooks) != 0 {\n" +
+		"\t\tfor _, obj := range o {\n" +
+		"\t\t\tif err := obj.doAfterSelectHooks(queries.GetExecutor(q.Query)); err != nil {\n" +
+		"\t\t\t\treturn o, err\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\treturn o, nil\n" +
+		"}\n" +
+		"\n" +
+		"// CountP returns the count of all {{$tableNameSingular}} records in the query, and panics on error.\n" +
+		"func (q {{$varNameSingular}}Query) CountP() int64 {\n" +
+		"\tc, err := q.Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn c\n" +
+		"}\n" +
+		"\n" +
+		"// Count returns the count of all {{$tableNameSingular}} records in the query.\n" +
+		"func (q {{$varNameSingular}}Query) Count() (int64, error) {\n" +
+		"\tvar count int64\n" +
+		"\n" +
+		"\tqueries.SetSelect(q.Query, nil)\n" +
+		"\tqueries.SetCount(q.Query)\n" +
+		"\n" +
+		"\terr := q.Query.QueryRow().Scan(&count)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(err, \"{{.PkgName}}: failed to count {{.Table.Name}} rows\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn count, nil\n" +
+		"}\n" +
+		"\n" +
+		"// Exists checks if the row exists in the table, and panics on error.\n" +
+		"func (q {{$varNameSingular}}Query) ExistsP() bool {\n" +
+		"\te, err := q.Exists()\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn e\n" +
+		"}\n" +
+		"\n" +
+		"// Exists checks if the row exists in the table.\n" +
+		"func (q {{$varNameSingular}}Query) Exists() (bool, error) {\n" +
+		"\tvar count int64\n" +
+		"\n" +
+		"\tqueries.SetCount(q.Query)\n" +
+		"\tqueries.SetLimit(q.Query, 1)\n" +
+		"\n" +
+		"\terr := q.Query.QueryRow().Scan(&count)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn false, errors.Wrap(err, \"{{.PkgName}}: failed to check if {{.Table.Name}} exists\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn count > 0, nil\n" +
+		"}\n",
+	"templates/04_relationship_to_one.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.FKeys -}}\n" +
+		"\t\t{{- $txt := txtsFromFKey $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $varNameSingular := .ForeignTable | singular | camelCase}}\n" +
+		"// {{$txt.Function.Name}}G pointed to by the foreign key.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) {{$txt.Function.Name}}G(mods ...qm.QueryMod) {{$varNameSingular}}Query {\n" +
+		"\treturn o.{{$txt.Function.Name}}(boil.GetDB(), mods...)\n" +
+		"}\n" +
+		"\n" +
+		"// {{$txt.Function.Name}} pointed to by the foreign key.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) {{$txt.Function.Name}}(exec boil.Executor, mods ...qm.QueryMod) ({{$varNameSingular}}Query) {\n" +
+		"\tqueryMods := []qm.QueryMod{\n" +
+		"\t\tqm.Where(\"{{range $i, $col := $txt.Columns}}{{if $i}} AND {{end}}{{$col.ForeignColumn}}=?{{end}}\"{{range $txt.Columns}}, o.{{.LocalColumnGo}}{{end}}),\n" +
+		"\t}\n" +
+		"\n" +
+		"\tqueryMods = append(queryMods, mods...)\n" +
+		"\n" +
+		"\tquery := {{$txt.ForeignTable.NamePluralGo}}(exec, queryMods...)\n" +
+		"\tqueries.SetFrom(query.Query, \"{{.ForeignTable | $dot.SchemaTable}}\")\n" +
+		"\n" +
+		"\treturn query\n" +
+		"}\n" +
+		"{{- end -}}\n" +
+		"{{- end -}}\n",
+	"templates/05_relationship_one_to_one.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.ToOneRelationships -}}\n" +
+		"\t\t{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $varNameSingular := .ForeignTable | singular | camelCase}}\n" +
+		"// {{$txt.Function.Name}}G pointed to by the foreign key.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) {{$txt.Function.Name}}G(mods ...qm.QueryMod) {{$varNameSingular}}Query {\n" +
+		"\treturn o.{{$txt.Function.Name}}(boil.GetDB(), mods...)\n" +
+		"}\n" +
+		"\n" +
+		"// {{$txt.Function.Name}} pointed to by the foreign key.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) {{$txt.Function.Name}}(exec boil.Executor, mods ...qm.QueryMod) ({{$varNameSingular}}Query) {\n" +
+		"\tqueryMods := []qm.QueryMod{\n" +
+		"\t\tqm.Where(\"{{range $i, $col := $txt.Columns}}{{if $i}} AND {{end}}{{$col.ForeignColumn}}=?{{end}}\"{{range $txt.Columns}}, o.{{.LocalColumnGo}}{{end}}),\n" +
+		"\t}\n" +
+		"\n" +
+		"\tqueryMods = append(queryMods, mods...)\n" +
+		"\n" +
+		"\tquery := {{$txt.ForeignTable.NamePluralGo}}(exec, queryMods...)\n" +
+		"\tqueries.SetFrom(query.Query, \"{{.ForeignTable | $dot.SchemaTable}}\")\n" +
+		"\n" +
+		"\treturn query\n" +
+		"}\n" +
+		"{{- end -}}\n" +
+		"{{- end -}}\n",
+	"templates/06_relationship_to_many.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- $table := .Table -}}\n" +
+		"\t{{- range .Table.ToManyRelationships -}}\n" +
+		"\t\t{{- $varNameSingular := .ForeignTable | singular | camelCase -}}\n" +
+		"\t\t{{- $txt := txtsFromToMany $dot.Tables $table . -}}\n" +
+		"\t\t{{- $schemaForeignTable := .ForeignTable | $dot.SchemaTable}}\n" +
+		"// {{$txt.Function.Name}}G retrieves all the {{.ForeignTable | singular}}'s {{$txt.ForeignTable.NameHumanReadable}}\n" +
+		"{{- if not (eq $txt.Function.Name $txt.ForeignTable.NamePluralGo)}} via {{.ForeignColumn}} column{{- end}}.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) {{$txt.Function.Name}}G(mods ...qm.QueryMod) {{$varNameSingular}}Query {\n" +
+		"\treturn o.{{$txt.Function.Name}}(boil.GetDB(), mods...)\n" +
+		"}\n" +
+		"\n" +
+		"// {{$txt.Function.Name}} retrieves all the {{.ForeignTable | singular}}'s {{$txt.ForeignTable.NameHumanReadable}} with an executor\n" +
+		"{{- if not (eq $txt.Function.Name $txt.ForeignTable.NamePluralGo)}} via {{.ForeignColumn}} column{{- end}}.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) {{$txt.Function.Name}}(exec boil.Executor, mods ...qm.QueryMod) {{$varNameSingular}}Query {\n" +
+		"\tvar queryMods []qm.QueryMod\n" +
+		"\tif len(mods) != 0 {\n" +
+		"\t\tqueryMods = append(queryMods, mods...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t\t{{if .ToJoinTable -}}\n" +
+		"\tqueryMods = append(queryMods,\n" +
+		"\t\t{{$schemaJoinTable := .JoinTable | $.SchemaTable -}}\n" +
+		"\t\tqm.InnerJoin(\"{{$schemaJoinTable}} on {{$schemaForeignTable}}.{{.ForeignColumn | $dot.Quotes}} = {{$schemaJoinTable}}.{{.JoinForeignColumn | $dot.Quotes}}\"),\n" +
+		"\t\tqm.Where(\"{{$schemaJoinTable}}.{{.JoinLocalColumn | $dot.Quotes}}=?\", o.{{$txt.LocalTable.ColumnNameGo}}),\n" +
+		"\t)\n" +
+		"\t\t{{else -}}\n" +
+		"\tqueryMods = append(queryMods,\n" +
+		"\t\tqm.Where(\"{{range $i, $col := $txt.Columns}}{{if $i}} AND {{end}}{{$schemaForeignTable}}.{{$col.ForeignColumn | $dot.Quotes}}=?{{end}}\"{{range $txt.Columns}}, o.{{.LocalColumnGo}}{{end}}),\n" +
+		"\t)\n" +
+		"\t\t{{end}}\n" +
+		"\n" +
+		"\tquery := {{$txt.ForeignTable.NamePluralGo}}(exec, queryMods...)\n" +
+		"\tqueries.SetFrom(query.Query, \"{{$schemaForeignTable}}\")\n" +
+		"\n" +
+		"\tif len(queries.GetSelect(query.Query)) == 0 {\n" +
+		"\t\tqueries.SetSelect(query.Query, []string{\"{{$schemaForeignTable}}.*\"})\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn query\n" +
+		"}\n" +
+		"\n" +
+		"{{end -}}{{- /* range relationships */ -}}\n" +
+		"{{- end -}}{{- /* if isJoinTable */ -}}\n",
+	"templates/07_relationship_to_one_eager.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.FKeys -}}\n" +
+		"\t\t{{- $txt := txtsFromFKey $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $varNameSingular := $dot.Table.Name | singular | camelCase -}}\n" +
+		"\t\t{{- $arg := printf \"maybe%s\" $txt.LocalTable.NameGo -}}\n" +
+		"// Load{{$txt.Function.Name}} allows an eager lookup of values, cached into the\n" +
+		"// loaded structs of the objects.\n" +
+		"func ({{$varNameSingular}}L) Load{{$txt.Function.Name}}(e boil.Executor, singular bool, {{$arg}} interface{}) error {\n" +
+		"\tvar slice []*{{$txt.LocalTable.NameGo}}\n" +
+		"\tvar object *{{$txt.LocalTable.NameGo}}\n" +
+		"\n" +
+		"\tcount := 1\n" +
+		"\tif singular {\n" +
+		"\t\tobject = {{$arg}}.(*{{$txt.LocalTable.NameGo}})\n" +
+		"\t} else {\n" +
+		"\t\tslice = *{{$arg}}.(*[]*{{$txt.LocalTable.NameGo}})\n" +
+		"\t\tcount = len(slice)\n" +
+		"\t}\n" +
+		"\n" +
+		"\targs := make([]interface{}, 0, count{{if gt (len $txt.Columns) 1}}*{{len $txt.Columns}}{{end}})\n" +
+		"\tif singular {\n" +
+		"\t\tif object.R == nil {\n" +
+		"\t\t\tobject.R = &{{$varNameSingular}}R{}\n" +
+		"\t\t}\n" +
+		"\t\targs = append(args{{range $txt.Columns}}, object.{{.LocalColumnGo}}{{end}})\n" +
+		"\t} else {\n" +
+		"\t\tfor _, obj := range slice {\n" +
+		"\t\t\tif obj.R == nil {\n" +
+		"\t\t\t\tobj.R = &{{$varNameSingular}}R{}\n" +
+		"\t\t\t}\n" +
+		"\t\t\targs = append(args{{range $txt.Columns}}, obj.{{.LocalColumnGo}}{{end}})\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if gt (len $txt.Columns) 1 -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select * from {{.ForeignTable | $dot.SchemaTableIn \"e\"}} where %s\",\n" +
+		"\t\tstrmangle.WhereClauseRepeated(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{\"{\"}}{{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}\"{{$col.ForeignColumn}}\"{{end}}{{\"}\"}}, count),\n" +
+		"\t)\n" +
+		"\t{{- else -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select * from {{.ForeignTable | $dot.SchemaTableIn \"e\"}} where {{.ForeignColumn | $dot.Quotes}} in (%s)\",\n" +
+		"\t\tstrmangle.Placeholders(dialect.IndexPlaceholders, count, 1, 1),\n" +
+		"\t)\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintf(boil.DebugWriter, \"%s\\n%v\\n\", query, args)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tresults, err := e.Query(query, args...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to eager load {{$txt.ForeignTable.NameGo}}\")\n" +
+		"\t}\n" +
+		"\tdefer results.Close()\n" +
+		"\n" +
+		"\tvar resultSlice []*{{$txt.ForeignTable.NameGo}}\n" +
+		"\tif err = queries.Bind(results, &resultSlice); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to bind eager loaded slice {{$txt.ForeignTable.NameGo}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if not $dot.NoHooks -}}\n" +
+		"\tif len({{$varNameSingular}}AfterSelectHooks) != 0 {\n" +
+		"\t\tfor _, obj := range resultSlice {\n" +
+		"\t\t\tif err := obj.doAfterSelectHooks(e); err != nil {\n" +
+		"\t\t\t\treturn err\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif len(resultSlice) == 0 {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif singular {\n" +
+		"\t\tobject.R.{{$txt.Function.Name}} = resultSlice[0]\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, local := range slice {\n" +
+		"\t\tfor _, foreign := range resultSlice {\n" +
+		"\t\t\tif {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(local.{{$col.LocalAssignment}}, foreign.{{$col.ForeignAssignment}}){{else}}local.{{$col.LocalAssignment}} == foreign.{{$col.ForeignAssignment}}{{end}}{{end}} {\n" +
+		"\t\t\t\tlocal.R.{{$txt.Function.Name}} = foreign\n" +
+		"\t\t\t\tbreak\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"{{end -}}{{/* range */}}\n" +
+		"{{end}}{{/* join table */}}\n",
+	"templates/08_relationship_one_to_one_eager.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.ToOneRelationships -}}\n" +
+		"\t\t{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $varNameSingular := $dot.Table.Name | singular | camelCase -}}\n" +
+		"\t\t{{- $arg := printf \"maybe%s\" $txt.LocalTable.NameGo -}}\n" +
+		"// Load{{$txt.Function.Name}} allows an eager lookup of values, cached into the\n" +
+		"// loaded structs of the objects.\n" +
+		"func ({{$varNameSingular}}L) Load{{$txt.Function.Name}}(e boil.Executor, singular bool, {{$arg}} interface{}) error {\n" +
+		"\tvar slice []*{{$txt.LocalTable.NameGo}}\n" +
+		"\tvar object *{{$txt.LocalTable.NameGo}}\n" +
+		"\n" +
+		"\tcount := 1\n" +
+		"\tif singular {\n" +
+		"\t\tobject = {{$arg}}.(*{{$txt.LocalTable.NameGo}})\n" +
+		"\t} else {\n" +
+		"\t\tslice = *{{$arg}}.(*[]*{{$txt.LocalTable.NameGo}})\n" +
+		"\t\tcount = len(slice)\n" +
+		"\t}\n" +
+		"\n" +
+		"\targs := make([]interface{}, 0, count{{if gt (len $txt.Columns) 1}}*{{len $txt.Columns}}{{end}})\n" +
+		"\tif singular {\n" +
+		"\t\tif object.R == nil {\n" +
+		"\t\t\tobject.R = &{{$varNameSingular}}R{}\n" +
+		"\t\t}\n" +
+		"\t\targs = append(args{{range $txt.Columns}}, object.{{.LocalColumnGo}}{{end}})\n" +
+		"\t} else {\n" +
+		"\t\tfor _, obj := range slice {\n" +
+		"\t\t\tif obj.R == nil {\n" +
+		"\t\t\t\tobj.R = &{{$varNameSingular}}R{}\n" +
+		"\t\t\t}\n" +
+		"\t\t\targs = append(args{{range $txt.Columns}}, obj.{{.LocalColumnGo}}{{end}})\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if gt (len $txt.Columns) 1 -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select * from {{.ForeignTable | $dot.SchemaTableIn \"e\"}} where %s\",\n" +
+		"\t\tstrmangle.WhereClauseRepeated(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{\"{\"}}{{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}\"{{$col.ForeignColumn}}\"{{end}}{{\"}\"}}, count),\n" +
+		"\t)\n" +
+		"\t{{- else -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select * from {{.ForeignTable | $dot.SchemaTableIn \"e\"}} where {{.ForeignColumn | $dot.Quotes}} in (%s)\",\n" +
+		"\t\tstrmangle.Placeholders(dialect.IndexPlaceholders, count, 1, 1),\n" +
+		"\t)\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintf(boil.DebugWriter, \"%s\\n%v\\n\", query, args)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tresults, err := e.Query(query, args...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to eager load {{$txt.ForeignTable.NameGo}}\")\n" +
+		"\t}\n" +
+		"\tdefer results.Close()\n" +
+		"\n" +
+		"\tvar resultSlice []*{{$txt.ForeignTable.NameGo}}\n" +
+		"\tif err = queries.Bind(results, &resultSlice); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to bind eager loaded slice {{$txt.ForeignTable.NameGo}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if not $dot.NoHooks -}}\n" +
+		"\tif len({{$varNameSingular}}AfterSelectHooks) != 0 {\n" +
+		"\t\tfor _, obj := range resultSlice {\n" +
+		"\t\t\tif err := obj.doAfterSelectHooks(e); err != nil {\n" +
+		"\t\t\t\treturn err\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif len(resultSlice) == 0 {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif singular {\n" +
+		"\t\tobject.R.{{$txt.Function.Name}} = resultSlice[0]\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, local := range slice {\n" +
+		"\t\tfor _, foreign := range resultSlice {\n" +
+		"\t\t\tif {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(local.{{$col.LocalAssignment}}, foreign.{{$col.ForeignAssignment}}){{else}}local.{{$col.LocalAssignment}} == foreign.{{$col.ForeignAssignment}}{{end}}{{end}} {\n" +
+		"\t\t\t\tlocal.R.{{$txt.Function.Name}} = foreign\n" +
+		"\t\t\t\tbreak\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"{{end -}}{{/* range */}}\n" +
+		"{{end}}{{/* join table */}}\n",
+	"templates/09_relationship_to_many_eager.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.ToManyRelationships -}}\n" +
+		"\t\t{{- $varNameSingular := $dot.Table.Name | singular | camelCase -}}\n" +
+		"\t\t{{- $txt := txtsFromToMany $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $arg := printf \"maybe%s\" $txt.LocalTable.NameGo -}}\n" +
+		"\t\t{{- $schemaForeignTable := .ForeignTable | $dot.SchemaTableIn \"e\"}}\n" +
+		"// Load{{$txt.Function.Name}} allows an eager lookup of values, cached into the\n" +
+		"// loaded structs of the objects.\n" +
+		"func ({{$varNameSingular}}L) Load{{$txt.Function.Name}}(e boil.Executor, singular bool, {{$arg}} interface{}) error {\n" +
+		"\tvar slice []*{{$txt.LocalTable.NameGo}}\n" +
+		"\tvar object *{{$txt.LocalTable.NameGo}}\n" +
+		"\n" +
+		"\tcount := 1\n" +
+		"\tif singular {\n" +
+		"\t\tobject = {{$arg}}.(*{{$txt.LocalTable.NameGo}})\n" +
+		"\t} else {\n" +
+		"\t\tslice = *{{$arg}}.(*[]*{{$txt.LocalTable.NameGo}})\n" +
+		"\t\tcount = len(slice)\n" +
+		"\t}\n" +
+		"\n" +
+		"\targs := make([]interface{}, 0, count{{if gt (len $txt.Columns) 1}}*{{len $txt.Columns}}{{end}})\n" +
+		"\tif singular {\n" +
+		"\t\tif object.R == nil {\n" +
+		"\t\t\tobject.R = &{{$varNameSingular}}R{}\n" +
+		"\t\t}\n" +
+		"\t\targs = append(args{{range $txt.Columns}}, object.{{.LocalColumnGo}}{{end}})\n" +
+		"\t} else {\n" +
+		"\t\tfor _, obj := range slice {\n" +
+		"\t\t\tif obj.R == nil {\n" +
+		"\t\t\t\tobj.R = &{{$varNameSingular}}R{}\n" +
+		"\t\t\t}\n" +
+		"\t\t\targs = append(args{{range $txt.Columns}}, obj.{{.LocalColumnGo}}{{end}})\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\t\t{{if .ToJoinTable -}}\n" +
+		"\t\t\t{{- $schemaJoinTable := .JoinTable | $dot.SchemaTableIn \"e\" -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select {{id 0 | $dot.Quotes}}.*, {{id 1 | $dot.Quotes}}.{{.JoinLocalColumn | $dot.Quotes}} from {{$schemaForeignTable}} as {{id 0 | $dot.Quotes}} inner join {{$schemaJoinTable}} as {{id 1 | $dot.Quotes}} on {{id 0 | $dot.Quotes}}.{{.ForeignColumn | $dot.Quotes}} = {{id 1 | $dot.Quotes}}.{{.JoinForeignColumn | $dot.Quotes}} where {{id 1 | $dot.Quotes}}.{{.JoinLocalColumn | $dot.Quotes}} in (%s)\",\n" +
+		"\t\tstrmangle.Placeholders(dialect.IndexPlaceholders, count, 1, 1),\n" +
+		"\t)\n" +
+		"\t\t{{else if gt (len $txt.Columns) 1 -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select * from {{$schemaForeignTable}} where %s\",\n" +
+		"\t\tstrmangle.WhereClauseRepeated(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{\"{\"}}{{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}\"{{$col.ForeignColumn}}\"{{end}}{{\"}\"}}, count),\n" +
+		"\t)\n" +
+		"\t\t{{else -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select * from {{$schemaForeignTable}} where {{.ForeignColumn | $dot.Quotes}} in (%s)\",\n" +
+		"\t\tstrmangle.Placeholders(dialect.IndexPlaceholders, count, 1, 1),\n" +
+		"\t)\n" +
+		"\t\t{{end -}}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintf(boil.DebugWriter, \"%s\\n%v\\n\", query, args)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tresults, err := e.Query(query, args...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to eager load {{.ForeignTable}}\")\n" +
+		"\t}\n" +
+		"\tdefer results.Close()\n" +
+		"\n" +
+		"\tvar resultSlice []*{{$txt.ForeignTable.NameGo}}\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\t{{- $foreignTable := getTable $dot.Tables .ForeignTable -}}\n" +
+		"\t{{- $joinTable := getTable $dot.Tables .JoinTable -}}\n" +
+		"\t{{- $localCol := $joinTable.GetColumn .JoinLocalColumn}}\n" +
+		"\tvar localJoinCols []{{$localCol.Type}}\n" +
+		"\tfor results.Next() {\n" +
+		"\t\tone := new({{$txt.ForeignTable.NameGo}})\n" +
+		"\t\tvar localJoinCol {{$localCol.Type}}\n" +
+		"\n" +
+		"\t\terr = results.Scan({{$foreignTable.Columns | columnNames | stringMap $dot.StringFuncs.titleCase | prefixStringSlice \"&one.\" | join \", \"}}, &localJoinCol)\n" +
+		"\t\tif err = results.Err(); err != nil {\n" +
+		"\t\t\treturn errors.Wrap(err, \"failed to plebian-bind eager loaded slice {{.ForeignTable}}\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tresultSlice = append(resultSlice, one)\n" +
+		"\t\tlocalJoinCols = append(localJoinCols, localJoinCol)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = results.Err(); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to plebian-bind eager loaded slice {{.ForeignTable}}\")\n" +
+		"\t}\n" +
+		"\t{{else -}}\n" +
+		"\tif err = queries.Bind(results, &resultSlice); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to bind eager loaded slice {{.ForeignTable}}\")\n" +
+		"\t}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\t{{if not $dot.NoHooks -}}\n" +
+		"\tif len({{.ForeignTable | singular | camelCase}}AfterSelectHooks) != 0 {\n" +
+		"\t\tfor _, obj := range resultSlice {\n" +
+		"\t\t\tif err := obj.doAfterSelectHooks(e); err != nil {\n" +
+		"\t\t\t\treturn err\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{- end}}\n" +
+		"\tif singular {\n" +
+		"\t\tobject.R.{{$txt.Function.Name}} = resultSlice\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\tfor i, foreign := range resultSlice {\n" +
+		"\t\tlocalJoinCol := localJoinCols[i]\n" +
+		"\t\tfor _, local := range slice {\n" +
+		"\t\t\t{{if $txt.Function.UsesBytes -}}\n" +
+		"\t\t\tif 0 == bytes.Compare(local.{{$txt.Function.LocalAssignment}}, localJoinCol) {\n" +
+		"\t\t\t{{else -}}\n" +
+		"\t\t\tif local.{{$txt.Function.LocalAssignment}} == localJoinCol {\n" +
+		"\t\t\t{{end -}}\n" +
+		"\t\t\t\tlocal.R.{{$txt.Function.Name}} = append(local.R.{{$txt.Function.Name}}, foreign)\n" +
+		"\t\t\t\tbreak\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\t{{else -}}\n" +
+		"\tfor _, foreign := range resultSlice {\n" +
+		"\t\tfor _, local := range slice {\n" +
+		"\t\t\tif {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(local.{{$col.LocalAssignment}}, foreign.{{$col.ForeignAssignment}}){{else}}local.{{$col.LocalAssignment}} == foreign.{{$col.ForeignAssignment}}{{end}}{{end}} {\n" +
+		"\t\t\t\tlocal.R.{{$txt.Function.Name}} = append(local.R.{{$txt.Function.Name}}, foreign)\n" +
+		"\t\t\t\tbreak\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"{{end -}}{{/* range tomany */}}\n" +
+		"{{- end -}}{{/* if IsJoinTable */}}\n",
+	"templates/10_relationship_to_one_setops.tpl": "{{- if or .Table.IsJoinTable .Table.IsView -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- $ret := \"error\" -}}{{- $z := \"\" -}}{{- $aff := \"\" -}}\n" +
+		"\t{{- if .RowsAffected -}}{{- $ret = \"(int64, error)\" -}}{{- $z = \"0, \" -}}{{- $aff = \"rowsAff, \" -}}{{- end -}}\n" +
+		"\t{{- range .Table.FKeys -}}\n" +
+		"\t\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\t{{- $txt := txtsFromFKey $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $foreignNameSingular := .ForeignTable | singular | camelCase -}}\n" +
+		"\t\t{{- $varNameSingular := .Table | singular | camelCase}}\n" +
+		"\t\t{{- $schemaTable := .Table | $dot.SchemaTable}}\n" +
+		"// Set{{$txt.Function.Name}}G of the {{.Table | singular}} to the related item.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to related.\n" +
+		"// Adds o to related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Uses the global database handle.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\treturn o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related)\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}}P of the {{.Table | singular}} to the related item.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to related.\n" +
+		"// Adds o to related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Set{{$txt.Function.Name}}(exec, insert, related)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Set{{$txt.Function.Name}}(exec, insert, related); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}}GP of the {{.Table | singular}} to the related item.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to related.\n" +
+		"// Adds o to related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Uses the global database handle and panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}} of the {{.Table | singular}} to the related item.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to related.\n" +
+		"// Adds o to related.R.{{$txt.Function.ForeignName}}.\n" +
+		"{{- if $dot.RowsAffected}}\n" +
+		"// Returns the number of rows affected by updating the {{.Table | singular}}.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\tvar err error\n" +
+		"\tif insert {\n" +
+		"\t\tif err = related.Insert(exec); err != nil {\n" +
+		"\t\t\treturn {{$z}}errors.Wrap(err, \"failed to insert into foreign table\")\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tupdateQuery := fmt.Sprintf(\n" +
+		"\t\t\"UPDATE {{$schemaTable}} SET %s WHERE %s\",\n" +
+		"\t\tstrmangle.SetParamNames(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{\"{\"}}\"{{.Columns | join \"\\\", \\\"\"}}\"{{\"}\"}}),\n" +
+		"\t\tstrmangle.WhereClause(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}{{len .Columns}}+1{{else}}0{{end}}, {{$varNameSingular}}PrimaryKeyColumns),\n" +
+		"\t)\n" +
+		"\tvalues := []interface{}{{\"{\"}}{{range $txt.Columns}}related.{{.ForeignColumnGo}}, {{end}}o.{{$dot.Table.PKey.Columns | stringMap $dot.StringFuncs.titleCase | join \", o.\"}}{{\"}\"}}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, updateQuery)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, values)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tresult, err := exec.Exec(updateQuery, values...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"failed to update local table\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\trowsAff, err := result.RowsAffected()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(err, \"failed to get rows affected by update of local table\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif _, err = exec.Exec(updateQuery, values...); err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"failed to update local table\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\to.{{.LocalAssignment}} = related.{{.ForeignAssignment}}\n" +
+		"\t{{if .LocalNullable -}}\n" +
+		"\to.{{.LocalColumnGo}}.Valid = true\n" +
+		"\t{{end -}}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\tif o.R == nil {\n" +
+		"\t\to.R = &{{$varNameSingular}}R{\n" +
+		"\t\t\t{{$txt.Function.Name}}: related,\n" +
+		"\t\t}\n" +
+		"\t} else {\n" +
+		"\t\to.R.{{$txt.Function.Name}} = related\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .Unique -}}\n" +
+		"\tif related.R == nil {\n" +
+		"\t\trelated.R = &{{$foreignNameSingular}}R{\n" +
+		"\t\t\t{{$txt.Function.ForeignName}}: o,\n" +
+		"\t\t}\n" +
+		"\t} else {\n" +
+		"\t\trelated.R.{{$txt.Function.ForeignName}} = o\n" +
+		"\t}\n" +
+		"\t{{else -}}\n" +
+		"\tif related.R == nil {\n" +
+		"\t\trelated.R = &{{$foreignNameSingular}}R{\n" +
+		"\t\t\t{{$txt.Function.ForeignName}}: {{$txt.LocalTable.NameGo}}Slice{{\"{\"}}o{{\"}\"}},\n" +
+		"\t\t}\n" +
+		"\t} else {\n" +
+		"\t\trelated.R.{{$txt.Function.ForeignName}} = append(related.R.{{$txt.Function.ForeignName}}, o)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\treturn {{$aff}}nil\n" +
+		"}\n" +
+		"\n" +
+		"\t\t{{- if .Nullable}}\n" +
+		"// Remove{{$txt.Function.Name}}G relationship.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to nil.\n" +
+		"// Removes o from all passed in related items' relationships struct (Optional).\n" +
+		"// Uses the global database handle.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\treturn o.Remove{{$txt.Function.Name}}(boil.GetDB(), related)\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}}P relationship.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to nil.\n" +
+		"// Removes o from all passed in related items' relationships struct (Optional).\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Remove{{$txt.Function.Name}}(exec, related)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Remove{{$txt.Function.Name}}(exec, related); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}}GP relationship.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to nil.\n" +
+		"// Removes o from all passed in related items' relationships struct (Optional).\n" +
+		"// Uses the global database handle and panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}} relationship.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to nil.\n" +
+		"// Removes o from all passed in related items' relationships struct (Optional).\n" +
+		"{{- if $dot.RowsAffected}}\n" +
+		"// Returns the number of rows affected by updating the {{.Table | singular}}.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\tvar err error\n" +
+		"\t{{- if $dot.RowsAffected}}\n" +
+		"\tvar rowsAff int64\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\to.{{.LocalColumnGo}}.Valid = false\n" +
+		"\t{{end -}}\n" +
+		"\tif {{$aff}}err = o.Update(exec, \"{{.Columns | join \"\\\", \\\"\"}}\"); err != nil {\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\to.{{.LocalColumnGo}}.Valid = true\n" +
+		"\t\t{{end -}}\n" +
+		"\t\treturn {{$z}}errors.Wrap(err, \"failed to update local table\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.R.{{$txt.Function.Name}} = nil\n" +
+		"\tif related == nil || related.R == nil {\n" +
+		"\t\treturn {{$aff}}nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .Unique -}}\n" +
+		"\trelated.R.{{$txt.Function.ForeignName}} = nil\n" +
+		"\t{{else -}}\n" +
+		"\tfor i, ri := range related.R.{{$txt.Function.ForeignName}} {\n" +
+		"\t\tif {{range $i, $col := $txt.Columns}}{{if $i}} || {{end}}{{if $col.UsesBytes}}0 != bytes.Compare(o.{{$col.LocalAssignment}}, ri.{{$col.LocalAssignment}}){{else}}o.{{$col.LocalAssignment}} != ri.{{$col.LocalAssignment}}{{end}}{{end}} {\n" +
+		"\t\t\tcontinue\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tln := len(related.R.{{$txt.Function.ForeignName}})\n" +
+		"\t\tif ln > 1 && i < ln-1 {\n" +
+		"\t\t\trelated.R.{{$txt.Function.ForeignName}}[i] = related.R.{{$txt.Function.ForeignName}}[ln-1]\n" +
+		"\t\t}\n" +
+		"\t\trelated.R.{{$txt.Function.ForeignName}} = related.R.{{$txt.Function.ForeignName}}[:ln-1]\n" +
+		"\t\tbreak\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\treturn {{$aff}}nil\n" +
+		"}\n" +
+		"{{end -}}{{/* if foreignkey nullable */}}\n" +
+		"{{- end -}}{{/* if foreign view */}}\n" +
+		"{{- end -}}{{/* range */}}\n" +
+		"{{- end -}}{{/* join table */}}\n",
+	"templates/11_relationship_one_to_one_setops.tpl": "{{- if or .Table.IsJoinTable .Table.IsView -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- $ret := \"error\" -}}{{- $z := \"\" -}}{{- $aff := \"\" -}}\n" +
+		"\t{{- if .RowsAffected -}}{{- $ret = \"(int64, error)\" -}}{{- $z = \"0, \" -}}{{- $aff = \"rowsAff, \" -}}{{- end -}}\n" +
+		"\t{{- range .Table.ToOneRelationships -}}\n" +
+		"\t\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\t{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $varNameSingular := .Table | singular | camelCase -}}\n" +
+		"\t\t{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase -}}\n" +
+		"\t\t{{- $foreignPKeyCols := (getTable $dot.Tables .ForeignTable).PKey.Columns -}}\n" +
+		"\t\t{{- $foreignSchemaTable := .ForeignTable | $dot.SchemaTable}}\n" +
+		"// Set{{$txt.Function.Name}}G of the {{.Table | singular}} to the related item.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to related.\n" +
+		"// Adds o to related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Uses the global database handle.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\treturn o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related)\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}}P of the {{.Table | singular}} to the related item.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to related.\n" +
+		"// Adds o to related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Set{{$txt.Function.Name}}(exec, insert, related)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Set{{$txt.Function.Name}}(exec, insert, related); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}}GP of the {{.Table | singular}} to the related item.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to related.\n" +
+		"// Adds o to related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Uses the global database handle and panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}} of the {{.Table | singular}} to the related item.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to related.\n" +
+		"// Adds o to related.R.{{$txt.Function.ForeignName}}.\n" +
+		"{{- if $dot.RowsAffected}}\n" +
+		"// Returns the number of rows affected by inserting or updating related.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\tvar err error\n" +
+		"\t{{- if $dot.RowsAffected}}\n" +
+		"\tvar rowsAff int64\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif insert {\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\trelated.{{.ForeignAssignment}} = o.{{.LocalAssignment}}\n" +
+		"\t\t{{if .ForeignNullable -}}\n" +
+		"\t\trelated.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t{{end}}\n" +
+		"\n" +
+		"\t\tif err = related.Insert(exec); err != nil {\n" +
+		"\t\t\treturn {{$z}}errors.Wrap(err, \"failed to insert into foreign table\")\n" +
+		"\t\t}\n" +
+		"\t\t{{- if $dot.RowsAffected}}\n" +
+		"\t\trowsAff = 1\n" +
+		"\t\t{{- end}}\n" +
+		"\t} else {\n" +
+		"\t\tupdateQuery := fmt.Sprintf(\n" +
+		"\t\t\t\"UPDATE {{$foreignSchemaTable}} SET %s WHERE %s\",\n" +
+		"\t\t\tstrmangle.SetParamNames(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{\"{\"}}\"{{.ForeignColumns | join \"\\\", \\\"\"}}\"{{\"}\"}}),\n" +
+		"\t\t\tstrmangle.WhereClause(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}{{len .ForeignColumns}}+1{{else}}0{{end}}, {{$foreignVarNameSingular}}PrimaryKeyColumns),\n" +
+		"\t\t)\n" +
+		"\t\tvalues := []interface{}{{\"{\"}}{{range $txt.Columns}}o.{{.LocalColumnGo}}, {{end}}related.{{$foreignPKeyCols | stringMap $dot.StringFuncs.titleCase | join \", related.\"}}{{\"}\"}}\n" +
+		"\n" +
+		"\t\tif boil.DebugMode {\n" +
+		"\t\t\tfmt.Fprintln(boil.DebugWriter, updateQuery)\n" +
+		"\t\t\tfmt.Fprintln(boil.DebugWriter, values)\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\t{{if $dot.RowsAffected -}}\n" +
+		"\t\tresult, err := exec.Exec(updateQuery, values...)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"failed to update foreign table\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tif rowsAff, err = result.RowsAffected(); err != nil {\n" +
+		"\t\t\treturn 0, errors.Wrap(err, \"failed to get rows affected by update of foreign table\")\n" +
+		"\t\t}\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\tif _, err = exec.Exec(updateQuery, values...); err != nil {\n" +
+		"\t\t\treturn errors.Wrap(boil.ClassifyError(err), \"failed to update foreign table\")\n" +
+		"\t\t}\n" +
+		"\t\t{{- end}}\n" +
+		"\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\trelated.{{.ForeignAssignment}} = o.{{.LocalAssignment}}\n" +
+		"\t\t{{if .ForeignNullable -}}\n" +
+		"\t\trelated.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t{{end}}\n" +
+		"\t}\n" +
+		"\n" +
+		"\n" +
+		"\tif o.R == nil {\n" +
+		"\t\to.R = &{{$varNameSingular}}R{\n" +
+		"\t\t\t{{$txt.Function.Name}}: related,\n" +
+		"\t\t}\n" +
+		"\t} else {\n" +
+		"\t\to.R.{{$txt.Function.Name}} = related\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif related.R == nil {\n" +
+		"\t\trelated.R = &{{$foreignVarNameSingular}}R{\n" +
+		"\t\t\t{{$txt.Function.ForeignName}}: o,\n" +
+		"\t\t}\n" +
+		"\t} else {\n" +
+		"\t\trelated.R.{{$txt.Function.ForeignName}} = o\n" +
+		"\t}\n" +
+		"\treturn {{$aff}}nil\n" +
+		"}\n" +
+		"\n" +
+		"\t\t{{- if .ForeignColumnNullable}}\n" +
+		"// Remove{{$txt.Function.Name}}G relationship.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to nil.\n" +
+		"// Removes o from all passed in related items' relationships struct (Optional).\n" +
+		"// Uses the global database handle.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\treturn o.Remove{{$txt.Function.Name}}(boil.GetDB(), related)\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}}P relationship.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to nil.\n" +
+		"// Removes o from all passed in related items' relationships struct (Optional).\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Remove{{$txt.Function.Name}}(exec, related)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Remove{{$txt.Function.Name}}(exec, related); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}}GP relationship.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to nil.\n" +
+		"// Removes o from all passed in related items' relationships struct (Optional).\n" +
+		"// Uses the global database handle and panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}} relationship.\n" +
+		"// Sets o.R.{{$txt.Function.Name}} to nil.\n" +
+		"// Removes o from all passed in related items' relationships struct (Optional).\n" +
+		"{{- if $dot.RowsAffected}}\n" +
+		"// Returns the number of rows affected by updating related.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\tvar err error\n" +
+		"\t{{- if $dot.RowsAffected}}\n" +
+		"\tvar rowsAff int64\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\trelated.{{.ForeignColumnGo}}.Valid = false\n" +
+		"\t{{end -}}\n" +
+		"\tif {{$aff}}err = related.Update(exec, \"{{.ForeignColumns | join \"\\\", \\\"\"}}\"); err != nil {\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\trelated.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\t\t{{end -}}\n" +
+		"\t\treturn {{$z}}errors.Wrap(err, \"failed to update local table\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.R.{{$txt.Function.Name}} = nil\n" +
+		"\tif related == nil || related.R == nil {\n" +
+		"\t\treturn {{$aff}}nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\trelated.R.{{$txt.Function.ForeignName}} = nil\n" +
+		"\treturn {{$aff}}nil\n" +
+		"}\n" +
+		"{{end -}}{{/* if foreignkey nullable */}}\n" +
+		"{{- end -}}{{/* if foreign view */}}\n" +
+		"{{- end -}}{{/* range */}}\n" +
+		"{{- end -}}{{/* join table */}}\n",
+	"templates/12_relationship_to_many_setops.tpl": "{{- if or .Table.IsJoinTable .Table.IsView -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- $ret := \"error\" -}}{{- $z := \"\" -}}{{- $aff := \"\" -}}\n" +
+		"\t{{- if .RowsAffected -}}{{- $ret = \"(int64, error)\" -}}{{- $z = \"0, \" -}}{{- $aff = \"rowsAff, \" -}}{{- end -}}\n" +
+		"\t{{- $table := .Table -}}\n" +
+		"\t{{- range .Table.ToManyRelationships -}}\n" +
+		"\t\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\t{{- $txt := txtsFromToMany $dot.Tables $table . -}}\n" +
+		"\t\t{{- $varNameSingular := .Table | singular | camelCase -}}\n" +
+		"\t\t{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase}}\n" +
+		"\t\t{{- $foreignPKeyCols := (getTable $dot.Tables .ForeignTable).PKey.Columns -}}\n" +
+		"\t\t{{- $foreignSchemaTable := .ForeignTable | $dot.SchemaTable}}\n" +
+		"// Add{{$txt.Function.Name}}G adds the given related objects to the existing relationships\n" +
+		"// of the {{$table.Name | singular}}, optionally inserting them as new records.\n" +
+		"// Appends related to o.R.{{$txt.Function.Name}}.\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}} appropriately.\n" +
+		"// Uses the global database handle.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}G(insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\treturn o.Add{{$txt.Function.Name}}(boil.GetDB(), insert, related...)\n" +
+		"}\n" +
+		"\n" +
+		"// Add{{$txt.Function.Name}}P adds the given related objects to the existing relationships\n" +
+		"// of the {{$table.Name | singular}}, optionally inserting them as new records.\n" +
+		"// Appends related to o.R.{{$txt.Function.Name}}.\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}} appropriately.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Add{{$txt.Function.Name}}(exec, insert, related...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Add{{$txt.Function.Name}}(exec, insert, related...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Add{{$txt.Function.Name}}GP adds the given related objects to the existing relationships\n" +
+		"// of the {{$table.Name | singular}}, optionally inserting them as new records.\n" +
+		"// Appends related to o.R.{{$txt.Function.Name}}.\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}} appropriately.\n" +
+		"// Uses the global database handle and panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}GP(insert bool, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Add{{$txt.Function.Name}}(boil.GetDB(), insert, related...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Add{{$txt.Function.Name}}(boil.GetDB(), insert, related...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Add{{$txt.Function.Name}} adds the given related objects to the existing relationships\n" +
+		"// of the {{$table.Name | singular}}, optionally inserting them as new records.\n" +
+		"// Appends related to o.R.{{$txt.Function.Name}}.\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}} appropriately.\n" +
+		"{{- if $dot.RowsAffected}}\n" +
+		"// Returns the number of rows affected by {{if .ToJoinTable}}inserting into {{.JoinTable}}{{else}}inserting or updating related{{end}}.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\tvar err error\n" +
+		"\t{{- if $dot.RowsAffected}}\n" +
+		"\tvar rowsAff int64\n" +
+		"\t{{- end}}\n" +
+		"\tfor _, rel := range related {\n" +
+		"\t\tif insert {\n" +
+		"\t\t\t{{if not .ToJoinTable -}}\n" +
+		"\t\t\t\t{{range $txt.Columns -}}\n" +
+		"\t\t\trel.{{.ForeignAssignment}} = o.{{.LocalAssignment}}\n" +
+		"\t\t\t\t\t{{if .ForeignNullable -}}\n" +
+		"\t\t\trel.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\t\t\t\t\t{{end -}}\n" +
+		"\t\t\t\t{{end -}}\n" +
+		"\t\t\t{{end -}}\n" +
+		"\n" +
+		"\t\t\tif err = rel.Insert(exec); err != nil {\n" +
+		"\t\t\t\treturn {{$z}}errors.Wrap(err, \"failed to insert into foreign table\")\n" +
+		"\t\t\t}\n" +
+		"\t\t\t{{- if and $dot.RowsAffected (not .ToJoinTable)}}\n" +
+		"\t\t\trowsAff++\n" +
+		"\t\t\t{{- end}}\n" +
+		"\t\t}{{if not .ToJoinTable}} else {\n" +
+		"\t\t\tupdateQuery := fmt.Sprintf(\n" +
+		"\t\t\t\t\"UPDATE {{$foreignSchemaTable}} SET %s WHERE %s\",\n" +
+		"\t\t\t\tstrmangle.SetParamNames(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{\"{\"}}\"{{.ForeignColumns | join \"\\\", \\\"\"}}\"{{\"}\"}}),\n" +
+		"\t\t\t\tstrmangle.WhereClause(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}{{len .ForeignColumns}}+1{{else}}0{{end}}, {{$foreignVarNameSingular}}PrimaryKeyColumns),\n" +
+		"\t\t\t)\n" +
+		"\t\t\tvalues := []interface{}{{\"{\"}}{{range $txt.Columns}}o.{{.LocalColumnGo}}, {{end}}rel.{{$foreignPKeyCols | stringMap $dot.StringFuncs.titleCase | join \", rel.\"}}{{\"}\"}}\n" +
+		"\n" +
+		"\t\t\tif boil.DebugMode {\n" +
+		"\t\t\t\tfmt.Fprintln(boil.DebugWriter, updateQuery)\n" +
+		"\t\t\t\tfmt.Fprintln(boil.DebugWriter, values)\n" +
+		"\t\t\t}\n" +
+		"\n" +
+		"\t\t\t{{if $dot.RowsAffected -}}\n" +
+		"\t\t\tresult, err := exec.Exec(updateQuery, values...)\n" +
+		"\t\t\tif err != nil {\n" +
+		"\t\t\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"failed to update foreign table\")\n" +
+		"\t\t\t}\n" +
+		"\n" +
+		"\t\t\taffected, err := result.RowsAffected()\n" +
+		"\t\t\tif err != nil {\n" +
+		"\t\t\t\treturn 0, errors.Wrap(err, \"failed to get rows affected by update of foreign table\")\n" +
+		"\t\t\t}\n" +
+		"\t\t\trowsAff += affected\n" +
+		"\t\t\t{{- else -}}\n" +
+		"\t\t\tif _, err = exec.Exec(updateQuery, values...); err != nil {\n" +
+		"\t\t\t\treturn errors.Wrap(boil.ClassifyError(err), \"failed to update foreign table\")\n" +
+		"\t\t\t}\n" +
+		"\t\t\t{{- end}}\n" +
+		"\n" +
+		"\t\t\t{{range $txt.Columns -}}\n" +
+		"\t\t\trel.{{.ForeignAssignment}} = o.{{.LocalAssignment}}\n" +
+		"\t\t\t\t{{if .ForeignNullable -}}\n" +
+		"\t\t\trel.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\t\t\t\t{{end -}}\n" +
+		"\t\t\t{{end -}}\n" +
+		"\t\t}{{end -}}\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\tfor _, rel := range related {\n" +
+		"\t\tquery := \"insert into {{.JoinTable | $dot.SchemaTable}} ({{.JoinLocalColumn | $dot.Quotes}}, {{.JoinForeignColumn | $dot.Quotes}}) values {{if $dot.Dialect.IndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}\"\n" +
+		"\t\tvalues := []interface{}{{\"{\"}}o.{{$txt.LocalTable.ColumnNameGo}}, rel.{{$txt.ForeignTable.ColumnNameGo}}}\n" +
+		"\n" +
+		"\t\tif boil.DebugMode {\n" +
+		"\t\t\tfmt.Fprintln(boil.DebugWriter, query)\n" +
+		"\t\t\tfmt.Fprintln(boil.DebugWriter, values)\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\t{{if $dot.RowsAffected -}}\n" +
+		"\t\tresult, err := exec.Exec(query, values...)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"failed to insert into join table\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\taffected, err := result.RowsAffected()\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn 0, errors.Wrap(err, \"failed to get rows affected by insert into join table\")\n" +
+		"\t\t}\n" +
+		"\t\trowsAff += affected\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\t_, err = exec.Exec(query, values...)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn errors.Wrap(boil.ClassifyError(err), \"failed to insert into join table\")\n" +
+		"\t\t}\n" +
+		"\t\t{{- end}}\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\tif o.R == nil {\n" +
+		"\t\to.R = &{{$varNameSingular}}R{\n" +
+		"\t\t\t{{$txt.Function.Name}}: related,\n" +
+		"\t\t}\n" +
+		"\t} else {\n" +
+		"\t\to.R.{{$txt.Function.Name}} = append(o.R.{{$txt.Function.Name}}, related...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\tfor _, rel := range related {\n" +
+		"\t\tif rel.R == nil {\n" +
+		"\t\t\trel.R = &{{$foreignVarNameSingular}}R{\n" +
+		"\t\t\t\t{{$txt.Function.ForeignName}}: {{$txt.LocalTable.NameGo}}Slice{{\"{\"}}o{{\"}\"}},\n" +
+		"\t\t\t}\n" +
+		"\t\t} else {\n" +
+		"\t\t\trel.R.{{$txt.Function.ForeignName}} = append(rel.R.{{$txt.Function.ForeignName}}, o)\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\t{{else -}}\n" +
+		"\tfor _, rel := range related {\n" +
+		"\t\tif rel.R == nil {\n" +
+		"\t\t\trel.R = &{{$foreignVarNameSingular}}R{\n" +
+		"\t\t\t\t{{$txt.Function.ForeignName}}: o,\n" +
+		"\t\t\t}\n" +
+		"\t\t} else {\n" +
+		"\t\t\trel.R.{{$txt.Function.ForeignName}} = o\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\treturn {{$aff}}nil\n" +
+		"}\n" +
+		"\n" +
+		"\t\t\t{{- if (or .ForeignColumnNullable .ToJoinTable)}}\n" +
+		"// Set{{$txt.Function.Name}}G removes all previously related items of the\n" +
+		"// {{$table.Name | singular}} replacing them completely with the passed\n" +
+		"// in related items, optionally inserting them as new records.\n" +
+		"// Sets o.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.\n" +
+		"// Replaces o.R.{{$txt.Function.Name}} with related.\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.\n" +
+		"// Uses the global database handle.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\treturn o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related...)\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}}P removes all previously related items of the\n" +
+		"// {{$table.Name | singular}} replacing them completely with the passed\n" +
+		"// in related items, optionally inserting them as new records.\n" +
+		"// Sets o.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.\n" +
+		"// Replaces o.R.{{$txt.Function.Name}} with related.\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Set{{$txt.Function.Name}}(exec, insert, related...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Set{{$txt.Function.Name}}(exec, insert, related...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}}GP removes all previously related items of the\n" +
+		"// {{$table.Name | singular}} replacing them completely with the passed\n" +
+		"// in related items, optionally inserting them as new records.\n" +
+		"// Sets o.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.\n" +
+		"// Replaces o.R.{{$txt.Function.Name}} with related.\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.\n" +
+		"// Uses the global database handle and panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Set{{$txt.Function.Name}} removes all previously related items of the\n" +
+		"// {{$table.Name | singular}} replacing them completely with the passed\n" +
+		"// in related items, optionally inserting them as new records.\n" +
+		"// Sets o.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.\n" +
+		"// Replaces o.R.{{$txt.Function.Name}} with related.\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.\n" +
+		"{{- if $dot.RowsAffected}}\n" +
+		"// Returns the number of rows affected by removing the previous relationships and adding the new ones.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\tquery := \"delete from {{.JoinTable | $dot.SchemaTable}} where {{.JoinLocalColumn | $dot.Quotes}} = {{if $dot.Dialect.IndexPlaceholders}}$1{{else}}?{{end}}\"\n" +
+		"\tvalues := []interface{}{{\"{\"}}o.{{$txt.LocalTable.ColumnNameGo}}}\n" +
+		"\t{{else -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"update {{.ForeignTable | $dot.SchemaTable}} set {{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}{{$col.ForeignColumn | $dot.Quotes}} = null{{end}} where %s\",\n" +
+		"\t\tstrmangle.WhereClause(\"{{$dot.LQ}}\", \"{{$dot.RQ}}\", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{\"{\"}}\"{{.ForeignColumns | join \"\\\", \\\"\"}}\"{{\"}\"}}),\n" +
+		"\t)\n" +
+		"\tvalues := []interface{}{{\"{\"}}{{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}o.{{$col.LocalColumnGo}}{{end}}{{\"}\"}}\n" +
+		"\t{{end -}}\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, query)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, values)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tresult, err := exec.Exec(query, values...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"failed to remove relationships before set\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\trowsAff, err := result.RowsAffected()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(err, \"failed to get rows affected by removing relationships before set\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\t_, err := exec.Exec(query, values...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"failed to remove relationships before set\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\tremove{{$txt.Function.Name}}From{{$txt.Function.ForeignName}}Slice(o, related)\n" +
+		"\tif o.R != nil {\n" +
+		"\t\to.R.{{$txt.Function.Name}} = nil\n" +
+		"\t}\n" +
+		"\t{{else -}}\n" +
+		"\tif o.R != nil {\n" +
+		"\t\tfor _, rel := range o.R.{{$txt.Function.Name}} {\n" +
+		"\t\t\t{{range $txt.Columns -}}\n" +
+		"\t\t\trel.{{.ForeignColumnGo}}.Valid = false\n" +
+		"\t\t\t{{end -}}\n" +
+		"\t\t\tif rel.R == nil {\n" +
+		"\t\t\t\tcontinue\n" +
+		"\t\t\t}\n" +
+		"\n" +
+		"\t\t\trel.R.{{$txt.Function.ForeignName}} = nil\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\to.R.{{$txt.Function.Name}} = nil\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tadded, err := o.Add{{$txt.Function.Name}}(exec, insert, related...)\n" +
+		"\treturn rowsAff + added, err\n" +
+		"\t{{- else -}}\n" +
+		"\treturn o.Add{{$txt.Function.Name}}(exec, insert, related...)\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}}G relationships from objects passed in.\n" +
+		"// Removes related items from R.{{$txt.Function.Name}} (uses pointer comparison, removal does not keep order)\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Uses the global database handle.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\treturn o.Remove{{$txt.Function.Name}}(boil.GetDB(), related...)\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}}P relationships from objects passed in.\n" +
+		"// Removes related items from R.{{$txt.Function.Name}} (uses pointer comparison, removal does not keep order)\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Remove{{$txt.Function.Name}}(exec, related...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Remove{{$txt.Function.Name}}(exec, related...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}}GP relationships from objects passed in.\n" +
+		"// Removes related items from R.{{$txt.Function.Name}} (uses pointer comparison, removal does not keep order)\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}}.\n" +
+		"// Uses the global database handle and panics on error.\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\trowsAff, err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Remove{{$txt.Function.Name}} relationships from objects passed in.\n" +
+		"// Removes related items from R.{{$txt.Function.Name}} (uses pointer comparison, removal does not keep order)\n" +
+		"// Sets related.R.{{$txt.Function.ForeignName}}.\n" +
+		"{{- if $dot.RowsAffected}}\n" +
+		"// Returns the number of rows affected by {{if .ToJoinTable}}deleting from {{.JoinTable}}{{else}}updating related{{end}}.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tvar rowsAff int64\n" +
+		"\t{{- else -}}\n" +
+		"\tvar err error\n" +
+		"\t{{- end}}\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"delete from {{.JoinTable | $dot.SchemaTable}} where {{.JoinLocalColumn | $dot.Quotes}} = {{if $dot.Dialect.IndexPlaceholders}}$1{{else}}?{{end}} and {{.JoinForeignColumn | $dot.Quotes}} in (%s)\",\n" +
+		"\t\tstrmangle.Placeholders(dialect.IndexPlaceholders, len(related), 2, 1),\n" +
+		"\t)\n" +
+		"\tvalues := []interface{}{{\"{\"}}o.{{$txt.LocalTable.ColumnNameGo}}}\n" +
+		"\tfor _, rel := range related {\n" +
+		"\t\tvalues = append(values, rel.{{$txt.ForeignTable.ColumnNameGo}})\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, query)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, values)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tresult, err := exec.Exec(query, values...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"failed to remove relationships before set\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif rowsAff, err = result.RowsAffected(); err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(err, \"failed to get rows affected by removing relationships\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\t_, err = exec.Exec(query, values...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"failed to remove relationships before set\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\t{{else -}}\n" +
+		"\tfor _, rel := range related {\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\trel.{{.ForeignColumnGo}}.Valid = false\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t{{if not .ToJoinTable -}}\n" +
+		"\t\tif rel.R != nil {\n" +
+		"\t\t\trel.R.{{$txt.Function.ForeignName}} = nil\n" +
+		"\t\t}\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t{{if $dot.RowsAffected -}}\n" +
+		"\t\taffected, err := rel.Update(exec, \"{{.ForeignColumns | join \"\\\", \\\"\"}}\")\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn 0, err\n" +
+		"\t\t}\n" +
+		"\t\trowsAff += affected\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\tif err = rel.Update(exec, \"{{.ForeignColumns | join \"\\\", \\\"\"}}\"); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t\t{{- end}}\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\tremove{{$txt.Function.Name}}From{{$txt.Function.ForeignName}}Slice(o, related)\n" +
+		"\t{{end -}}\n" +
+		"\tif o.R == nil {\n" +
+		"\t\treturn {{$aff}}nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, rel := range related {\n" +
+		"\t\tfor i, ri := range o.R.{{$txt.Function.Name}} {\n" +
+		"\t\t\tif rel != ri {\n" +
+		"\t\t\t\tcontinue\n" +
+		"\t\t\t}\n" +
+		"\n" +
+		"\t\t\tln := len(o.R.{{$txt.Function.Name}})\n" +
+		"\t\t\tif ln > 1 && i < ln-1 {\n" +
+		"\t\t\t\to.R.{{$txt.Function.Name}}[i] = o.R.{{$txt.Function.Name}}[ln-1]\n" +
+		"\t\t\t}\n" +
+		"\t\t\to.R.{{$txt.Function.Name}} = o.R.{{$txt.Function.Name}}[:ln-1]\n" +
+		"\t\t\tbreak\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn {{$aff}}nil\n" +
+		"}\n" +
+		"\n" +
+		"\t\t\t\t{{if .ToJoinTable -}}\n" +
+		"func remove{{$txt.Function.Name}}From{{$txt.Function.ForeignName}}Slice(o *{{$txt.LocalTable.NameGo}}, related []*{{$txt.ForeignTable.NameGo}}) {\n" +
+		"\tfor _, rel := range related {\n" +
+		"\t\tif rel.R == nil {\n" +
+		"\t\t\tcontinue\n" +
+		"\t\t}\n" +
+		"\t\tfor i, ri := range rel.R.{{$txt.Function.ForeignName}} {\n" +
+		"\t\t\t{{if $txt.Function.UsesBytes -}}\n" +
+		"\t\t\tif 0 != bytes.Compare(o.{{$txt.Function.LocalAssignment}}, ri.{{$txt.Function.LocalAssignment}}) {\n" +
+		"\t\t\t{{else -}}\n" +
+		"\t\t\tif o.{{$txt.Function.LocalAssignment}} != ri.{{$txt.Function.LocalAssignment}} {\n" +
+		"\t\t\t{{end -}}\n" +
+		"\t\t\t\tcontinue\n" +
+		"\t\t\t}\n" +
+		"\n" +
+		"\t\t\tln := len(rel.R.{{$txt.Function.ForeignName}})\n" +
+		"\t\t\tif ln > 1 && i < ln-1 {\n" +
+		"\t\t\t\trel.R.{{$txt.Function.ForeignName}}[i] = rel.R.{{$txt.Function.ForeignName}}[ln-1]\n" +
+		"\t\t\t}\n" +
+		"\t\t\trel.R.{{$txt.Function.ForeignName}} = rel.R.{{$txt.Function.ForeignName}}[:ln-1]\n" +
+		"\t\t\tbreak\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"}\n" +
+		"\t\t\t\t{{end -}}{{- /* if ToJoinTable */ -}}\n" +
+		"\t\t\t{{- end -}}{{- /* if nullable foreign key */ -}}\n" +
+		"\t\t{{- end -}}{{- /* if foreign view */ -}}\n" +
+		"\t{{- end -}}{{- /* range relationships */ -}}\n" +
+		"{{- end -}}{{- /* if IsJoinTable */ -}}\n",
+	"templates/13_all.tpl": "{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase}}\n" +
+		"// {{$tableNamePlural}}G retrieves all records.\n" +
+		"func {{$tableNamePlural}}G(mods ...qm.QueryMod) {{$varNameSingular}}Query {\n" +
+		"\treturn {{$tableNamePlural}}(boil.GetDB(), mods...)\n" +
+		"}\n" +
+		"\n" +
+		"// {{$tableNamePlural}} retrieves all the records using an executor.\n" +
+		"func {{$tableNamePlural}}(exec boil.Executor, mods ...qm.QueryMod) {{$varNameSingular}}Query {\n" +
+		"\tmods = append(mods, qm.From(\"{{.Table.Name | .SchemaTable}}\"))\n" +
+		"\treturn {{$varNameSingular}}Query{NewQuery(exec, mods...)}\n" +
+		"}\n",
+	"templates/14_find.tpl": "{{- $dot := . -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- if .Table.PKey -}}\n" +
+		"{{- $colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}\n" +
+		"{{- $pkNames := $colDefs.Names | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}\n" +
+		"{{- $pkArgs := joinSlices \" \" $pkNames $colDefs.Types | join \", \"}}\n" +
+		"// Find{{$tableNameSingular}}G retrieves a single record by ID.\n" +
+		"func Find{{$tableNameSingular}}G({{$pkArgs}}, selectCols ...string) (*{{$tableNameSingular}}, error) {\n" +
+		"\treturn Find{{$tableNameSingular}}(boil.GetDB(), {{$pkNames | join \", \"}}, selectCols...)\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}GP retrieves a single record by ID, and panics on error.\n" +
+		"func Find{{$tableNameSingular}}GP({{$pkArgs}}, selectCols ...string) *{{$tableNameSingular}} {\n" +
+		"\tretobj, err := Find{{$tableNameSingular}}(boil.GetDB(), {{$pkNames | join \", \"}}, selectCols...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn retobj\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}} retrieves a single record by ID with an executor.\n" +
+		"// If selectCols is empty Find will return all columns.\n" +
+		"func Find{{$tableNameSingular}}(exec boil.Executor, {{$pkArgs}}, selectCols ...string) (*{{$tableNameSingular}}, error) {\n" +
+		"\t{{$varNameSingular}}Obj := &{{$tableNameSingular}}{}\n" +
+		"\n" +
+		"\tsel := \"*\"\n" +
+		"\tif len(selectCols) > 0 {\n" +
+		"\t\tsel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), \",\")\n" +
+		"\t}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select %s from {{.Table.Name | .SchemaTable}} where {{if .Dialect.IndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}\", sel,\n" +
+		"\t)\n" +
+		"\n" +
+		"\tq := queries.Raw(exec, query, {{$pkNames | join \", \"}})\n" +
+		"\n" +
+		"\terr := q.Bind({{$varNameSingular}}Obj)\n" +
+		"\tif err != nil {\n" +
+		"\t\tif errors.Cause(err) == sql.ErrNoRows {\n" +
+		"\t\t\treturn nil, sql.ErrNoRows\n" +
+		"\t\t}\n" +
+		"\t\treturn nil, errors.Wrap(err, \"{{.PkgName}}: unable to select from {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn {{$varNameSingular}}Obj, nil\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}P retrieves a single record by ID with an executor, and panics on error.\n" +
+		"func Find{{$tableNameSingular}}P(exec boil.Executor, {{$pkArgs}}, selectCols ...string) *{{$tableNameSingular}} {\n" +
+		"\tretobj, err := Find{{$tableNameSingular}}(exec, {{$pkNames | join \", \"}}, selectCols...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn retobj\n" +
+		"}\n" +
+		"{{- end -}}\n" +
+		"{{- range $ukey := .Table.UKeys -}}\n" +
+		"{{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join \"And\" -}}\n" +
+		"{{- $colDefs := sqlColDefinitions $dot.Table.Columns $ukey.Columns -}}\n" +
+		"{{- $keyNames := $colDefs.Names | stringMap $dot.StringFuncs.camelCase | stringMap $dot.StringFuncs.replaceReserved -}}\n" +
+		"{{- $keyArgs := joinSlices \" \" $keyNames $colDefs.Types | join \", \" -}}\n" +
+		"{{- $keyType := printf \"%s%sKey\" $tableNameSingular $keyName}}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}By{{$keyName}}G retrieves a single record by its unique {{$ukey.Columns | join \", \"}}.\n" +
+		"func Find{{$tableNameSingular}}By{{$keyName}}G({{$keyArgs}}, selectCols ...string) (*{{$tableNameSingular}}, error) {\n" +
+		"\treturn Find{{$tableNameSingular}}By{{$keyName}}(boil.GetDB(), {{$keyNames | join \", \"}}, selectCols...)\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}By{{$keyName}}GP retrieves a single record by its unique {{$ukey.Columns | join \", \"}}, and panics on error.\n" +
+		"func Find{{$tableNameSingular}}By{{$keyName}}GP({{$keyArgs}}, selectCols ...string) *{{$tableNameSingular}} {\n" +
+		"\tretobj, err := Find{{$tableNameSingular}}By{{$keyName}}(boil.GetDB(), {{$keyNames | join \", \"}}, selectCols...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn retobj\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}By{{$keyName}} retrieves a single record by its unique {{$ukey.Columns | join \", \"}}\n" +
+		"// with an executor. If selectCols is empty all columns are returned.\n" +
+		"func Find{{$tableNameSingular}}By{{$keyName}}(exec boil.Executor, {{$keyArgs}}, selectCols ...string) (*{{$tableNameSingular}}, error) {\n" +
+		"\t{{$varNameSingular}}Obj := &{{$tableNameSingular}}{}\n" +
+		"\n" +
+		"\tsel := \"*\"\n" +
+		"\tif len(selectCols) > 0 {\n" +
+		"\t\tsel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), \",\")\n" +
+		"\t}\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select %s from {{$dot.Table.Name | $dot.SchemaTable}} where {{if $dot.Dialect.IndexPlaceholders}}{{whereClause $dot.LQ $dot.RQ 1 $ukey.Columns}}{{else}}{{whereClause $dot.LQ $dot.RQ 0 $ukey.Columns}}{{end}}\", sel,\n" +
+		"\t)\n" +
+		"\n" +
+		"\tq := queries.Raw(exec, query, {{$keyNames | join \", \"}})\n" +
+		"\n" +
+		"\terr := q.Bind({{$varNameSingular}}Obj)\n" +
+		"\tif err != nil {\n" +
+		"\t\tif errors.Cause(err) == sql.ErrNoRows {\n" +
+		"\t\t\treturn nil, sql.ErrNoRows\n" +
+		"\t\t}\n" +
+		"\t\treturn nil, errors.Wrap(err, \"{{$dot.PkgName}}: unable to select from {{$dot.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn {{$varNameSingular}}Obj, nil\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}By{{$keyName}}P retrieves a single record by its unique {{$ukey.Columns | join \", \"}}\n" +
+		"// with an executor, and panics on error.\n" +
+		"func Find{{$tableNameSingular}}By{{$keyName}}P(exec boil.Executor, {{$keyArgs}}, selectCols ...string) *{{$tableNameSingular}} {\n" +
+		"\tretobj, err := Find{{$tableNameSingular}}By{{$keyName}}(exec, {{$keyNames | join \", \"}}, selectCols...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn retobj\n" +
+		"}\n" +
+		"{{- if gt (len $ukey.Columns) 1}}\n" +
+		"\n" +
+		"// {{$keyType}} is a value of the unique {{$ukey.Columns | join \", \"}} of {{$dot.Table.Name}},\n" +
+		"// used to find several records at once.\n" +
+		"type {{$keyType}} struct {\n" +
+		"\t{{range $col := $colDefs -}}\n" +
+		"\t{{titleCase $col.Name}} {{$col.Type}}\n" +
+		"\t{{end -}}\n" +
+		"}\n" +
+		"{{- else}}\n" +
+		"{{- $keyType = (index $colDefs 0).Type}}\n" +
+		"{{- end}}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}SliceBy{{$keyName}}G retrieves the records matching any of the keys.\n" +
+		"func Find{{$tableNameSingular}}SliceBy{{$keyName}}G(keys ...{{$keyType}}) ({{$tableNameSingular}}Slice, error) {\n" +
+		"\treturn Find{{$tableNameSingular}}SliceBy{{$keyName}}(boil.GetDB(), keys...)\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}SliceBy{{$keyName}}GP retrieves the records matching any of the keys, and panics on error.\n" +
+		"func Find{{$tableNameSingular}}SliceBy{{$keyName}}GP(keys ...{{$keyType}}) {{$tableNameSingular}}Slice {\n" +
+		"\to, err := Find{{$tableNameSingular}}SliceBy{{$keyName}}(boil.GetDB(), keys...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}SliceBy{{$keyName}} retrieves the records matching any of the keys with\n" +
+		"// an executor in a single query, keys without a record are skipped. The order of\n" +
+		"// the records is up to the database.\n" +
+		"func Find{{$tableNameSingular}}SliceBy{{$keyName}}(exec boil.Executor, keys ...{{$keyType}}) ({{$tableNameSingular}}Slice, error) {\n" +
+		"\tif len(keys) == 0 {\n" +
+		"\t\treturn {{$tableNameSingular}}Slice{}, nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\targs := make([]interface{}, 0, len(keys)*{{len $ukey.Columns}})\n" +
+		"\tfor _, key := range keys {\n" +
+		"\t\t{{if gt (len $ukey.Columns) 1 -}}\n" +
+		"\t\targs = append(args, {{$ukey.Columns | stringMap $dot.StringFuncs.titleCase | prefixStringSlice \"key.\" | join \", \"}})\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\targs = append(args, key)\n" +
+		"\t\t{{- end}}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tquery := fmt.Sprintf(\n" +
+		"\t\t\"select * from {{$dot.Table.Name | $dot.SchemaTable}} where %s\",\n" +
+		"\t\tstrmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{ {{- $ukey.Columns | stringMap $dot.StringFuncs.quoteWrap | join \", \" -}} }, len(keys)),\n" +
+		"\t)\n" +
+		"\n" +
+		"\tvar o []*{{$tableNameSingular}}\n" +
+		"\tif err := queries.Raw(exec, query, args...).Bind(&o); err != nil {\n" +
+		"\t\treturn nil, errors.Wrap(err, \"{{$dot.PkgName}}: unable to select from {{$dot.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o, nil\n" +
+		"}\n" +
+		"\n" +
+		"// Find{{$tableNameSingular}}SliceBy{{$keyName}}P retrieves the records matching any of the keys with\n" +
+		"// an executor, and panics on error.\n" +
+		"func Find{{$tableNameSingular}}SliceBy{{$keyName}}P(exec boil.Executor, keys ...{{$keyType}}) {{$tableNameSingular}}Slice {\n" +
+		"\to, err := Find{{$tableNameSingular}}SliceBy{{$keyName}}(exec, keys...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o\n" +
+		"}\n" +
+		"{{- end -}}\n",
+	"templates/15_insert.tpl": "{{- if not .Table.IsView -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- $schemaTable := .Table.Name | .SchemaTable}}\n" +
+		"// InsertG a single record. See Insert for whitelist behavior description.\n" +
+		"func (o *{{$tableNameSingular}}) InsertG(whitelist ... string) error {\n" +
+		"\treturn o.Insert(boil.GetDB(), whitelist...)\n" +
+		"}\n" +
+		"\n" +
+		"// InsertGP a single record, and panics on error. See Insert for whitelist\n" +
+		"// behavior description.\n" +
+		"func (o *{{$tableNameSingular}}) InsertGP(whitelist ... string) {\n" +
+		"\tif err := o.Insert(boil.GetDB(), whitelist...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// InsertP a single record using an executor, and panics on error. See Insert\n" +
+		"// for whitelist behavior description.\n" +
+		"func (o *{{$tableNameSingular}}) InsertP(exec boil.Executor, whitelist ... string) {\n" +
+		"\tif err := o.Insert(exec, whitelist...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// Insert a single record using an executor.\n" +
+		"// Whitelist behavior: If a whitelist is provided, only those columns supplied are inserted\n" +
+		"// No whitelist behavior: Without a whitelist, columns are inferred by the following rules:\n" +
+		"// - All columns without a default value are included (i.e. name, age)\n" +
+		"// - All columns with a default, but non-zero are included (i.e. health = 75)\n" +
+		"func (o *{{$tableNameSingular}}) Insert(exec boil.Executor, whitelist ... string) error {\n" +
+		"\tif o == nil {\n" +
+		"\t\treturn errors.New(\"{{.PkgName}}: no {{.Table.Name}} provided for insertion\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\t{{- template \"timestamp_insert_helper\" . }}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err := o.doBeforeInsertHooks(exec); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tnzDefaults := queries.NonZeroDefaultSet({{$varNameSingular}}ColumnsWithDefault, o)\n" +
+		"\n" +
+		"\tkey := makeCacheKey(whitelist, nzDefaults)\n" +
+		"\t{{- if .RuntimeSchema}}\n" +
+		"\tkey = boil.SchemaOf(exec, \"{{.Table.SchemaName}}\") + \".\" + key\n" +
+		"\t{{- end}}\n" +
+		"\t{{$varNameSingular}}InsertCacheMut.RLock()\n" +
+		"\tcache, cached := {{$varNameSingular}}InsertCache[key]\n" +
+		"\t{{$varNameSingular}}InsertCacheMut.RUnlock()\n" +
+		"\n" +
+		"\tif !cached {\n" +
+		"\t\twl, returnColumns := strmangle.InsertColumnSet(\n" +
+		"\t\t\t{{$varNameSingular}}Columns,\n" +
+		"\t\t\t{{$varNameSingular}}ColumnsWithDefault,\n" +
+		"\t\t\t{{$varNameSingular}}ColumnsWithoutDefault,\n" +
+		"\t\t\tnzDefaults,\n" +
+		"\t\t\twhitelist,\n" +
+		"\t\t)\n" +
+		"\n" +
+		"\t\tcache.valueMapping, err = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, wl)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t\t{{- if not .NoValidate}}\n" +
+		"\t\tcache.columns = wl\n" +
+		"\t\t{{- end}}\n" +
+		"\t\tcache.retMapping, err = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, returnColumns)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t\tif len(wl) != 0 {\n" +
+		"\t\t\tcache.query = fmt.Sprintf(\"INSERT INTO {{$schemaTable}} ({{.LQ}}%s{{.RQ}}) %%sVALUES (%s)%%s\", strings.Join(wl, \"{{.RQ}},{{.LQ}}\"), strmangle.Placeholders(dialect.IndexPlaceholders, len(wl), 1, 1))\n" +
+		"\t\t} else {\n" +
+		"\t\t\t{{if eq .DriverName \"mysql\" -}}\n" +
+		"\t\t\tcache.query = \"INSERT INTO {{$schemaTable}} () VALUES ()\"\n" +
+		"\t\t\t{{else -}}\n" +
+		"\t\t\tcache.query = \"INSERT INTO {{$schemaTable}} DEFAULT VALUES\"\n" +
+		"\t\t\t{{end -}}\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tvar queryOutput, queryReturning string\n" +
+		"\n" +
+		"\t\tif len(cache.retMapping) != 0 {\n" +
+		"\t\t\t{{if .UseLastInsertID -}}\n" +
+		"\t\t\tcache.retQuery = fmt.Sprintf(\"SELECT {{.LQ}}%s{{.RQ}} FROM {{$schemaTable}} WHERE %s\", strings.Join(returnColumns, \"{{.RQ}},{{.LQ}}\"), strmangle.WhereClause(\"{{.LQ}}\", \"{{.RQ}}\", {{if .Dialect.IndexPlaceholders}}1{{else}}0{{end}}, {{$varNameSingular}}PrimaryKeyColumns))\n" +
+		"\t\t\t{{else -}}\n" +
+		"\t\t\t\t{{if ne .DriverName \"mssql\" -}}\n" +
+		"\t\t\tqueryReturning = fmt.Sprintf(\" RETURNING {{.LQ}}%s{{.RQ}}\", strings.Join(returnColumns, \"{{.RQ}},{{.LQ}}\"))\n" +
+		"\t\t\t\t{{else -}}\n" +
+		"\t\t\tqueryOutput = fmt.Sprintf(\"OUTPUT INSERTED.{{.LQ}}%s{{.RQ}} \", strings.Join(returnColumns, \"{{.RQ}},INSERTED.{{.LQ}}\"))\n" +
+		"\t\t\t\t{{end -}}\n" +
+		"\t\t\t{{end -}}\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tif len(wl) != 0 {\n" +
+		"\t\t\tcache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tvalue := reflect.Indirect(reflect.ValueOf(o))\n" +
+		"\tvals := queries.ValuesFromMapping(value, cache.valueMapping)\n" +
+		"\t{{- if not .NoValidate}}\n" +
+		"\n" +
+		"\tif err := boil.ValidateColumns(\"{{.Table.Name}}\", cache.columns, vals, {{$varNameSingular}}ColumnLimits); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, cache.query)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, vals)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .UseLastInsertID -}}\n" +
+		"\t{{- $canLastInsertID := .Table.CanLastInsertID -}}\n" +
+		"\t{{if $canLastInsertID -}}\n" +
+		"\tresult, err := exec.Exec(cache.query, vals...)\n" +
+		"\t{{else -}}\n" +
+		"\t_, err = exec.Exec(cache.query, vals...)\n" +
+		"\t{{- end}}\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to insert into {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t\n" +
+		"\t{{if $canLastInsertID -}}\n" +
+		"\tvar lastID int64\n" +
+		"\t{{- end}}\n" +
+		"\tvar identifierCols []interface{}\n" +
+		"\n" +
+		"\tif len(cache.retMapping) == 0 {\n" +
+		"\t\tgoto CacheNoHooks\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $canLastInsertID -}}\n" +
+		"\tlastID, err = result.LastInsertId()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn ErrSyncFail\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{$colName := index .Table.PKey.Columns 0 -}}\n" +
+		"\t{{- $col := .Table.GetColumn $colName -}}\n" +
+		"\t{{- $colTitled := $colName | titleCase}}\n" +
+		"\to.{{$colTitled}} = {{$col.Type}}(lastID)\n" +
+		"\tif lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == {{$varNameSingular}}Mapping[\"{{$colTitled}}\"] {\n" +
+		"\t\tgoto CacheNoHooks\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tidentifierCols = []interface{}{\n" +
+		"\t\t{{range .Table.PKey.Columns -}}\n" +
+		"\t\to.{{. | titleCase}},\n" +
+		"\t\t{{end -}}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, cache.retQuery)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, identifierCols...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\terr = exec.QueryRow(cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"{{.PkgName}}: unable to populate default values for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{else}}\n" +
+		"\tif len(cache.retMapping) != 0 {\n" +
+		"\t\terr = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)\n" +
+		"\t} else {\n" +
+		"\t\t_, err = exec.Exec(cache.query, vals...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to insert into {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"{{if .UseLastInsertID -}}\n" +
+		"CacheNoHooks:\n" +
+		"{{- end}}\n" +
+		"\tif !cached {\n" +
+		"\t\t{{$varNameSingular}}InsertCacheMut.Lock()\n" +
+		"\t\t{{$varNameSingular}}InsertCache[key] = cache\n" +
+		"\t\t{{$varNameSingular}}InsertCacheMut.Unlock()\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.Snapshot()\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\treturn o.doAfterInsertHooks(exec)\n" +
+		"\t{{- else -}}\n" +
+		"\treturn nil\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"{{- end -}}\n",
+	"templates/16_update.tpl": "{{- if not .Table.IsView -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- $schemaTable := .Table.Name | .SchemaTable -}}\n" +
+		"{{- $ret := \"error\" -}}{{- $z := \"\" -}}\n" +
+		"{{- if .RowsAffected -}}{{- $ret = \"(int64, error)\" -}}{{- $z = \"0, \" -}}{{- end -}}\n" +
+		"{{- $autoUpdatedAt := and (not .NoAutoTimestamps) (containsAny (.Table.Columns | columnNames) \"updated_at\")}}\n" +
+		"// UpdateG a single {{$tableNameSingular}} record. See Update for\n" +
+		"// whitelist behavior description.\n" +
+		"func (o *{{$tableNameSingular}}) UpdateG(whitelist ...string) {{$ret}} {\n" +
+		"\treturn o.Update(boil.GetDB(), whitelist...)\n" +
+		"}\n" +
+		"\n" +
+		"// UpdateGP a single {{$tableNameSingular}} record.\n" +
+		"// UpdateGP takes a whitelist of column names that should be updated.\n" +
+		"// Panics on error. See Update for whitelist behavior description.\n" +
+		"func (o *{{$tableNameSingular}}) UpdateGP(whitelist ...string){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := o.Update(boil.GetDB(), whitelist...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Update(boil.GetDB(), whitelist...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// UpdateP uses an executor to update the {{$tableNameSingular}}, and panics on error.\n" +
+		"// See Update for whitelist behavior description.\n" +
+		"func (o *{{$tableNameSingular}}) UpdateP(exec boil.Executor, whitelist ... string){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := o.Update(exec, whitelist...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\terr := o.Update(exec, whitelist...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Update uses an executor to update the {{$tableNameSingular}}.\n" +
+		"// Whitelist behavior: If a whitelist is provided, only the columns given are updated.\n" +
+		"// No whitelist behavior: Without a whitelist, columns are inferred by the following rules:\n" +
+		"// - If the {{$tableNameSingular}} was loaded from the database, only the columns that\n" +
+		"//   Changed reports once the BeforeUpdate hooks ran are updated, and nothing is\n" +
+		"//   written if there are none{{if $autoUpdatedAt}} besides updated_at{{end}}\n" +
+		"// - Otherwise all columns are inferred to start with\n" +
+		"// - All primary keys are subtracted from this set\n" +
+		"// Update does not automatically update the record in case of default values. Use .Reload()\n" +
+		"// to refresh the records.\n" +
+		"{{- if .RowsAffected}}\n" +
+		"// Update returns the number of rows affected, which is 0 if the row was not found or nothing\n" +
+		"// needed to be updated.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$tableNameSingular}}) Update(exec boil.Executor, whitelist ... string) {{$ret}} {\n" +
+		"\tdirty := len(whitelist) == 0 && o.snapshot != nil\n" +
+		"\t{{- if $autoUpdatedAt}}\n" +
+		"\tupdatedAt := o.UpdatedAt\n" +
+		"\t{{- end}}\n" +
+		"\t{{- template \"timestamp_update_helper\" . }}\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err = o.doBeforeUpdateHooks(exec); err != nil {\n" +
+		"\t\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{end -}}\n" +
+		"\tif dirty {\n" +
+		"\t\twhitelist = strmangle.SetComplement(o.Changed(), {{$varNameSingular}}PrimaryKeyColumns)\n" +
+		"\t\t{{- if $autoUpdatedAt}}\n" +
+		"\t\t// Bumping updated_at alone is not worth a write\n" +
+		"\t\tif len(strmangle.SetComplement(whitelist, []string{\"updated_at\"})) == 0 {\n" +
+		"\t\t\to.UpdatedAt = updatedAt\n" +
+		"\t\t\treturn {{$z}}nil\n" +
+		"\t\t}\n" +
+		"\t\t{{- else}}\n" +
+		"\t\tif len(whitelist) == 0 {\n" +
+		"\t\t\treturn {{$z}}nil\n" +
+		"\t\t}\n" +
+		"\t\t{{- end}}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tkey := makeCacheKey(whitelist, nil)\n" +
+		"\t{{- if .RuntimeSchema}}\n" +
+		"\tkey = boil.SchemaOf(exec, \"{{.Table.SchemaName}}\") + \".\" + key\n" +
+		"\t{{- end}}\n" +
+		"\t{{$varNameSingular}}UpdateCacheMut.RLock()\n" +
+		"\tcache, cached := {{$varNameSingular}}UpdateCache[key]\n" +
+		"\t{{$varNameSingular}}UpdateCacheMut.RUnlock()\n" +
+		"\n" +
+		"\tif !cached {\n" +
+		"\t\twl := strmangle.UpdateColumnSet(\n" +
+		"\t\t\t{{$varNameSingular}}Columns,\n" +
+		"\t\t\t{{$varNameSingular}}PrimaryKeyColumns,\n" +
+		"\t\t\twhitelist,\n" +
+		"\t\t)\n" +
+		"\t\t{{if eq .DriverName \"mssql\"}}\n" +
+		"\t\twl = strmangle.SetComplement(wl, {{$varNameSingular}}ColumnsWithAuto)\n" +
+		"\t\t{{end}}\n" +
+		"\t\t{{if not .NoAutoTimestamps}}\n" +
+		"\t\tif len(whitelist) == 0 {\n" +
+		"\t\t\twl = strmangle.SetComplement(wl, []string{\"created_at\"})\n" +
+		"\t\t}\n" +
+		"\t\t{{end -}}\n" +
+		"\t\tif len(wl) == 0 {\n" +
+		"\t\t\treturn {{$z}}errors.New(\"{{.PkgName}}: unable to update {{.Table.Name}}, could not build whitelist\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tcache.query = fmt.Sprintf(\"UPDATE {{$schemaTable}} SET %s WHERE %s\",\n" +
+		"\t\t\tstrmangle.SetParamNames(\"{{.LQ}}\", \"{{.RQ}}\", {{if .Dialect.IndexPlaceholders}}1{{else}}0{{end}}, wl),\n" +
+		"\t\t\tstrmangle.WhereClause(\"{{.LQ}}\", \"{{.RQ}}\", {{if .Dialect.IndexPlaceholders}}len(wl)+1{{else}}0{{end}}, {{$varNameSingular}}PrimaryKeyColumns),\n" +
+		"\t\t)\n" +
+		"\t\tcache.valueMapping, err = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, append(wl, {{$varNameSingular}}PrimaryKeyColumns...))\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn {{$z}}err\n" +
+		"\t\t}\n" +
+		"\t\tcache.columns = append(wl, {{$varNameSingular}}PrimaryKeyColumns...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tvalues := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)\n" +
+		"\t{{- if not .NoValidate}}\n" +
+		"\n" +
+		"\tif err := boil.ValidateColumns(\"{{.Table.Name}}\", cache.columns, values, {{$varNameSingular}}ColumnLimits); err != nil {\n" +
+		"\t\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, cache.query)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, values)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tresult, err := exec.Exec(cache.query, values...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to update {{.Table.Name}} row\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\trowsAff, err := result.RowsAffected()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(err, \"{{.PkgName}}: failed to get rows affected by update for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\t_, err = exec.Exec(cache.query, values...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to update {{.Table.Name}} row\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif !cached {\n" +
+		"\t\t{{$varNameSingular}}UpdateCacheMut.Lock()\n" +
+		"\t\t{{$varNameSingular}}UpdateCache[key] = cache\n" +
+		"\t\t{{$varNameSingular}}UpdateCacheMut.Unlock()\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.refreshSnapshot(cache.columns)\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\treturn {{if .RowsAffected}}rowsAff, {{end}}o.doAfterUpdateHooks(exec)\n" +
+		"\t{{- else -}}\n" +
+		"\treturn {{if .RowsAffected}}rowsAff, {{end}}nil\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// UpdateAllP updates all rows with matching column names, and panics on error.\n" +
+		"func (q {{$varNameSingular}}Query) UpdateAllP(cols M){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := q.UpdateAll(cols)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := q.UpdateAll(cols); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// UpdateAll updates all rows with the specified column values.\n" +
+		"func (q {{$varNameSingular}}Query) UpdateAll(cols M) {{$ret}} {\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\texec := queries.GetExecutor(q.Query)\n" +
+		"\tif err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}BeforeUpdateAllHooks, exec, q.Query, nil, cols); err != nil {\n" +
+		"\t\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{end -}}\n" +
+		"\tqueries.SetUpdate(q.Query, cols)\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tresult, err := q.Query.Exec()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to update all for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\trowsAff, err := result.RowsAffected()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(err, \"{{.PkgName}}: unable to retrieve rows affected for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\t_, err := q.Query.Exec()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to update all for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err = do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}AfterUpdateAllHooks, exec, q.Query, nil, cols); err != nil {\n" +
+		"\t\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{end -}}\n" +
+		"\treturn {{if .RowsAffected}}rowsAff, {{end}}nil\n" +
+		"}\n" +
+		"\n" +
+		"// UpdateAllG updates all rows with the specified column values.\n" +
+		"func (o {{$tableNameSingular}}Slice) UpdateAllG(cols M) {{$ret}} {\n" +
+		"\treturn o.UpdateAll(boil.GetDB(), cols)\n" +
+		"}\n" +
+		"\n" +
+		"// UpdateAllGP updates all rows with the specified column values, and panics on error.\n" +
+		"func (o {{$tableNameSingular}}Slice) UpdateAllGP(cols M){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := o.UpdateAll(boil.GetDB(), cols)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.UpdateAll(boil.GetDB(), cols); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// UpdateAllP updates all rows with the specified column values, and panics on error.\n" +
+		"func (o {{$tableNameSingular}}Slice) UpdateAllP(exec boil.Executor, cols M){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := o.UpdateAll(exec, cols)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.UpdateAll(exec, cols); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// UpdateAll updates all rows with the specified column values, using an executor.\n" +
+		"func (o {{$tableNameSingular}}Slice) UpdateAll(exec boil.Executor, cols M) {{$ret}} {\n" +
+		"\tln := int64(len(o))\n" +
+		"\tif ln == 0 {\n" +
+		"\t\treturn {{$z}}nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif len(cols) == 0 {\n" +
+		"\t\treturn {{$z}}errors.New(\"{{.PkgName}}: update all requires at least one column argument\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}BeforeUpdateAllHooks, exec, nil, o, cols); err != nil {\n" +
+		"\t\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\tcolNames := make([]string, len(cols))\n" +
+		"\targs := make([]interface{}, len(cols))\n" +
+		"\n" +
+		"\ti := 0\n" +
+		"\tfor name, value := range cols {\n" +
+		"\t\tcolNames[i] = name\n" +
+		"\t\targs[i] = value\n" +
+		"\t\ti++\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Append all of the primary key values for each column\n" +
+		"\tfor _, obj := range o {\n" +
+		"\t\tpkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$varNameSingular}}PrimaryKeyMapping)\n" +
+		"\t\targs = append(args, pkeyArgs...)\n" +
+		"\t}\n" +
+		"\t\n" +
+		"\tsql := fmt.Sprintf(\"UPDATE {{$schemaTable}} SET %s WHERE %s\",\n" +
+		"\t\tstrmangle.SetParamNames(\"{{.LQ}}\", \"{{.RQ}}\", {{if .Dialect.IndexPlaceholders}}1{{else}}0{{end}}, colNames),\n" +
+		"\t\tstrmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.IndexPlaceholders}}len(colNames)+1{{else}}0{{end}}, {{$varNameSingular}}PrimaryKeyColumns, len(o)))\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, sql)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, args...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tresult, err := exec.Exec(sql, args...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to update all in {{$varNameSingular}} slice\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\trowsAff, err := result.RowsAffected()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(err, \"{{.PkgName}}: unable to retrieve rows affected all in update all {{$varNameSingular}}\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\t_, err := exec.Exec(sql, args...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to update all in {{$varNameSingular}} slice\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err = do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}AfterUpdateAllHooks, exec, nil, o, cols); err != nil {\n" +
+		"\t\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{end -}}\n" +
+		"\treturn {{if .RowsAffected}}rowsAff, {{end}}nil\n" +
+		"}\n" +
+		"{{- end -}}\n",
+	"templates/17_upsert.tpl": "{{- if not .Table.IsView -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- $schemaTable := .Table.Name | .SchemaTable}}\n" +
+		"// UpsertG attempts an insert, and does an update or ignore on conflict.\n" +
+		"func (o *{{$tableNameSingular}}) UpsertG({{if eq .DriverName \"postgres\"}}updateOnConflict bool, conflictColumns []string, {{end}}updateColumns []string,\twhitelist ...string) error {\n" +
+		"\treturn o.Upsert(boil.GetDB(), {{if eq .DriverName \"postgres\"}}updateOnConflict, conflictColumns, {{end}}updateColumns, whitelist...)\n" +
+		"}\n" +
+		"\n" +
+		"// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.\n" +
+		"func (o *{{$tableNameSingular}}) UpsertGP({{if eq .DriverName \"postgres\"}}updateOnConflict bool, conflictColumns []string, {{end}}updateColumns []string,\twhitelist ...string) {\n" +
+		"\tif err := o.Upsert(boil.GetDB(), {{if eq .DriverName \"postgres\"}}updateOnConflict, conflictColumns, {{end}}updateColumns, whitelist...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.\n" +
+		"// UpsertP panics on error.\n" +
+		"func (o *{{$tableNameSingular}}) UpsertP(exec boil.Executor, {{if eq .DriverName \"postgres\"}}updateOnConflict bool, conflictColumns []string, {{end}}updateColumns []string,\twhitelist ...string) {\n" +
+		"\tif err := o.Upsert(exec, {{if eq .DriverName \"postgres\"}}updateOnConflict, conflictColumns, {{end}}updateColumns, whitelist...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// Upsert attempts an insert using an executor, and does an update or ignore on conflict.\n" +
+		"func (o *{{$tableNameSingular}}) Upsert(exec boil.Executor, {{if eq .DriverName \"postgres\"}}updateOnConflict bool, conflictColumns []string, {{end}}updateColumns []string, whitelist ...string) error {\n" +
+		"\tif o == nil {\n" +
+		"\t\treturn errors.New(\"{{.PkgName}}: no {{.Table.Name}} provided for upsert\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{- template \"timestamp_upsert_helper\" . }}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err := o.doBeforeUpsertHooks(exec); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tnzDefaults := queries.NonZeroDefaultSet({{$varNameSingular}}ColumnsWithDefault, o)\n" +
+		"\n" +
+		"\t// Build cache key in-line uglily - mysql vs postgres problems\n" +
+		"\tbuf := strmangle.GetBuffer()\n" +
+		"\t{{- if .RuntimeSchema}}\n" +
+		"\tbuf.WriteString(boil.SchemaOf(exec, \"{{.Table.SchemaName}}\"))\n" +
+		"\tbuf.WriteByte('.')\n" +
+		"\t{{- end}}\n" +
+		"\t{{if eq .DriverName \"postgres\"}}\n" +
+		"\tif updateOnConflict {\n" +
+		"\t\tbuf.WriteByte('t')\n" +
+		"\t} else {\n" +
+		"\t\tbuf.WriteByte('f')\n" +
+		"\t}\n" +
+		"\tbuf.WriteByte('.')\n" +
+		"\tfor _, c := range conflictColumns {\n" +
+		"\t\tbuf.WriteString(c)\n" +
+		"\t}\n" +
+		"\tbuf.WriteByte('.')\n" +
+		"\t{{end -}}\n" +
+		"\tfor _, c := range updateColumns {\n" +
+		"\t\tbuf.WriteString(c)\n" +
+		"\t}\n" +
+		"\tbuf.WriteByte('.')\n" +
+		"\tfor _, c := range whitelist {\n" +
+		"\t\tbuf.WriteString(c)\n" +
+		"\t}\n" +
+		"\tbuf.WriteByte('.')\n" +
+		"\tfor _, c := range nzDefaults {\n" +
+		"\t\tbuf.WriteString(c)\n" +
+		"\t}\n" +
+		"\tkey := buf.String()\n" +
+		"\tstrmangle.PutBuffer(buf)\n" +
+		"\n" +
+		"\t{{$varNameSingular}}UpsertCacheMut.RLock()\n" +
+		"\tcache, cached := {{$varNameSingular}}UpsertCache[key]\n" +
+		"\t{{$varNameSingular}}UpsertCacheMut.RUnlock()\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\tif !cached {\n" +
+		"\t\tinsert, ret := strmangle.InsertColumnSet(\n" +
+		"\t\t\t{{$varNameSingular}}Columns,\n" +
+		"\t\t\t{{$varNameSingular}}ColumnsWithDefault,\n" +
+		"\t\t\t{{$varNameSingular}}ColumnsWithoutDefault,\n" +
+		"\t\t\tnzDefaults,\n" +
+		"\t\t\twhitelist,\n" +
+		"\t\t)\n" +
+		"\t\t{{if eq .DriverName \"mssql\" -}}\n" +
+		"\t\tinsert = strmangle.SetComplement(insert, {{$varNameSingular}}ColumnsWithAuto)\n" +
+		"\t\tfor i, v := range insert {\n" +
+		"\t\t\tif strmangle.ContainsAny({{$varNameSingular}}PrimaryKeyColumns, v) && strmangle.ContainsAny({{$varNameSingular}}ColumnsWithDefault, v) {\n" +
+		"\t\t\t\tinsert = append(insert[:i], insert[i+1:]...)\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t\tif len(insert) == 0 {\n" +
+		"\t\t\treturn errors.New(\"{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build insert column list\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tret = strmangle.SetMerge(ret, {{$varNameSingular}}ColumnsWithAuto)\n" +
+		"\t\tret = strmangle.SetMerge(ret, {{$varNameSingular}}ColumnsWithDefault)\n" +
+		"\n" +
+		"\t\t{{end}}\n" +
+		"\t\tupdate := strmangle.UpdateColumnSet(\n" +
+		"\t\t\t{{$varNameSingular}}Columns,\n" +
+		"\t\t\t{{$varNameSingular}}PrimaryKeyColumns,\n" +
+		"\t\t\tupdateColumns,\n" +
+		"\t\t)\n" +
+		"\t\t{{if eq .DriverName \"mssql\" -}}\n" +
+		"\t\tupdate = strmangle.SetComplement(update, {{$varNameSingular}}ColumnsWithAuto)\n" +
+		"\t\t{{end -}}\n" +
+		"\n" +
+		"\t\tif len(update) == 0 {\n" +
+		"\t\t\treturn errors.New(\"{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\t{{if eq .DriverName \"postgres\"}}\n" +
+		"\t\tconflict := conflictColumns\n" +
+		"\t\tif len(conflict) == 0 {\n" +
+		"\t\t\tconflict = make([]string, len({{$varNameSingular}}PrimaryKeyColumns))\n" +
+		"\t\t\tcopy(conflict, {{$varNameSingular}}PrimaryKeyColumns)\n" +
+		"\t\t}\n" +
+		"\t\tcache.query = queries.BuildUpsertQueryPostgres(dialect, \"{{$schemaTable}}\", updateOnConflict, ret, update, conflict, insert)\n" +
+		"\t\t{{else if eq .DriverName \"mysql\"}}\n" +
+		"\t\tcache.query = queries.BuildUpsertQueryMySQL(dialect, \"{{.Table.Name}}\", update, insert)\n" +
+		"\t\tcache.retQuery = fmt.Sprintf(\n" +
+		"\t\t\t\"SELECT %s FROM {{.LQ}}{{.Table.Name}}{{.RQ}} WHERE {{whereClause .LQ .RQ 0 .Table.PKey.Columns}}\",\n" +
+		"\t\t\tstrings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), \",\"),\n" +
+		"\t\t)\n" +
+		"\t\t{{else if eq .DriverName \"mssql\"}}\n" +
+		"\t\tcache.query = queries.BuildUpsertQueryMSSQL(dialect, \"{{$schemaTable}}\", {{$varNameSingular}}PrimaryKeyColumns, update, insert, ret)\n" +
+		"\n" +
+		"\t\twhitelist = append({{$varNameSingular}}PrimaryKeyColumns, update...)\n" +
+		"\t\twhitelist = append(whitelist, insert...)\n" +
+		"\t\t{{- end}}\n" +
+		"\n" +
+		"\t\tcache.valueMapping, err = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, {{if eq .DriverName \"mssql\"}}whitelist{{else}}insert{{end}})\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t\t{{- if not .NoValidate}}\n" +
+		"\t\tcache.columns = {{if eq .DriverName \"mssql\"}}whitelist{{else}}insert{{end}}\n" +
+		"\t\t{{- end}}\n" +
+		"\t\tif len(ret) != 0 {\n" +
+		"\t\t\tcache.retMapping, err = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, ret)\n" +
+		"\t\t\tif err != nil {\n" +
+		"\t\t\t\treturn err\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tvalue := reflect.Indirect(reflect.ValueOf(o))\n" +
+		"\tvals := queries.ValuesFromMapping(value, cache.valueMapping)\n" +
+		"\t{{- if not .NoValidate}}\n" +
+		"\n" +
+		"\tif err := boil.ValidateColumns(\"{{.Table.Name}}\", cache.columns, vals, {{$varNameSingular}}ColumnLimits); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\tvar returns []interface{}\n" +
+		"\tif len(cache.retMapping) != 0 {\n" +
+		"\t\treturns = queries.PtrsFromMapping(value, cache.retMapping)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, cache.query)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, vals)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .UseLastInsertID -}}\n" +
+		"\t{{- $canLastInsertID := .Table.CanLastInsertID -}}\n" +
+		"\t{{if $canLastInsertID -}}\n" +
+		"\tresult, err := exec.Exec(cache.query, vals...)\n" +
+		"\t{{else -}}\n" +
+		"\t_, err = exec.Exec(cache.query, vals...)\n" +
+		"\t{{- end}}\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to upsert for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $canLastInsertID -}}\n" +
+		"\tvar lastID int64\n" +
+		"\t{{- end}}\n" +
+		"\tvar identifierCols []interface{}\n" +
+		"\n" +
+		"\tif len(cache.retMapping) == 0 {\n" +
+		"\t\tgoto CacheNoHooks\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $canLastInsertID -}}\n" +
+		"\tlastID, err = result.LastInsertId()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn ErrSyncFail\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{$colName := index .Table.PKey.Columns 0 -}}\n" +
+		"\t{{- $col := .Table.GetColumn $colName -}}\n" +
+		"\t{{- $colTitled := $colName | titleCase}}\n" +
+		"\to.{{$colTitled}} = {{$col.Type}}(lastID)\n" +
+		"\tif lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == {{$varNameSingular}}Mapping[\"{{$colTitled}}\"] {\n" +
+		"\t\tgoto CacheNoHooks\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tidentifierCols = []interface{}{\n" +
+		"\t\t{{range .Table.PKey.Columns -}}\n" +
+		"\t\to.{{. | titleCase}},\n" +
+		"\t\t{{end -}}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, cache.retQuery)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, identifierCols...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\terr = exec.QueryRow(cache.retQuery, identifierCols...).Scan(returns...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"{{.PkgName}}: unable to populate default values for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- else}}\n" +
+		"\tif len(cache.retMapping) != 0 {\n" +
+		"\t\terr = exec.QueryRow(cache.query, vals...).Scan(returns...)\n" +
+		"\t\tif err == sql.ErrNoRows {\n" +
+		"\t\t\terr = nil // Postgres doesn't return anything when there's no update\n" +
+		"\t\t}\n" +
+		"\t} else {\n" +
+		"\t\t_, err = exec.Exec(cache.query, vals...)\n" +
+		"\t}\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to upsert {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"{{if .UseLastInsertID -}}\n" +
+		"CacheNoHooks:\n" +
+		"{{end -}}\n" +
+		"\tif !cached {\n" +
+		"\t\t{{$varNameSingular}}UpsertCacheMut.Lock()\n" +
+		"\t\t{{$varNameSingular}}UpsertCache[key] = cache\n" +
+		"\t\t{{$varNameSingular}}UpsertCacheMut.Unlock()\n" +
+		"\t}\n" +
+		"\n" +
+		"\to.Snapshot()\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\treturn o.doAfterUpsertHooks(exec)\n" +
+		"\t{{- else -}}\n" +
+		"\treturn nil\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"{{- if eq .DriverName \"postgres\"}}\n" +
+		"{{- $dot := .}}\n" +
+		"{{- range $ukey := .Table.UKeys -}}\n" +
+		"{{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join \"And\" -}}\n" +
+		"{{- $conflict := $ukey.Columns | stringMap $dot.StringFuncs.quoteWrap | join \", \"}}\n" +
+		"\n" +
+		"// UpsertBy{{$keyName}}G attempts an insert, and does an update or ignore on a conflict\n" +
+		"// on the unique {{$ukey.Columns | join \", \"}}.\n" +
+		"func (o *{{$tableNameSingular}}) UpsertBy{{$keyName}}G(updateOnConflict bool, updateColumns []string, whitelist ...string) error {\n" +
+		"\treturn o.Upsert(boil.GetDB(), updateOnConflict, []string{ {{- $conflict -}} }, updateColumns, whitelist...)\n" +
+		"}\n" +
+		"\n" +
+		"// UpsertBy{{$keyName}}GP attempts an insert, and does an update or ignore on a conflict\n" +
+		"// on the unique {{$ukey.Columns | join \", \"}}. Panics on error.\n" +
+		"func (o *{{$tableNameSingular}}) UpsertBy{{$keyName}}GP(updateOnConflict bool, updateColumns []string, whitelist ...string) {\n" +
+		"\tif err := o.Upsert(boil.GetDB(), updateOnConflict, []string{ {{- $conflict -}} }, updateColumns, whitelist...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// UpsertBy{{$keyName}} attempts an insert using an executor, and does an update or ignore\n" +
+		"// on a conflict on the unique {{$ukey.Columns | join \", \"}}.\n" +
+		"func (o *{{$tableNameSingular}}) UpsertBy{{$keyName}}(exec boil.Executor, updateOnConflict bool, updateColumns []string, whitelist ...string) error {\n" +
+		"\treturn o.Upsert(exec, updateOnConflict, []string{ {{- $conflict -}} }, updateColumns, whitelist...)\n" +
+		"}\n" +
+		"\n" +
+		"// UpsertBy{{$keyName}}P attempts an insert using an executor, and does an update or ignore\n" +
+		"// on a conflict on the unique {{$ukey.Columns | join \", \"}}. Panics on error.\n" +
+		"func (o *{{$tableNameSingular}}) UpsertBy{{$keyName}}P(exec boil.Executor, updateOnConflict bool, updateColumns []string, whitelist ...string) {\n" +
+		"\tif err := o.Upsert(exec, updateOnConflict, []string{ {{- $conflict -}} }, updateColumns, whitelist...); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{- end -}}\n" +
+		"{{- end -}}\n" +
+		"{{- end -}}\n",
+	"templates/18_delete.tpl": "{{- if not .Table.IsView -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- $schemaTable := .Table.Name | .SchemaTable -}}\n" +
+		"{{- $ret := \"error\" -}}{{- $z := \"\" -}}\n" +
+		"{{- if .RowsAffected -}}{{- $ret = \"(int64, error)\" -}}{{- $z = \"0, \" -}}{{- end}}\n" +
+		"// DeleteP deletes a single {{$tableNameSingular}} record with an executor.\n" +
+		"// DeleteP will match against the primary key column to find the record to delete.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$tableNameSingular}}) DeleteP(exec boil.Executor){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := o.Delete(exec)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.Delete(exec); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// DeleteG deletes a single {{$tableNameSingular}} record.\n" +
+		"// DeleteG will match against the primary key column to find the record to delete.\n" +
+		"func (o *{{$tableNameSingular}}) DeleteG() {{$ret}} {\n" +
+		"\tif o == nil {\n" +
+		"\treturn {{$z}}errors.New(\"{{.PkgName}}: no {{$tableNameSingular}} provided for deletion\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o.Delete(boil.GetDB())\n" +
+		"}\n" +
+		"\n" +
+		"// DeleteGP deletes a single {{$tableNameSingular}} record.\n" +
+		"// DeleteGP will match against the primary key column to find the record to delete.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$tableNameSingular}}) DeleteGP(){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := o.DeleteG()\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.DeleteG(); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// Delete deletes a single {{$tableNameSingular}} record with an executor.\n" +
+		"// Delete will match against the primary key column to find the record to delete.\n" +
+		"{{- if .RowsAffected}}\n" +
+		"// Delete returns the number of rows affected, which is 0 if the row was not found.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$tableNameSingular}}) Delete(exec boil.Executor) {{$ret}} {\n" +
+		"\tif o == nil {\n" +
+		"\treturn {{$z}}errors.New(\"{{.PkgName}}: no {{$tableNameSingular}} provided for delete\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err := o.doBeforeDeleteHooks(exec); err != nil {\n" +
+		"\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\targs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}PrimaryKeyMapping)\n" +
+		"\tsql := \"DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.IndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}\"\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\tfmt.Fprintln(boil.DebugWriter, sql)\n" +
+		"\tfmt.Fprintln(boil.DebugWriter, args...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tresult, err := exec.Exec(sql, args...)\n" +
+		"\tif err != nil {\n" +
+		"\treturn 0, errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to delete from {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\trowsAff, err := result.RowsAffected()\n" +
+		"\tif err != nil {\n" +
+		"\treturn 0, errors.Wrap(err, \"{{.PkgName}}: failed to get rows affected by delete for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\t_, err := exec.Exec(sql, args...)\n" +
+		"\tif err != nil {\n" +
+		"\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to delete from {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err := o.doAfterDeleteHooks(exec); err != nil {\n" +
+		"\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\treturn {{if .RowsAffected}}rowsAff, {{end}}nil\n" +
+		"}\n" +
+		"\n" +
+		"// DeleteAllP deletes all rows, and panics on error.\n" +
+		"func (q {{$varNameSingular}}Query) DeleteAllP(){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := q.DeleteAll()\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := q.DeleteAll(); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// DeleteAll deletes all matching rows.\n" +
+		"func (q {{$varNameSingular}}Query) DeleteAll() {{$ret}} {\n" +
+		"\tif q.Query == nil {\n" +
+		"\treturn {{$z}}errors.New(\"{{.PkgName}}: no {{$varNameSingular}}Query provided for delete all\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\texec := queries.GetExecutor(q.Query)\n" +
+		"\tif err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}BeforeDeleteAllHooks, exec, q.Query, nil, nil); err != nil {\n" +
+		"\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{end -}}\n" +
+		"\tqueries.SetDelete(q.Query)\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tresult, err := q.Query.Exec()\n" +
+		"\tif err != nil {\n" +
+		"\treturn 0, errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to delete all from {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\trowsAff, err := result.RowsAffected()\n" +
+		"\tif err != nil {\n" +
+		"\treturn 0, errors.Wrap(err, \"{{.PkgName}}: failed to get rows affected by deleteall for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\t_, err := q.Query.Exec()\n" +
+		"\tif err != nil {\n" +
+		"\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to delete all from {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err = do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}AfterDeleteAllHooks, exec, q.Query, nil, nil); err != nil {\n" +
+		"\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{end -}}\n" +
+		"\treturn {{if .RowsAffected}}rowsAff, {{end}}nil\n" +
+		"}\n" +
+		"\n" +
+		"// DeleteAllGP deletes all rows in the slice, and panics on error.\n" +
+		"func (o {{$tableNameSingular}}Slice) DeleteAllGP(){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := o.DeleteAllG()\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.DeleteAllG(); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// DeleteAllG deletes all rows in the slice.\n" +
+		"func (o {{$tableNameSingular}}Slice) DeleteAllG() {{$ret}} {\n" +
+		"\tif o == nil {\n" +
+		"\treturn {{$z}}errors.New(\"{{.PkgName}}: no {{$tableNameSingular}} slice provided for delete all\")\n" +
+		"\t}\n" +
+		"\treturn o.DeleteAll(boil.GetDB())\n" +
+		"}\n" +
+		"\n" +
+		"// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.\n" +
+		"func (o {{$tableNameSingular}}Slice) DeleteAllP(exec boil.Executor){{if .RowsAffected}} int64{{end}} {\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\trowsAff, err := o.DeleteAll(exec)\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn rowsAff\n" +
+		"\t{{- else -}}\n" +
+		"\tif err := o.DeleteAll(exec); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// DeleteAll deletes all rows in the slice, using an executor.\n" +
+		"func (o {{$tableNameSingular}}Slice) DeleteAll(exec boil.Executor) {{$ret}} {\n" +
+		"\tif o == nil {\n" +
+		"\t\treturn {{$z}}errors.New(\"{{.PkgName}}: no {{$tableNameSingular}} slice provided for delete all\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif len(o) == 0 {\n" +
+		"\t\treturn {{$z}}nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}BeforeDeleteAllHooks, exec, nil, o, nil); err != nil {\n" +
+		"\t\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif len({{$varNameSingular}}BeforeDeleteHooks) != 0 {\n" +
+		"\t\tfor _, obj := range o {\n" +
+		"\t\t\tif err := obj.doBeforeDeleteHooks(exec); err != nil {\n" +
+		"\t\t\t\treturn {{$z}}err\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tvar args []interface{}\n" +
+		"\tfor _, obj := range o {\n" +
+		"\t\tpkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$varNameSingular}}PrimaryKeyMapping)\n" +
+		"\t\targs = append(args, pkeyArgs...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tsql := \"DELETE FROM {{$schemaTable}} WHERE \" +\n" +
+		"\t\tstrmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.IndexPlaceholders}}1{{else}}0{{end}}, {{$varNameSingular}}PrimaryKeyColumns, len(o))\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, sql)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, args)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tresult, err := exec.Exec(sql, args...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to delete all from {{$varNameSingular}} slice\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\trowsAff, err := result.RowsAffected()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn 0, errors.Wrap(err, \"{{.PkgName}}: failed to get rows affected by deleteall for {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\t_, err := exec.Exec(sql, args...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(boil.ClassifyError(err), \"{{.PkgName}}: unable to delete all from {{$varNameSingular}} slice\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\t{{if not .NoHooks -}}\n" +
+		"\tif len({{$varNameSingular}}AfterDeleteHooks) != 0 || len({{$varNameSingular}}AfterCommitHooks) != 0 || len({{$varNameSingular}}AfterRollbackHooks) != 0 {\n" +
+		"\t\tfor _, obj := range o {\n" +
+		"\t\t\tif err := obj.doAfterDeleteHooks(exec); err != nil {\n" +
+		"\t\t\t\treturn {{$z}}err\n" +
+		"\t\t\t}\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}AfterDeleteAllHooks, exec, nil, o, nil); err != nil {\n" +
+		"\t\treturn {{$z}}err\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\treturn {{if .RowsAffected}}rowsAff, {{end}}nil\n" +
+		"}\n" +
+		"{{- end -}}\n",
+	"templates/19_reload.tpl": "{{- if .Table.PKey -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $schemaTable := .Table.Name | .SchemaTable}}\n" +
+		"// ReloadGP refetches the object from the database and panics on error.\n" +
+		"func (o *{{$tableNameSingular}}) ReloadGP() {\n" +
+		"\tif err := o.ReloadG(); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// ReloadP refetches the object from the database with an executor. Panics on error.\n" +
+		"func (o *{{$tableNameSingular}}) ReloadP(exec boil.Executor) {\n" +
+		"\tif err := o.Reload(exec); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// ReloadG refetches the object from the database using the primary keys.\n" +
+		"func (o *{{$tableNameSingular}}) ReloadG() error {\n" +
+		"\tif o == nil {\n" +
+		"\t\treturn errors.New(\"{{.PkgName}}: no {{$tableNameSingular}} provided for reload\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o.Reload(boil.GetDB())\n" +
+		"}\n" +
+		"\n" +
+		"// Reload refetches the object from the database\n" +
+		"// using the primary keys with an executor.\n" +
+		"func (o *{{$tableNameSingular}}) Reload(exec boil.Executor) error {\n" +
+		"\tret, err := Find{{$tableNameSingular}}(exec, {{.Table.PKey.Columns | stringMap .StringFuncs.titleCase | prefixStringSlice \"o.\" | join \", \"}})\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\t*o = *ret\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// ReloadAllGP refetches every row with matching primary key column values\n" +
+		"// and overwrites the original object slice with the newly updated slice.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$tableNameSingular}}Slice) ReloadAllGP() {\n" +
+		"\tif err := o.ReloadAllG(); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// ReloadAllP refetches every row with matching primary key column values\n" +
+		"// and overwrites the original object slice with the newly updated slice.\n" +
+		"// Panics on error.\n" +
+		"func (o *{{$tableNameSingular}}Slice) ReloadAllP(exec boil.Executor) {\n" +
+		"\tif err := o.ReloadAll(exec); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// ReloadAllG refetches every row with matching primary key column values\n" +
+		"// and overwrites the original object slice with the newly updated slice.\n" +
+		"func (o *{{$tableNameSingular}}Slice) ReloadAllG() error {\n" +
+		"\tif o == nil {\n" +
+		"\t\treturn errors.New(\"{{.PkgName}}: empty {{$tableNameSingular}}Slice provided for reload all\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o.ReloadAll(boil.GetDB())\n" +
+		"}\n" +
+		"\n" +
+		"// ReloadAll refetches every row with matching primary key column values\n" +
+		"// and overwrites the original object slice with the newly updated slice.\n" +
+		"func (o *{{$tableNameSingular}}Slice) ReloadAll(exec boil.Executor) error {\n" +
+		"\tif o == nil || len(*o) == 0 {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{$varNamePlural}} := {{$tableNameSingular}}Slice{}\n" +
+		"\tvar args []interface{}\n" +
+		"\tfor _, obj := range *o {\n" +
+		"\t\tpkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$varNameSingular}}PrimaryKeyMapping)\n" +
+		"\t\targs = append(args, pkeyArgs...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tsql := \"SELECT {{$schemaTable}}.* FROM {{$schemaTable}} WHERE \" +\n" +
+		"\t\tstrmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.IndexPlaceholders}}1{{else}}0{{end}}, {{$varNameSingular}}PrimaryKeyColumns, len(*o))\n" +
+		"\n" +
+		"\tq := queries.Raw(exec, sql, args...)\n" +
+		"\n" +
+		"\terr := q.Bind(&{{$varNamePlural}})\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"{{.PkgName}}: unable to reload all in {{$tableNameSingular}}Slice\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t*o = {{$varNamePlural}}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"{{- end -}}\n",
+	"templates/20_exists.tpl": "{{- $dot := . -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- if .Table.PKey -}}\n" +
+		"{{- $colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}\n" +
+		"{{- $pkNames := $colDefs.Names | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}\n" +
+		"{{- $pkArgs := joinSlices \" \" $pkNames $colDefs.Types | join \", \" -}}\n" +
+		"{{- $schemaTable := .Table.Name | .SchemaTable}}\n" +
+		"// {{$tableNameSingular}}Exists checks if the {{$tableNameSingular}} row exists.\n" +
+		"func {{$tableNameSingular}}Exists(exec boil.Executor, {{$pkArgs}}) (bool, error) {\n" +
+		"\tvar exists bool\n" +
+		"\t{{if eq .DriverName \"mssql\" -}}\n" +
+		"\tsql := \"select case when exists(select top(1) 1 from {{$schemaTable}} where {{if .Dialect.IndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}) then 1 else 0 end\"\n" +
+		"\t{{- else -}}\n" +
+		"\tsql := \"select exists(select 1 from {{$schemaTable}} where {{if .Dialect.IndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}} limit 1)\"\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, sql)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, {{$pkNames | join \", \"}})\n" +
+		"\t}\n" +
+		"\n" +
+		"\trow := exec.QueryRow(sql, {{$pkNames | join \", \"}})\n" +
+		"\n" +
+		"\terr := row.Scan(&exists)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn false, errors.Wrap(err, \"{{.PkgName}}: unable to check if {{.Table.Name}} exists\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn exists, nil\n" +
+		"}\n" +
+		"\n" +
+		"// {{$tableNameSingular}}ExistsG checks if the {{$tableNameSingular}} row exists.\n" +
+		"func {{$tableNameSingular}}ExistsG({{$pkArgs}}) (bool, error) {\n" +
+		"\treturn {{$tableNameSingular}}Exists(boil.GetDB(), {{$pkNames | join \", \"}})\n" +
+		"}\n" +
+		"\n" +
+		"// {{$tableNameSingular}}ExistsGP checks if the {{$tableNameSingular}} row exists. Panics on error.\n" +
+		"func {{$tableNameSingular}}ExistsGP({{$pkArgs}}) bool {\n" +
+		"\te, err := {{$tableNameSingular}}Exists(boil.GetDB(), {{$pkNames | join \", \"}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn e\n" +
+		"}\n" +
+		"\n" +
+		"// {{$tableNameSingular}}ExistsP checks if the {{$tableNameSingular}} row exists. Panics on error.\n" +
+		"func {{$tableNameSingular}}ExistsP(exec boil.Executor, {{$pkArgs}}) bool {\n" +
+		"\te, err := {{$tableNameSingular}}Exists(exec, {{$pkNames | join \", \"}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn e\n" +
+		"}\n" +
+		"{{- end -}}\n" +
+		"{{- range $ukey := .Table.UKeys -}}\n" +
+		"{{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join \"And\" -}}\n" +
+		"{{- $colDefs := sqlColDefinitions $dot.Table.Columns $ukey.Columns -}}\n" +
+		"{{- $keyNames := $colDefs.Names | stringMap $dot.StringFuncs.camelCase | stringMap $dot.StringFuncs.replaceReserved -}}\n" +
+		"{{- $keyArgs := joinSlices \" \" $keyNames $colDefs.Types | join \", \" -}}\n" +
+		"{{- $schemaTable := $dot.Table.Name | $dot.SchemaTable}}\n" +
+		"\n" +
+		"// {{$tableNameSingular}}ExistsBy{{$keyName}} checks if a {{$tableNameSingular}} row with the unique\n" +
+		"// {{$ukey.Columns | join \", \"}} exists.\n" +
+		"func {{$tableNameSingular}}ExistsBy{{$keyName}}(exec boil.Executor, {{$keyArgs}}) (bool, error) {\n" +
+		"\tvar exists bool\n" +
+		"\t{{if eq $dot.DriverName \"mssql\" -}}\n" +
+		"\tsql := \"select case when exists(select top(1) 1 from {{$schemaTable}} where {{if $dot.Dialect.IndexPlaceholders}}{{whereClause $dot.LQ $dot.RQ 1 $ukey.Columns}}{{else}}{{whereClause $dot.LQ $dot.RQ 0 $ukey.Columns}}{{end}}) then 1 else 0 end\"\n" +
+		"\t{{- else -}}\n" +
+		"\tsql := \"select exists(select 1 from {{$schemaTable}} where {{if $dot.Dialect.IndexPlaceholders}}{{whereClause $dot.LQ $dot.RQ 1 $ukey.Columns}}{{else}}{{whereClause $dot.LQ $dot.RQ 0 $ukey.Columns}}{{end}} limit 1)\"\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, sql)\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, {{$keyNames | join \", \"}})\n" +
+		"\t}\n" +
+		"\n" +
+		"\trow := exec.QueryRow(sql, {{$keyNames | join \", \"}})\n" +
+		"\n" +
+		"\terr := row.Scan(&exists)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn false, errors.Wrap(err, \"{{$dot.PkgName}}: unable to check if {{$dot.Table.Name}} exists\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn exists, nil\n" +
+		"}\n" +
+		"\n" +
+		"// {{$tableNameSingular}}ExistsBy{{$keyName}}G checks if a {{$tableNameSingular}} row with the unique\n" +
+		"// {{$ukey.Columns | join \", \"}} exists.\n" +
+		"func {{$tableNameSingular}}ExistsBy{{$keyName}}G({{$keyArgs}}) (bool, error) {\n" +
+		"\treturn {{$tableNameSingular}}ExistsBy{{$keyName}}(boil.GetDB(), {{$keyNames | join \", \"}})\n" +
+		"}\n" +
+		"\n" +
+		"// {{$tableNameSingular}}ExistsBy{{$keyName}}GP checks if a {{$tableNameSingular}} row with the unique\n" +
+		"// {{$ukey.Columns | join \", \"}} exists. Panics on error.\n" +
+		"func {{$tableNameSingular}}ExistsBy{{$keyName}}GP({{$keyArgs}}) bool {\n" +
+		"\te, err := {{$tableNameSingular}}ExistsBy{{$keyName}}(boil.GetDB(), {{$keyNames | join \", \"}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn e\n" +
+		"}\n" +
+		"\n" +
+		"// {{$tableNameSingular}}ExistsBy{{$keyName}}P checks if a {{$tableNameSingular}} row with the unique\n" +
+		"// {{$ukey.Columns | join \", \"}} exists. Panics on error.\n" +
+		"func {{$tableNameSingular}}ExistsBy{{$keyName}}P(exec boil.Executor, {{$keyArgs}}) bool {\n" +
+		"\te, err := {{$tableNameSingular}}ExistsBy{{$keyName}}(exec, {{$keyNames | join \", \"}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn e\n" +
+		"}\n" +
+		"{{- end -}}\n",
+	"templates/21_auto_timestamps.tpl": "{{- define \"timestamp_insert_helper\" -}}\n" +
+		"\t{{- if not .NoAutoTimestamps -}}\n" +
+		"\t{{- $colNames := .Table.Columns | columnNames -}}\n" +
+		"\t{{if containsAny $colNames \"created_at\" \"updated_at\"}}\n" +
+		"\tcurrTime := time.Now().In(boil.GetLocation())\n" +
+		"\t\t{{range $ind, $col := .Table.Columns}}\n" +
+		"\t\t\t{{- if eq $col.Name \"created_at\" -}}\n" +
+		"\t\t\t\t{{- if $col.Nullable}}\n" +
+		"\tif o.CreatedAt.Time.IsZero() {\n" +
+		"\t\to.CreatedAt.Time = currTime\n" +
+		"\t\to.CreatedAt.Valid = true\n" +
+		"\t}\n" +
+		"\t\t\t\t{{- else}}\n" +
+		"\tif o.CreatedAt.IsZero() {\n" +
+		"\t\to.CreatedAt = currTime\n" +
+		"\t}\n" +
+		"\t\t\t\t{{- end -}}\n" +
+		"\t\t\t{{- end -}}\n" +
+		"\t\t\t{{- if eq $col.Name \"updated_at\" -}}\n" +
+		"\t\t\t\t{{- if $col.Nullable}}\n" +
+		"\tif o.UpdatedAt.Time.IsZero() {\n" +
+		"\t\to.UpdatedAt.Time = currTime\n" +
+		"\t\to.UpdatedAt.Valid = true\n" +
+		"\t}\n" +
+		"\t\t\t\t{{- else}}\n" +
+		"\tif o.UpdatedAt.IsZero() {\n" +
+		"\t\to.UpdatedAt = currTime\n" +
+		"\t}\n" +
+		"\t\t\t\t{{- end -}}\n" +
+		"\t\t\t{{- end -}}\n" +
+		"\t\t{{end}}\n" +
+		"\t{{end}}\n" +
+		"\t{{- end}}\n" +
+		"{{- end -}}\n" +
+		"{{- define \"timestamp_update_helper\" -}}\n" +
+		"\t{{- if not .NoAutoTimestamps -}}\n" +
+		"\t{{- $colNames := .Table.Columns | columnNames -}}\n" +
+		"\t{{if containsAny $colNames \"updated_at\"}}\n" +
+		"\tcurrTime := time.Now().In(boil.GetLocation())\n" +
+		"\t\t{{range $ind, $col := .Table.Columns}}\n" +
+		"\t\t\t{{- if eq $col.Name \"updated_at\" -}}\n" +
+		"\t\t\t\t{{- if $col.Nullable}}\n" +
+		"\to.UpdatedAt.Time = currTime\n" +
+		"\to.UpdatedAt.Valid = true\n" +
+		"\t\t\t\t{{- else}}\n" +
+		"\to.UpdatedAt = currTime\n" +
+		"\t\t\t\t{{- end -}}\n" +
+		"\t\t\t{{- end -}}\n" +
+		"\t\t{{end}}\n" +
+		"\t{{end}}\n" +
+		"\t{{- end}}\n" +
+		"{{end -}}\n" +
+		"{{- define \"timestamp_upsert_helper\" -}}\n" +
+		"\t{{- if not .NoAutoTimestamps -}}\n" +
+		"\t{{- $colNames := .Table.Columns | columnNames -}}\n" +
+		"\t{{if containsAny $colNames \"created_at\" \"updated_at\"}}\n" +
+		"\tcurrTime := time.Now().In(boil.GetLocation())\n" +
+		"\t\t{{range $ind, $col := .Table.Columns}}\n" +
+		"\t\t\t{{- if eq $col.Name \"created_at\" -}}\n" +
+		"\t\t\t\t{{- if $col.Nullable}}\n" +
+		"\tif o.CreatedAt.Time.IsZero() {\n" +
+		"\t\to.CreatedAt.Time = currTime\n" +
+		"\t\to.CreatedAt.Valid = true\n" +
+		"\t}\n" +
+		"\t\t\t\t{{- else}}\n" +
+		"\tif o.CreatedAt.IsZero() {\n" +
+		"\t\to.CreatedAt = currTime\n" +
+		"\t}\n" +
+		"\t\t\t\t{{- end -}}\n" +
+		"\t\t\t{{- end -}}\n" +
+		"\t\t\t{{- if eq $col.Name \"updated_at\" -}}\n" +
+		"\t\t\t\t{{- if $col.Nullable}}\n" +
+		"\to.UpdatedAt.Time = currTime\n" +
+		"\to.UpdatedAt.Valid = true\n" +
+		"\t\t\t\t{{- else}}\n" +
+		"\to.UpdatedAt = currTime\n" +
+		"\t\t\t\t{{- end -}}\n" +
+		"\t\t\t{{- end -}}\n" +
+		"\t\t{{end}}\n" +
+		"\t{{end}}\n" +
+		"\t{{- end}}\n" +
+		"{{end -}}\n",
+	"templates/22_refresh_materialized_view.tpl": "{{- if .Table.IsMaterializedView -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $schemaTable := .Table.Name | .SchemaTable}}\n" +
+		"// Refresh{{$tableNamePlural}}G refreshes the contents of the materialized view.\n" +
+		"func Refresh{{$tableNamePlural}}G(concurrently bool) error {\n" +
+		"\treturn Refresh{{$tableNamePlural}}(boil.GetDB(), concurrently)\n" +
+		"}\n" +
+		"\n" +
+		"// Refresh{{$tableNamePlural}}GP refreshes the contents of the materialized view, and panics on error.\n" +
+		"func Refresh{{$tableNamePlural}}GP(concurrently bool) {\n" +
+		"\tif err := Refresh{{$tableNamePlural}}(boil.GetDB(), concurrently); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// Refresh{{$tableNamePlural}}P refreshes the contents of the materialized view with an executor, and panics on error.\n" +
+		"func Refresh{{$tableNamePlural}}P(exec boil.Executor, concurrently bool) {\n" +
+		"\tif err := Refresh{{$tableNamePlural}}(exec, concurrently); err != nil {\n" +
+		"\t\tpanic(boil.WrapErr(err))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"// Refresh{{$tableNamePlural}} refreshes the contents of the materialized view with an executor.\n" +
+		"// A concurrent refresh doesn't lock out selects while it runs but requires\n" +
+		"// the view to have a unique index.\n" +
+		"func Refresh{{$tableNamePlural}}(exec boil.Executor, concurrently bool) error {\n" +
+		"\tquery := \"REFRESH MATERIALIZED VIEW {{$schemaTable}}\"\n" +
+		"\tif concurrently {\n" +
+		"\t\tquery = \"REFRESH MATERIALIZED VIEW CONCURRENTLY {{$schemaTable}}\"\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif boil.DebugMode {\n" +
+		"\t\tfmt.Fprintln(boil.DebugWriter, query)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif _, err := exec.Exec(query); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"{{.PkgName}}: unable to refresh {{.Table.Name}}\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"{{- end -}}\n",
+	"templates/23_validate.tpl": "{{- if not .Table.IsView -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase}}\n" +
+		"\n" +
+		"// ColumnLimits returns the limits the database puts on the columns of {{.Table.Name}}.\n" +
+		"func (o *{{$tableNameSingular}}) ColumnLimits() map[string]boil.ColumnLimits {\n" +
+		"\treturn {{$varNameSingular}}ColumnLimits\n" +
+		"}\n" +
+		"\n" +
+		"// Validate checks every column of the {{$tableNameSingular}} against the limits the\n" +
+		"// database puts on it: NOT NULL, lengths, decimal digits and simple CHECK constraints.\n" +
+		"// It returns a *boil.ValidationError listing each offending column.\n" +
+		"{{- if not .NoValidate}}\n" +
+		"// Insert, Update and Upsert check the columns they write the same way.\n" +
+		"{{- end}}\n" +
+		"func (o *{{$tableNameSingular}}) Validate() error {\n" +
+		"\tvalues := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}ColumnsMapping)\n" +
+		"\treturn boil.ValidateColumns(\"{{.Table.Name}}\", {{$varNameSingular}}Columns, values, {{$varNameSingular}}ColumnLimits)\n" +
+		"}\n" +
+		"{{- end}}\n",
+	"templates/24_changed.tpl": "{{- if not .Table.IsView -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase}}\n" +
+		"\n" +
+		"// Snapshot records the current values of the columns of the {{$tableNameSingular}} as the\n" +
+		"// ones it was loaded with, Changed compares against them. Bind, Find, Insert, Upsert\n" +
+		"// and Reload call it, and Update records the columns it wrote, it only needs to be\n" +
+		"// called for a {{$tableNameSingular}} brought in line with the database some other way.\n" +
+		"func (o *{{$tableNameSingular}}) Snapshot() {\n" +
+		"\tsnapshot := queries.SnapshotFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}ColumnsMapping)\n" +
+		"\to.snapshot = &snapshot\n" +
+		"}\n" +
+		"\n" +
+		"// refreshSnapshot records the current values of columns as the ones the\n" +
+		"// {{$tableNameSingular}} was loaded with, after only they were written. A\n" +
+		"// {{$tableNameSingular}} that was never loaded is left that way.\n" +
+		"func (o *{{$tableNameSingular}}) refreshSnapshot(columns []string) {\n" +
+		"\tif o.snapshot == nil {\n" +
+		"\t\treturn\n" +
+		"\t}\n" +
+		"\n" +
+		"\tsnapshot := queries.RefreshSnapshotFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}ColumnsMapping, {{$varNameSingular}}Columns, *o.snapshot, columns)\n" +
+		"\to.snapshot = &snapshot\n" +
+		"}\n" +
+		"\n" +
+		"// Changed returns the columns whose values changed since the {{$tableNameSingular}} was\n" +
+		"// loaded from the database. All columns are returned if it was never loaded.\n" +
+		"func (o *{{$tableNameSingular}}) Changed() []string {\n" +
+		"\tif o.snapshot == nil {\n" +
+		"\t\treturn append([]string(nil), {{$varNameSingular}}Columns...)\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn queries.ChangedFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}ColumnsMapping, {{$varNameSingular}}Columns, *o.snapshot)\n" +
+		"}\n" +
+		"{{- end}}\n",
+	"templates/99_marshal.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"\n" +
+		"// MarshalJSON will marshal the struct {{$tableNameSingular}} into JSON\n" +
+		"// The ID field is filtered by default\n" +
+		"// This is called by default through json.Marshal\n" +
+		"func (o {{$tableNameSingular}}) MarshalJSON() ([]byte, error) {\n" +
+		"    exclude := map[string]bool{\"ID\": true}\n" +
+		"    return marshal.ToJSON(o, exclude)\n" +
+		" }\n" +
+		"\n" +
+		" // MarshalJSONFilter will marshal the struct {{$tableNameSingular}} into JSON\n" +
+		"// The exclude map will be filtered\n" +
+		"func (o {{$tableNameSingular}}) MarshalJSONFilter(exclude map[string]bool) ([]byte, error) {\n" +
+		"    temp, err := o.JSONFilter(exclude)\n" +
+		"    if err != nil {\n" +
+		"        return nil, err\n" +
+		"    }\n" +
+		"    return json.Marshal(temp)\n" +
+		" }\n" +
+		"\n" +
+		"// JSONFilter is required to be able to filter fields in this struct when it is an anonymous field in another struct\n" +
+		"// if there is a special case in the struct (private fields for example)\n" +
+		"func (o {{$tableNameSingular}}) JSONFilter(exclude map[string]bool) (res map[string]interface{}, err error) {\n" +
+		"\treturn marshal.JSONFilter(o, exclude)\n" +
+		"}\n",
+	"templates/singleton/boil_factories.tpl": "// factorySeed is shared by every factory, so the values of the rows they build\n" +
+		"// stay unique across tables and calls.\n" +
+		"var factorySeed = randomize.NewSeed()\n" +
+		"\n" +
+		"// factoryMaxDepth bounds the chain of parents a factory creates, a cycle of\n" +
+		"// required foreign keys then fails to insert rather than recursing forever.\n" +
+		"const factoryMaxDepth = 16\n" +
+		"\n" +
+		"// modelFactories builds valid random rows through the package level Factory,\n" +
+		"// e.g. Factory.Pilot().Create(tx). Values are random but unique and within the\n" +
+		"// types, enums and lengths of their columns.\n" +
+		"type modelFactories struct{}\n" +
+		"\n" +
+		"// Factory builds random rows of every table, see modelFactories.\n" +
+		"var Factory modelFactories\n" +
+		"\n" +
+		"// factoryIsZero reports whether a foreign key column was left unset\n" +
+		"func factoryIsZero(v interface{}) bool {\n" +
+		"\treturn reflect.DeepEqual(v, reflect.Zero(reflect.TypeOf(v)).Interface())\n" +
+		"}\n" +
+		"{{- range $table := .Tables}}\n" +
+		"{{- if or $table.IsJoinTable $table.IsView}}\n" +
+		"{{- else}}\n" +
+		"{{- $tableNameSingular := $table.Name | singular | titleCase}}\n" +
+		"{{- $varNameSingular := $table.Name | singular | camelCase}}\n" +
+		"\n" +
+		"// {{$varNameSingular}}DBTypes are the database types of the columns of {{$table.Name}}, for randomize\n" +
+		"var {{$varNameSingular}}DBTypes = map[string]string{{\"{\"}}{{$table.Columns | columnDBTypes | makeStringMap}}{{\"}\"}}\n" +
+		"\n" +
+		"// {{$varNameSingular}}Factory builds {{$tableNameSingular}} rows, see modelFactories.\n" +
+		"type {{$varNameSingular}}Factory struct {\n" +
+		"\toverrides []func(o *{{$tableNameSingular}})\n" +
+		"}\n" +
+		"\n" +
+		"// {{$tableNameSingular}} returns a factory of {{$tableNameSingular}} rows that applies the\n" +
+		"// overrides, in order, to each row once its random values are chosen.\n" +
+		"func (modelFactories) {{$tableNameSingular}}(overrides ...func(o *{{$tableNameSingular}})) {{$varNameSingular}}Factory {\n" +
+		"\treturn {{$varNameSingular}}Factory{overrides: overrides}\n" +
+		"}\n" +
+		"\n" +
+		"// Build returns a random {{$tableNameSingular}} without inserting it. Columns with\n" +
+		"// defaults and foreign keys are left zero (or null) unless overridden.\n" +
+		"func (f {{$varNameSingular}}Factory) Build() (*{{$tableNameSingular}}, error) {\n" +
+		"\to := &{{$tableNameSingular}}{}\n" +
+		"\tblacklist := append([]string{ {{- range $table.FKeys}}{{range .Columns}}\"{{.}}\", {{end}}{{end -}} }, {{$varNameSingular}}ColumnsWithDefault...)\n" +
+		"\tif err := randomize.Struct(factorySeed, o, {{$varNameSingular}}DBTypes, false, blacklist...); err != nil {\n" +
+		"\t\treturn nil, err\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, override := range f.overrides {\n" +
+		"\t\toverride(o)\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o, nil\n" +
+		"}\n" +
+		"\n" +
+		"// Create builds a {{$tableNameSingular}} and inserts it with exec. A parent row is\n" +
+		"// created for each required foreign key the overrides leave unset, set one to\n" +
+		"// reuse an existing parent instead.\n" +
+		"func (f {{$varNameSingular}}Factory) Create(exec boil.Executor) (*{{$tableNameSingular}}, error) {\n" +
+		"\treturn f.create(exec, 0)\n" +
+		"}\n" +
+		"\n" +
+		"func (f {{$varNameSingular}}Factory) create(exec boil.Executor, depth int) (*{{$tableNameSingular}}, error) {\n" +
+		"\to, err := f.Build()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn nil, err\n" +
+		"\t}\n" +
+		"\t{{- range $table.FKeys -}}\n" +
+		"\t{{- $foreignTable := getTable $.Tables .ForeignTable -}}\n" +
+		"\t{{- if or .Nullable (eq .ForeignTable $table.Name) $foreignTable.IsJoinTable $foreignTable.IsView -}}\n" +
+		"\t{{- else -}}\n" +
+		"\t{{- $txt := txtsFromFKey $.Tables $table .}}\n" +
+		"\n" +
+		"\tif depth < factoryMaxDepth && {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}factoryIsZero(o.{{$col.LocalColumnGo}}){{end}} {\n" +
+		"\t\tparent, err := Factory.{{$txt.ForeignTable.NameGo}}().create(exec, depth+1)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn nil, err\n" +
+		"\t\t}\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\to.{{.LocalAssignment}} = parent.{{.ForeignAssignment}}\n" +
+		"\t\t{{end -}}\n" +
+		"\t}\n" +
+		"\t{{- end -}}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif err = o.Insert(exec); err != nil {\n" +
+		"\t\treturn nil, err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn o, nil\n" +
+		"}\n" +
+		"{{- end}}\n" +
+		"{{- end}}\n",
+	"templates/singleton/boil_fixtures.tpl": "// FixtureTables describes the tables of the package for the fixtures package\n" +
+		"var FixtureTables = []fixtures.Table{\n" +
+		"\t{{- range $table := .Tables}}\n" +
+		"\t{{- if not $table.IsView}}\n" +
+		"\t{{- $alias := $table.Name | singular | titleCase}}\n" +
+		"\t{\n" +
+		"\t\tName: \"{{$table.Name}}\",\n" +
+		"\t\t{{- if $table.FKeys}}\n" +
+		"\t\tForeignKeys: []fixtures.ForeignKey{\n" +
+		"\t\t\t{{- range $fkey := $table.FKeys}}\n" +
+		"\t\t\t{\n" +
+		"\t\t\t\tColumns: []string{ {{- $fkey.Columns | stringMap $.StringFuncs.quoteWrap | join \", \" -}} }, ForeignTable: \"{{$fkey.ForeignTable}}\", ForeignColumns: []string{ {{- $fkey.ForeignColumns | stringMap $.StringFuncs.quoteWrap | join \", \" -}} },\n" +
+		"\t\t\t\t{{- if $fkey.IsComposite}}\n" +
+		"\t\t\t\tRef: \"{{$fkey.ForeignTable | singular}}\",\n" +
+		"\t\t\t\t{{- end}}\n" +
+		"\t\t\t},\n" +
+		"\t\t\t{{- end}}\n" +
+		"\t\t},\n" +
+		"\t\t{{- end}}\n" +
+		"\t\t{{- if $table.IsJoinTable}}\n" +
+		"\t\tInsert: func(exec boil.Executor, row fixtures.Row) (fixtures.Row, error) {\n" +
+		"\t\t\treturn fixtures.InsertRaw(exec, dialect, \"{{$.SchemaTable $table.Name}}\", row)\n" +
+		"\t\t},\n" +
+		"\t\tClean: func(exec boil.Executor) error {\n" +
+		"\t\t\t_, err := exec.Exec(\"DELETE FROM {{$.SchemaTable $table.Name}}\")\n" +
+		"\t\t\treturn err\n" +
+		"\t\t},\n" +
+		"\t\t{{- else}}\n" +
+		"\t\tInsert: func(exec boil.Executor, row fixtures.Row) (fixtures.Row, error) {\n" +
+		"\t\t\to := &{{$alias}}{}\n" +
+		"\t\t\tif err := fixtures.Bind(row, o); err != nil {\n" +
+		"\t\t\t\treturn nil, err\n" +
+		"\t\t\t}\n" +
+		"\t\t\tif err := o.Insert(exec); err != nil {\n" +
+		"\t\t\t\treturn nil, err\n" +
+		"\t\t\t}\n" +
+		"\t\t\treturn fixtures.Values(o), nil\n" +
+		"\t\t},\n" +
+		"\t\tClean: func(exec boil.Executor) error {\n" +
+		"\t\t\t{{- if $.RowsAffected}}\n" +
+		"\t\t\t_, err := {{$table.Name | plural | titleCase}}(exec).DeleteAll()\n" +
+		"\t\t\treturn err\n" +
+		"\t\t\t{{- else}}\n" +
+		"\t\t\treturn {{$table.Name | plural | titleCase}}(exec).DeleteAll()\n" +
+		"\t\t\t{{- end}}\n" +
+		"\t\t},\n" +
+		"\t\t{{- end}}\n" +
+		"\t},\n" +
+		"\t{{- end}}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"// LoadFixtures inserts the rows of the fixture files at paths, and returns\n" +
+		"// them by label by table.\n" +
+		"func LoadFixtures(exec boil.Executor, paths ...string) (fixtures.Loaded, error) {\n" +
+		"\tset, err := fixtures.Read(paths...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn nil, err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn set.Load(exec, FixtureTables)\n" +
+		"}\n" +
+		"\n" +
+		"// CleanFixtures deletes all the rows of the tables the fixture files at paths\n" +
+		"// have rows for.\n" +
+		"func CleanFixtures(exec boil.Executor, paths ...string) error {\n" +
+		"\tset, err := fixtures.Read(paths...)\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn set.Clean(exec, FixtureTables)\n" +
+		"}\n",
+	"templates/singleton/boil_queries.tpl": "var dialect = queries.Dialect{\n" +
+		"\tLQ: 0x{{printf \"%x\" .Dialect.LQ}},\n" +
+		"\tRQ: 0x{{printf \"%x\" .Dialect.RQ}},\n" +
+		"\tIndexPlaceholders: {{.Dialect.IndexPlaceholders}},\n" +
+		"\tUseTopClause: {{.Dialect.UseTopClause}},\n" +
+		"}\n" +
+		"\n" +
+		"// NewQueryG initializes a new Query using the passed in QueryMods\n" +
+		"func NewQueryG(mods ...qm.QueryMod) *queries.Query {\n" +
+		"\treturn NewQuery(boil.GetDB(), mods...)\n" +
+		"}\n" +
+		"\n" +
+		"// NewQuery initializes a new Query using the passed in QueryMods\n" +
+		"func NewQuery(exec boil.Executor, mods ...qm.QueryMod) *queries.Query {\n" +
+		"\tq := &queries.Query{}\n" +
+		"\tqueries.SetExecutor(q, exec)\n" +
+		"\tqueries.SetDialect(q, &dialect)\n" +
+		"\tqm.Apply(q, mods...)\n" +
+		"\n" +
+		"\treturn q\n" +
+		"}\n" +
+		"{{- if .RuntimeSchema}}\n" +
+		"\n" +
+		"// schemaTable quotes a table along with the schema of exec, which is the\n" +
+		"// schema the table was generated from unless exec was bound to another one\n" +
+		"// with boil.WithSchema.\n" +
+		"func schemaTable(exec boil.Executor, schema, table string) string {\n" +
+		"\tschema = boil.SchemaOf(exec, schema)\n" +
+		"\t{{- if and (ne .DriverName \"mssql\") .DriverSchema}}\n" +
+		"\tif schema == \"{{.DriverSchema}}\" {\n" +
+		"\t\treturn string(dialect.LQ) + table + string(dialect.RQ)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\treturn string(dialect.LQ) + schema + string(dialect.RQ) + \".\" + string(dialect.LQ) + table + string(dialect.RQ)\n" +
+		"}\n" +
+		"{{- end}}\n",
+	"templates/singleton/boil_table_names.tpl": "var TableNames = struct {\n" +
+		"\t{{range $table := .Tables -}}\n" +
+		"\t{{titleCase $table.Name}} string\n" +
+		"\t{{end -}}\n" +
+		"}{\n" +
+		"\t{{range $table := .Tables -}}\n" +
+		"\t{{titleCase $table.Name}}: \"{{$table.SQLName}}\",\n" +
+		"\t{{end -}}\n" +
+		"}\n",
+	"templates/singleton/boil_types.tpl": "// M type is for providing columns and column values to UpdateAll.\n" +
+		"type M map[string]interface{}\n" +
+		"\n" +
+		"// ErrSyncFail occurs during insert when the record could not be retrieved in\n" +
+		"// order to populate default value information. This usually happens when LastInsertId\n" +
+		"// fails or there was a primary key configuration that was not resolvable.\n" +
+		"var ErrSyncFail = errors.New(\"{{.PkgName}}: failed to synchronize data after insert\")\n" +
+		"\n" +
+		"type insertCache struct {\n" +
+		"\tquery        string\n" +
+		"\tretQuery     string\n" +
+		"\tvalueMapping []uint64\n" +
+		"\tretMapping   []uint64\n" +
+		"\t{{- if not .NoValidate}}\n" +
+		"\tcolumns      []string\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"type updateCache struct {\n" +
+		"\tquery        string\n" +
+		"\tvalueMapping []uint64\n" +
+		"\tcolumns      []string\n" +
+		"}\n" +
+		"\n" +
+		"func makeCacheKey(wl, nzDefaults []string) string {\n" +
+		"\tbuf := strmangle.GetBuffer()\n" +
+		"\n" +
+		"\tfor _, w := range wl {\n" +
+		"\t\tbuf.WriteString(w)\n" +
+		"\t}\n" +
+		"\tif len(nzDefaults) != 0 {\n" +
+		"\t\tbuf.WriteByte('.')\n" +
+		"\t}\n" +
+		"\tfor _, nz := range nzDefaults {\n" +
+		"\t\tbuf.WriteString(nz)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tstr := buf.String()\n" +
+		"\tstrmangle.PutBuffer(buf)\n" +
+		"\treturn str\n" +
+		"}\n" +
+		"\n" +
+		"{{/*\n" +
+		"The following is a little bit of black magic and deserves some explanation\n" +
+		"\n" +
+		"Because postgres and mysql define enums completely differently (one at the\n" +
+		"database level as a custom datatype, and one at the table column level as\n" +
+		"a unique thing per table)... There's a chance the enum is named (postgres)\n" +
+		"and not (mysql). So we can't do this per table so this code is here.\n" +
+		"\n" +
+		"We loop through each table and column looking for enums. If it's named, we\n" +
+		"then use some disgusting magic to write state during the template compile to\n" +
+		"the \"once\" map. This lets named enums only be defined once if they're referenced\n" +
+		"multiple times in many (or even the same) tables.\n" +
+		"\n" +
+		"Then we check if all it's values are normal, if they are we create the enum\n" +
+		"output, if not we output a friendly error message as a comment to aid in\n" +
+		"debugging.\n" +
+		"\n" +
+		"Postgres output looks like: EnumNameEnumValue = \"enumvalue\"\n" +
+		"MySQL output looks like:    TableNameColNameEnumValue = \"enumvalue\"\n" +
+		"\n" +
+		"It only titlecases the EnumValue portion if it's snake-cased.\n" +
+		"*/}}\n" +
+		"{{$dot := . -}}\n" +
+		"{{$once := onceNew}}\n" +
+		"{{- range $table := .Tables -}}\n" +
+		"\t{{- range $col := $table.Columns | filterColumnsByEnum -}}\n" +
+		"\t\t{{- $name := parseEnumName $col.DBType -}}\n" +
+		"\t\t{{- $vals := parseEnumVals $col.DBType -}}\n" +
+		"\t\t{{- $isNamed := ne (len $name) 0}}\n" +
+		"\t\t{{- if and $isNamed (onceHas $once $name) -}}\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\t\t{{- if $isNamed -}}\n" +
+		"\t\t\t\t{{$_ := oncePut $once $name}}\n" +
+		"\t\t\t{{- end -}}\n" +
+		"{{- if and (gt (len $vals) 0) (isEnumNormal $vals)}}\n" +
+		"// Enum values for {{if $isNamed}}{{$name}}{{else}}{{$table.Name}}.{{$col.Name}}{{end}}\n" +
+		"const (\n" +
+		"\t{{- range $val := $vals -}}\n" +
+		"\t{{- if $isNamed}}{{titleCase $name}}{{else}}{{titleCase $table.Name}}{{titleCase $col.Name}}{{end -}}\n" +
+		"\t{{if shouldTitleCaseEnum $val}}{{titleCase $val}}{{else}}{{$val}}{{end}} = \"{{$val}}\"\n" +
+		"\t{{end -}}\n" +
+		")\n" +
+		"{{- else}}\n" +
+		"// Enum values for {{if $isNamed}}{{$name}}{{else}}{{$table.Name}}.{{$col.Name}}{{end}} are not proper Go identifiers, cannot emit constants\n" +
+		"{{- end -}}\n" +
+		"\t\t{{- end -}}\n" +
+		"\t{{- end -}}\n" +
+		"{{- end -}}\n",
+	"templates_test/all.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tquery := {{$tableNamePlural}}(nil)\n" +
+		"\n" +
+		"\tif query.Query == nil {\n" +
+		"\t\tt.Error(\"expected a query, got nothing\")\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/delete.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}Delete(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tif rowsAff, err := {{$varNameSingular}}.Delete(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t} else if rowsAff != 1 {\n" +
+		"\t\tt.Error(\"should only have deleted one row, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = {{$varNameSingular}}.Delete(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif count != before {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before, count)\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}QueryDeleteAll(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tif rowsAff, err := {{$tableNamePlural}}(tx).DeleteAll(); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t} else if rowsAff != before+1 {\n" +
+		"\t\tt.Errorf(\"should have deleted %d rows, but affected: %d\", before+1, rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = {{$tableNamePlural}}(tx).DeleteAll(); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif count != 0 {\n" +
+		"\t\tt.Error(\"want zero records, got:\", count)\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}SliceDeleteAll(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tslice := {{$tableNameSingular}}Slice{{\"{\"}}{{$varNameSingular}}{{\"}\"}}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tif rowsAff, err := slice.DeleteAll(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t} else if rowsAff != 1 {\n" +
+		"\t\tt.Error(\"should only have deleted one row, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = slice.DeleteAll(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif count != before {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before, count)\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/exists.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}Exists(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{$pkeyArgs := .Table.PKey.Columns | stringMap .StringFuncs.titleCase | prefixStringSlice (printf \"%s.\" $varNameSingular) | join \", \" -}}\n" +
+		"\te, err := {{$tableNameSingular}}Exists(tx, {{$pkeyArgs}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to check if {{$tableNameSingular}} exists: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !e {\n" +
+		"\t\tt.Errorf(\"Expected {{$tableNameSingular}}ExistsG to return true, but got false.\")\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/factory.tpl": "{{- $dot := . -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"// {{$varNameSingular}}Parents points the foreign keys of a randomized {{$tableNameSingular}}\n" +
+		"// at rows that exist before the tests write it. A required key that refers to\n" +
+		"// no row gets a parent from the factories, a nullable one is set to null.\n" +
+		"{{- if not .TestTransactions}}\n" +
+		"// The test database is a copy of the schema without foreign keys, so there\n" +
+		"// is nothing to do.\n" +
+		"{{- end}}\n" +
+		"func {{$varNameSingular}}Parents(exec boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t{{- $checked := false}}\n" +
+		"\t{{- if .TestTransactions}}\n" +
+		"\t{{- range .Table.FKeys}}\n" +
+		"\t{{- $foreignTable := getTable $dot.Tables .ForeignTable}}\n" +
+		"\t{{- if or $foreignTable.IsJoinTable $foreignTable.IsView (and (eq .ForeignTable $dot.Table.Name) (not .Nullable))}}\n" +
+		"\t{{- else}}\n" +
+		"\t{{- $txt := txtsFromFKey $dot.Tables $dot.Table .}}\n" +
+		"\t{{- $checked = true}}\n" +
+		"\tif exists, err := o.{{$txt.Function.Name}}(exec).Exists(); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t} else if !exists {\n" +
+		"\t\t{{- if .Nullable}}\n" +
+		"\t\t{{- range $txt.Columns}}\n" +
+		"\t\t{{- if .LocalNullable}}\n" +
+		"\t\to.{{.LocalColumnGo}}.Valid = false\n" +
+		"\t\t{{- end}}\n" +
+		"\t\t{{- end}}\n" +
+		"\t\t{{- else}}\n" +
+		"\t\tparent, err := Factory.{{$txt.ForeignTable.NameGo}}().Create(exec)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t\t{{- range $txt.Columns}}\n" +
+		"\t\to.{{.LocalAssignment}} = parent.{{.ForeignAssignment}}\n" +
+		"\t\t{{- end}}\n" +
+		"\t\t{{- end}}\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\t{{- end}}\n" +
+		"\t{{- end}}\n" +
+		"\t{{- if $checked}}\n" +
+		"\t{{end}}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}Factory(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\to, err := Factory.{{$tableNameSingular}}().Create(tx)\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\texists, err := {{$tableNameSingular}}Exists(tx, {{.Table.PKey.Columns | stringMap .StringFuncs.titleCase | prefixStringSlice \"o.\" | join \", \"}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif !exists {\n" +
+		"\t\tt.Error(\"want the created {{$tableNameSingular}} to exist\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tbuilt, err := Factory.{{$tableNameSingular}}(func(o *{{$tableNameSingular}}) { *o = {{$tableNameSingular}}{} }).Build()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(built, &{{$tableNameSingular}}{}) {\n" +
+		"\t\tt.Errorf(\"want the override applied last, got: %#v\", built)\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/find.tpl": "{{- $dot := . -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}Find(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{$varNameSingular}}Found, err := Find{{$tableNameSingular}}(tx, {{.Table.PKey.Columns | stringMap .StringFuncs.titleCase | prefixStringSlice (printf \"%s.\" $varNameSingular) | join \", \"}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif {{$varNameSingular}}Found == nil {\n" +
+		"\t\tt.Error(\"want a record, got nil\")\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{range $ukey := .Table.UKeys -}}\n" +
+		"{{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join \"And\" -}}\n" +
+		"{{- $keyArgs := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | prefixStringSlice (printf \"%s.\" $varNameSingular) | join \", \"}}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}FindBy{{$keyName}}(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{$varNameSingular}}Found, err := Find{{$tableNameSingular}}By{{$keyName}}(tx, {{$keyArgs}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif {{$varNameSingular}}Found == nil {\n" +
+		"\t\tt.Error(\"want a record, got nil\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\te, err := {{$tableNameSingular}}ExistsBy{{$keyName}}(tx, {{$keyArgs}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif !e {\n" +
+		"\t\tt.Error(\"want {{$tableNameSingular}}ExistsBy{{$keyName}} to return true, but got false.\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if gt (len $ukey.Columns) 1 -}}\n" +
+		"\tslice, err := Find{{$tableNameSingular}}SliceBy{{$keyName}}(tx, {{$tableNameSingular}}{{$keyName}}Key{\n" +
+		"\t\t{{range $col := $ukey.Columns -}}\n" +
+		"\t\t{{titleCase $col}}: {{$varNameSingular}}.{{titleCase $col}},\n" +
+		"\t\t{{end -}}\n" +
+		"\t})\n" +
+		"\t{{- else -}}\n" +
+		"\tslice, err := Find{{$tableNameSingular}}SliceBy{{$keyName}}(tx, {{$keyArgs}})\n" +
+		"\t{{- end}}\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif len(slice) != 1 {\n" +
+		"\t\tt.Error(\"want one record, got:\", len(slice))\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{end}}\n",
+	"templates_test/finishers.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}Bind(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$tableNamePlural}}(tx).Bind({{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}One(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif x, err := {{$tableNamePlural}}(tx).One(); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t} else if x == nil {\n" +
+		"\t\tt.Error(\"expected to get a non nil record\")\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}All(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}}One := &{{$tableNameSingular}}{}\n" +
+		"\t{{$varNameSingular}}Two := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}One, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}Two, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}One); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}One.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}Two); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Two.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tslice, err := {{$tableNamePlural}}(tx).All()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif len(slice) != int(before)+2 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+2, len(slice))\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}Count(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\t{{$varNameSingular}}One := &{{$tableNameSingular}}{}\n" +
+		"\t{{$varNameSingular}}Two := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}One, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}Two, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}One); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}One.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}Two); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Two.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif count != before+2 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+2, count)\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/hooks.tpl": "{{- if not .NoHooks -}}\n" +
+		"{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func {{$varNameSingular}}BeforeInsertHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}AfterInsertHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}AfterSelectHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}BeforeUpdateHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}AfterUpdateHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}BeforeDeleteHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}AfterDeleteHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}BeforeUpsertHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}AfterUpsertHook(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t*o = {{$tableNameSingular}}{}\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func {{$varNameSingular}}BulkHook(e boil.Executor, q *queries.Query, o {{$tableNameSingular}}Slice, cols M) error {\n" +
+		"\tcols[\"hooked\"] = len(o)\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}Hooks(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\tempty := &{{$tableNameSingular}}{}\n" +
+		"\to := &{{$tableNameSingular}}{}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, o, {{$varNameSingular}}DBTypes, false); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} object: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.BeforeInsertHook, {{$varNameSingular}}BeforeInsertHook)\n" +
+		"\tif err = o.doBeforeInsertHooks(boil.SkipHooks(nil)); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doBeforeInsertHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected BeforeInsertHook function to be skipped, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\tif err = o.doBeforeInsertHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doBeforeInsertHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected BeforeInsertHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}BeforeInsertHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.AfterInsertHook, {{$varNameSingular}}AfterInsertHook)\n" +
+		"\tif err = o.doAfterInsertHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doAfterInsertHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected AfterInsertHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}AfterInsertHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.AfterSelectHook, {{$varNameSingular}}AfterSelectHook)\n" +
+		"\tif err = o.doAfterSelectHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doAfterSelectHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected AfterSelectHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}AfterSelectHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.BeforeUpdateHook, {{$varNameSingular}}BeforeUpdateHook)\n" +
+		"\tif err = o.doBeforeUpdateHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doBeforeUpdateHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected BeforeUpdateHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}BeforeUpdateHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.AfterUpdateHook, {{$varNameSingular}}AfterUpdateHook)\n" +
+		"\tif err = o.doAfterUpdateHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doAfterUpdateHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected AfterUpdateHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}AfterUpdateHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.BeforeDeleteHook, {{$varNameSingular}}BeforeDeleteHook)\n" +
+		"\tif err = o.doBeforeDeleteHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doBeforeDeleteHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected BeforeDeleteHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}BeforeDeleteHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.AfterDeleteHook, {{$varNameSingular}}AfterDeleteHook)\n" +
+		"\tif err = o.doAfterDeleteHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doAfterDeleteHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected AfterDeleteHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}AfterDeleteHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.BeforeUpsertHook, {{$varNameSingular}}BeforeUpsertHook)\n" +
+		"\tif err = o.doBeforeUpsertHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doBeforeUpsertHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected BeforeUpsertHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}BeforeUpsertHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.AfterUpsertHook, {{$varNameSingular}}AfterUpsertHook)\n" +
+		"\tif err = o.doAfterUpsertHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doAfterUpsertHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !reflect.DeepEqual(o, empty) {\n" +
+		"\t\tt.Errorf(\"Expected AfterUpsertHook function to empty object, but got: %#v\", o)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}AfterUpsertHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tcommitted, rolledBack := false, false\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.AfterCommitHook, func(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t\tcommitted = true\n" +
+		"\t\treturn nil\n" +
+		"\t})\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.AfterRollbackHook, func(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t\trolledBack = true\n" +
+		"\t\treturn nil\n" +
+		"\t})\n" +
+		"\tif err = o.doAfterInsertHooks(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doAfterInsertHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif !committed || rolledBack {\n" +
+		"\t\tt.Errorf(\"Expected only AfterCommitHook to run outside a transaction, but got commit: %t, rollback: %t\", committed, rolledBack)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcommitted = false\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tif err = o.doAfterInsertHooks(tx); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doAfterInsertHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err = tx.Rollback(); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif committed || rolledBack {\n" +
+		"\t\tt.Errorf(\"Expected no transaction hooks to run in a transaction that does not collect them, but got commit: %t, rollback: %t\", committed, rolledBack)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcbTx := boil.NewCallbackTx(MustTx(boil.Begin()))\n" +
+		"\tif err = o.doAfterInsertHooks(cbTx); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to execute doAfterInsertHooks: %s\", err)\n" +
+		"\t}\n" +
+		"\tif committed || rolledBack {\n" +
+		"\t\tt.Errorf(\"Expected no transaction hooks to run before the transaction ends, but got commit: %t, rollback: %t\", committed, rolledBack)\n" +
+		"\t}\n" +
+		"\tif err = cbTx.Rollback(); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif committed || !rolledBack {\n" +
+		"\t\tt.Errorf(\"Expected only AfterRollbackHook to run once the transaction rolls back, but got commit: %t, rollback: %t\", committed, rolledBack)\n" +
+		"\t}\n" +
+		"\n" +
+		"\trolledBack = false\n" +
+		"\tAdd{{$tableNameSingular}}Hook(boil.AfterInsertHook, func(e boil.Executor, o *{{$tableNameSingular}}) error {\n" +
+		"\t\treturn errTestHook\n" +
+		"\t})\n" +
+		"\tcbTx = boil.NewCallbackTx(MustTx(boil.Begin()))\n" +
+		"\tif err = o.doAfterInsertHooks(cbTx); err != errTestHook {\n" +
+		"\t\tt.Errorf(\"Expected doAfterInsertHooks to fail, but got: %v\", err)\n" +
+		"\t}\n" +
+		"\tif err = cbTx.Rollback(); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif committed || rolledBack {\n" +
+		"\t\tt.Errorf(\"Expected no transaction hooks to run after a failed AfterInsertHook, but got commit: %t, rollback: %t\", committed, rolledBack)\n" +
+		"\t}\n" +
+		"\t{{$varNameSingular}}AfterInsertHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\t{{$varNameSingular}}AfterCommitHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\t{{$varNameSingular}}AfterRollbackHooks = []{{$tableNameSingular}}Hook{}\n" +
+		"\n" +
+		"\tbulkHooks := []*[]{{$tableNameSingular}}BulkHook{\n" +
+		"\t\t&{{$varNameSingular}}BeforeUpdateAllHooks,\n" +
+		"\t\t&{{$varNameSingular}}AfterUpdateAllHooks,\n" +
+		"\t\t&{{$varNameSingular}}BeforeDeleteAllHooks,\n" +
+		"\t\t&{{$varNameSingular}}AfterDeleteAllHooks,\n" +
+		"\t}\n" +
+		"\tbulkPoints := []boil.HookPoint{boil.BeforeUpdateAllHook, boil.AfterUpdateAllHook, boil.BeforeDeleteAllHook, boil.AfterDeleteAllHook}\n" +
+		"\tfor i, hookPoint := range bulkPoints {\n" +
+		"\t\tAdd{{$tableNameSingular}}BulkHook(hookPoint, {{$varNameSingular}}BulkHook)\n" +
+		"\n" +
+		"\t\tcols := M{}\n" +
+		"\t\tif err = do{{$tableNameSingular}}BulkHooks(*bulkHooks[i], boil.SkipHooks(nil), nil, {{$tableNameSingular}}Slice{o}, cols); err != nil {\n" +
+		"\t\t\tt.Errorf(\"Unable to execute do{{$tableNameSingular}}BulkHooks: %s\", err)\n" +
+		"\t\t}\n" +
+		"\t\tif len(cols) != 0 {\n" +
+		"\t\t\tt.Errorf(\"Expected bulk hook %d to be skipped, but got: %#v\", hookPoint, cols)\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tif err = do{{$tableNameSingular}}BulkHooks(*bulkHooks[i], nil, nil, {{$tableNameSingular}}Slice{o}, cols); err != nil {\n" +
+		"\t\t\tt.Errorf(\"Unable to execute do{{$tableNameSingular}}BulkHooks: %s\", err)\n" +
+		"\t\t}\n" +
+		"\t\tif cols[\"hooked\"] != 1 {\n" +
+		"\t\t\tt.Errorf(\"Expected bulk hook %d to be run with the slice, but got: %#v\", hookPoint, cols)\n" +
+		"\t\t}\n" +
+		"\t\t*bulkHooks[i] = []{{$tableNameSingular}}BulkHook{}\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{- end}}\n",
+	"templates_test/insert.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"{{- $parent := . -}}\n" +
+		"func test{{$tableNamePlural}}Insert(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif count != before+1 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+1, count)\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}InsertWhitelist(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx, {{$varNameSingular}}ColumnsWithoutDefault...); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif count != before+1 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+1, count)\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/main_test/mock_main.tpl": "",
+	"templates_test/main_test/mssql_main.tpl": "type mssqlTester struct {\n" +
+		"\tdbConn     *sql.DB\n" +
+		"\tdbName     string\n" +
+		"\thost       string\n" +
+		"\tuser       string\n" +
+		"\tpass       string\n" +
+		"\tsslmode    string\n" +
+		"\tport       int\n" +
+		"\ttestDBName string\n" +
+		"}\n" +
+		"\n" +
+		"func init() {\n" +
+		"\tdbMain = &mssqlTester{}\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mssqlTester) setup() error {\n" +
+		"\tvar err error\n" +
+		"\tm.dbName = viper.GetString(\"mssql.dbname\")\n" +
+		"\tm.host = viper.GetString(\"mssql.host\")\n" +
+		"\tm.user = viper.GetString(\"mssql.user\")\n" +
+		"\tm.pass = viper.GetString(\"mssql.pass\")\n" +
+		"\tm.port = viper.GetInt(\"mssql.port\")\n" +
+		"\tm.sslmode = viper.GetString(\"mssql.sslmode\")\n" +
+		"\t// Create a randomized db name.\n" +
+		"\tm.testDBName = randomize.StableDBName(m.dbName)\n" +
+		"\n" +
+		"\tif err = m.dropTestDB(); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\tif err = m.createTestDB(); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcreateCmd := exec.Command(\"sqlcmd\", \"-S\", m.host, \"-U\", m.user, \"-P\", m.pass, \"-d\", m.testDBName)\n" +
+		"\n" +
+		"\tf, err := os.Open(\"tables_schema.sql\")\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to open tables_schema.sql file\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tdefer f.Close()\n" +
+		"\n" +
+		"\tcreateCmd.Stdin = newFKeyDestroyer(rgxMSSQLkey, f)\n" +
+		"\n" +
+		"\tif err = createCmd.Start(); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to start sqlcmd command\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = createCmd.Wait(); err != nil {\n" +
+		"\t\tfmt.Println(err)\n" +
+		"\t\treturn errors.Wrap(err, \"failed to wait for sqlcmd command\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mssqlTester) sslMode(mode string) string {\n" +
+		"\tswitch mode {\n" +
+		"\tcase \"true\":\n" +
+		"\t\treturn \"true\"\n" +
+		"\tcase \"false\":\n" +
+		"\t\treturn \"false\"\n" +
+		"\tdefault:\n" +
+		"\t\treturn \"disable\"\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mssqlTester) createTestDB() error {\n" +
+		"\tsql := fmt.Sprintf(`\n" +
+		"\tCREATE DATABASE %s;\n" +
+		"\tGO\n" +
+		"\tALTER DATABASE %[1]s\n" +
+		"\tSET READ_COMMITTED_SNAPSHOT ON;\n" +
+		"\tGO`, m.testDBName)\n" +
+		"\treturn m.runCmd(sql, \"sqlcmd\", \"-S\", m.host, \"-U\", m.user, \"-P\", m.pass)\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mssqlTester) dropTestDB() error {\n" +
+		"\t// Since MS SQL 2016 it can be done with\n" +
+		"\t// DROP DATABASE [ IF EXISTS ] { database_name | database_snapshot_name } [ ,...n ] [;]\n" +
+		"\tsql := fmt.Sprintf(`\n" +
+		"\tIF EXISTS(SELECT name FROM sys.databases \n" +
+		"\t\tWHERE name = '%s')\n" +
+		"\t\tDROP DATABASE %s\n" +
+		"\tGO`, m.testDBName, m.testDBName)\n" +
+		"\treturn m.runCmd(sql, \"sqlcmd\", \"-S\", m.host, \"-U\", m.user, \"-P\", m.pass)\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mssqlTester) teardown() error {\n" +
+		"\tif m.dbConn != nil {\n" +
+		"\t\tm.dbConn.Close()\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err := m.dropTestDB(); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mssqlTester) runCmd(stdin, command string, args ...string) error {\n" +
+		"\tcmd := exec.Command(command, args...)\n" +
+		"\tcmd.Stdin = strings.NewReader(stdin)\n" +
+		"\n" +
+		"\tstdout := &bytes.Buffer{}\n" +
+		"\tstderr := &bytes.Buffer{}\n" +
+		"\tcmd.Stdout = stdout\n" +
+		"\tcmd.Stderr = stderr\n" +
+		"\tif err := cmd.Run(); err != nil {\n" +
+		"\t\tfmt.Println(\"failed running:\", command, args)\n" +
+		"\t\tfmt.Println(stdout.String())\n" +
+		"\t\tfmt.Println(stderr.String())\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mssqlTester) conn() (*sql.DB, error) {\n" +
+		"\tif m.dbConn != nil {\n" +
+		"\t\treturn m.dbConn, nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\tm.dbConn, err = sql.Open(\"mssql\", drivers.MSSQLBuildQueryString(m.user, m.pass, m.testDBName, m.host, m.port, m.sslmode))\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn nil, err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn m.dbConn, nil\n" +
+		"}\n",
+	"templates_test/main_test/mysql_main.tpl": "type mysqlTester struct {\n" +
+		"\tdbConn *sql.DB\n" +
+		"\n" +
+		"\tdbName\tstring\n" +
+		"\thost\tstring\n" +
+		"\tuser\tstring\n" +
+		"\tpass\tstring\n" +
+		"\tsslmode\tstring\n" +
+		"\tport\tint\n" +
+		"\n" +
+		"\toptionFile string\n" +
+		"\n" +
+		"\ttestDBName string\n" +
+		"}\n" +
+		"\n" +
+		"func init() {\n" +
+		"\tdbMain = &mysqlTester{}\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) setup() error {\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\tm.dbName = viper.GetString(\"mysql.dbname\")\n" +
+		"\tm.host = viper.GetString(\"mysql.host\")\n" +
+		"\tm.user = viper.GetString(\"mysql.user\")\n" +
+		"\tm.pass = viper.GetString(\"mysql.pass\")\n" +
+		"\tm.port = viper.GetInt(\"mysql.port\")\n" +
+		"\tm.sslmode = viper.GetString(\"mysql.sslmode\")\n" +
+		"\t// Create a randomized db name.\n" +
+		"\tm.testDBName = randomize.StableDBName(m.dbName)\n" +
+		"\n" +
+		"\tif err = m.makeOptionFile(); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"couldn't make option file\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = m.dropTestDB(); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\tif err = m.createTestDB(); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\tdumpCmd := exec.Command(\"mysqldump\", m.defaultsFile(), \"--no-data\", m.dbName)\n" +
+		"\tcreateCmd := exec.Command(\"mysql\", m.defaultsFile(), \"--database\", m.testDBName)\n" +
+		"\n" +
+		"\tr, w := io.Pipe()\n" +
+		"\tdumpCmd.Stdout = w\n" +
+		"\tcreateCmd.Stdin = newFKeyDestroyer(rgxMySQLkey, r)\n" +
+		"\n" +
+		"\tif err = dumpCmd.Start(); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to start mysqldump command\")\n" +
+		"\t}\n" +
+		"\tif err = createCmd.Start(); err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to start mysql command\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = dumpCmd.Wait(); err != nil {\n" +
+		"\t\tfmt.Println(err)\n" +
+		"\t\treturn errors.Wrap(err, \"failed to wait for mysqldump command\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tw.Close() // After dumpCmd is done, close the write end of the pipe\n" +
+		"\n" +
+		"\tif err = createCmd.Wait(); err != nil {\n" +
+		"\t\tfmt.Println(err)\n" +
+		"\t\treturn errors.Wrap(err, \"failed to wait for mysql command\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) sslMode(mode string) string {\n" +
+		"\tswitch mode {\n" +
+		"\tcase \"true\":\n" +
+		"\t\treturn \"REQUIRED\"\n" +
+		"\tcase \"false\":\n" +
+		"\t\treturn \"DISABLED\"\n" +
+		"\tdefault:\n" +
+		"\t\treturn \"PREFERRED\"\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) defaultsFile() string {\n" +
+		"\treturn fmt.Sprintf(\"--defaults-file=%s\", m.optionFile)\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) makeOptionFile() error {\n" +
+		"\ttmp, err := ioutil.TempFile(\"\", \"optionfile\")\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"failed to create option file\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tisTCP := false\n" +
+		"\t_, err = os.Stat(m.host)\n" +
+		"\tif os.IsNotExist(err) {\n" +
+		"\t\tisTCP = true\n" +
+		"\t} else if err != nil {\n" +
+		"\t\treturn errors.Wrap(err, \"could not stat m.host\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfmt.Fprintln(tmp, \"[client]\")\n" +
+		"\tfmt.Fprintf(tmp, \"host=%s\\n\", m.host)\n" +
+		"\tfmt.Fprintf(tmp, \"port=%d\\n\", m.port)\n" +
+		"\tfmt.Fprintf(tmp, \"user=%s\\n\", m.user)\n" +
+		"\tfmt.Fprintf(tmp, \"password=%s\\n\", m.pass)\n" +
+		"\tfmt.Fprintf(tmp, \"ssl-mode=%s\\n\", m.sslMode(m.sslmode))\n" +
+		"\tif isTCP {\n" +
+		"\t\tfmt.Fprintln(tmp, \"protocol=tcp\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfmt.Fprintln(tmp, \"[mysqldump]\")\n" +
+		"\tfmt.Fprintf(tmp, \"host=%s\\n\", m.host)\n" +
+		"\tfmt.Fprintf(tmp, \"port=%d\\n\", m.port)\n" +
+		"\tfmt.Fprintf(tmp, \"user=%s\\n\", m.user)\n" +
+		"\tfmt.Fprintf(tmp, \"password=%s\\n\", m.pass)\n" +
+		"\tfmt.Fprintf(tmp, \"ssl-mode=%s\\n\", m.sslMode(m.sslmode))\n" +
+		"\tif isTCP {\n" +
+		"\t\tfmt.Fprintln(tmp, \"protocol=tcp\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tm.optionFile = tmp.Name()\n" +
+		"\n" +
+		"\treturn tmp.Close()\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) createTestDB() error {\n" +
+		"\tsql := fmt.Sprintf(\"create database %s;\", m.testDBName)\n" +
+		"\treturn m.runCmd(sql, \"mysql\")\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) dropTestDB() error {\n" +
+		"\tsql := fmt.Sprintf(\"drop database if exists %s;\", m.testDBName)\n" +
+		"\treturn m.runCmd(sql, \"mysql\")\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) teardown() error {\n" +
+		"\tif m.dbConn != nil {\n" +
+		"\t\tm.dbConn.Close()\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err := m.dropTestDB(); err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn os.Remove(m.optionFile)\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) runCmd(stdin, command string, args ...string) error {\n" +
+		"\targs = append([]string{m.defaultsFile()}, args...)\n" +
+		"\n" +
+		"\tcmd := exec.Command(command, args...)\n" +
+		"\tcmd.Stdin = strings.NewReader(stdin)\n" +
+		"\n" +
+		"\tstdout := &bytes.Buffer{}\n" +
+		"\tstderr := &bytes.Buffer{}\n" +
+		"\tcmd.Stdout = stdout\n" +
+		"\tcmd.Stderr = stderr\n" +
+		"\tif err := cmd.Run(); err != nil {\n" +
+		"\tfmt.Println(\"failed running:\", command, args)\n" +
+		"\tfmt.Println(stdout.String())\n" +
+		"\tfmt.Println(stderr.String())\n" +
+		"\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"func (m *mysqlTester) conn() (*sql.DB, error) {\n" +
+		"\tif m.dbConn != nil {\n" +
+		"\treturn m.dbConn, nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\tm.dbConn, err = sql.Open(\"mysql\", drivers.MySQLBuildQueryString(m.user, m.pass, m.testDBName, m.host, m.port, m.sslmode))\n" +
+		"\tif err != nil {\n" +
+		"\treturn nil, err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn m.dbConn, nil\n" +
+		"}\n",
+	"templates_test/main_test/postgres_main.tpl": "type pgTester struct {\n" +
+		"  dbConn *sql.DB\n" +
+		"\n" +
+		"  dbName  string\n" +
+		"  host    string\n" +
+		"  user    string\n" +
+		"  pass    string\n" +
+		"  sslmode string\n" +
+		"  port    int\n" +
+		"\n" +
+		"  pgPassFile string\n" +
+		"\n" +
+		"  testDBName string\n" +
+		"}\n" +
+		"\n" +
+		"func init() {\n" +
+		"  dbMain = &pgTester{}\n" +
+		"}\n" +
+		"\n" +
+		"// setup dumps the database schema and imports it into a temporary randomly\n" +
+		"// generated test database so that tests can be run against it using the\n" +
+		"// generated sqlboiler ORM package.\n" +
+		"func (p *pgTester) setup() error {\n" +
+		"  var err error\n" +
+		"\n" +
+		"  p.dbName = viper.GetString(\"postgres.dbname\")\n" +
+		"  p.host = viper.GetString(\"postgres.host\")\n" +
+		"  p.user = viper.GetString(\"postgres.user\")\n" +
+		"  p.pass = viper.GetString(\"postgres.pass\")\n" +
+		"  p.port = viper.GetInt(\"postgres.port\")\n" +
+		"  p.sslmode = viper.GetString(\"postgres.sslmode\")\n" +
+		"  // Create a randomized db name.\n" +
+		"  p.testDBName = randomize.StableDBName(p.dbName)\n" +
+		"\n" +
+		"  if err = p.makePGPassFile(); err != nil {\n" +
+		"    return err\n" +
+		"  }\n" +
+		"\n" +
+		"  if err = p.dropTestDB(); err != nil {\n" +
+		"    return err\n" +
+		"  }\n" +
+		"  if err = p.createTestDB(); err != nil {\n" +
+		"    return err\n" +
+		"  }\n" +
+		"\n" +
+		"  dumpCmd := exec.Command(\"pg_dump\", \"--schema-only\", p.dbName)\n" +
+		"  dumpCmd.Env = append(os.Environ(), p.pgEnv()...)\n" +
+		"  createCmd := exec.Command(\"psql\", p.testDBName)\n" +
+		"  createCmd.Env = append(os.Environ(), p.pgEnv()...)\n" +
+		"\n" +
+		"  r, w := io.Pipe()\n" +
+		"  dumpCmd.Stdout = w\n" +
+		"  createCmd.Stdin = newFKeyDestroyer(rgxPGFkey, r)\n" +
+		"\n" +
+		"  if err = dumpCmd.Start(); err != nil {\n" +
+		"    return errors.Wrap(err, \"failed to start pg_dump command\")\n" +
+		"  }\n" +
+		"  if err = createCmd.Start(); err != nil {\n" +
+		"    return errors.Wrap(err, \"failed to start psql command\")\n" +
+		"  }\n" +
+		"\n" +
+		"  if err = dumpCmd.Wait(); err != nil {\n" +
+		"    fmt.Println(err)\n" +
+		"    return errors.Wrap(err, \"failed to wait for pg_dump command\")\n" +
+		"  }\n" +
+		"\n" +
+		"  w.Close() // After dumpCmd is done, close the write end of the pipe\n" +
+		"\n" +
+		"  if err = createCmd.Wait(); err != nil {\n" +
+		"    fmt.Println(err)\n" +
+		"    return errors.Wrap(err, \"failed to wait for psql command\")\n" +
+		"  }\n" +
+		"\n" +
+		"  return nil\n" +
+		"}\n" +
+		"\n" +
+		"func (p *pgTester) runCmd(stdin, command string, args ...string) error {\n" +
+		"  cmd := exec.Command(command, args...)\n" +
+		"  cmd.Env = append(os.Environ(), p.pgEnv()...)\n" +
+		"\n" +
+		"  if len(stdin) != 0 {\n" +
+		"    cmd.Stdin = strings.NewReader(stdin)\n" +
+		"  }\n" +
+		"\n" +
+		"  stdout := &bytes.Buffer{}\n" +
+		"  stderr := &bytes.Buffer{}\n" +
+		"  cmd.Stdout = stdout\n" +
+		"  cmd.Stderr = stderr\n" +
+		"  if err := cmd.Run(); err != nil {\n" +
+		"    fmt.Println(\"failed running:\", command, args)\n" +
+		"    fmt.Println(stdout.String())\n" +
+		"    fmt.Println(stderr.String())\n" +
+		"    return err\n" +
+		"  }\n" +
+		"\n" +
+		"  return nil\n" +
+		"}\n" +
+		"\n" +
+		"func (p *pgTester) pgEnv() []string {\n" +
+		"  return []string{\n" +
+		"    fmt.Sprintf(\"PGHOST=%s\", p.host),\n" +
+		"    fmt.Sprintf(\"PGPORT=%d\", p.port),\n" +
+		"    fmt.Sprintf(\"PGUSER=%s\", p.user),\n" +
+		"    fmt.Sprintf(\"PGPASSFILE=%s\", p.pgPassFile),\n" +
+		"  }\n" +
+		"}\n" +
+		"\n" +
+		"func (p *pgTester) makePGPassFile() error {\n" +
+		"  tmp, err := ioutil.TempFile(\"\", \"pgpass\")\n" +
+		"  if err != nil {\n" +
+		"    return errors.Wrap(err, \"failed to create option file\")\n" +
+		"  }\n" +
+		"\n" +
+		"  fmt.Fprintf(tmp, \"%s:%d:postgres:%s\", p.host, p.port, p.user)\n" +
+		"  if len(p.pass) != 0 {\n" +
+		"    fmt.Fprintf(tmp, \":%s\", p.pass)\n" +
+		"  }\n" +
+		"  fmt.Fprintln(tmp)\n" +
+		"\n" +
+		"  fmt.Fprintf(tmp, \"%s:%d:%s:%s\", p.host, p.port, p.dbName, p.user)\n" +
+		"  if len(p.pass) != 0 {\n" +
+		"    fmt.Fprintf(tmp, \":%s\", p.pass)\n" +
+		"  }\n" +
+		"  fmt.Fprintln(tmp)\n" +
+		"\n" +
+		"  fmt.Fprintf(tmp, \"%s:%d:%s:%s\", p.host, p.port, p.testDBName, p.user)\n" +
+		"  if len(p.pass) != 0 {\n" +
+		"    fmt.Fprintf(tmp, \":%s\", p.pass)\n" +
+		"  }\n" +
+		"  fmt.Fprintln(tmp)\n" +
+		"\n" +
+		"  p.pgPassFile = tmp.Name()\n" +
+		"  return tmp.Close()\n" +
+		"}\n" +
+		"\n" +
+		"func (p *pgTester) createTestDB() error {\n" +
+		"  return p.runCmd(\"\", \"createdb\", p.testDBName)\n" +
+		"}\n" +
+		"\n" +
+		"func (p *pgTester) dropTestDB() error {\n" +
+		"  return p.runCmd(\"\", \"dropdb\", \"--if-exists\", p.testDBName)\n" +
+		"}\n" +
+		"\n" +
+		"// teardown executes cleanup tasks when the tests finish running\n" +
+		"func (p *pgTester) teardown() error {\n" +
+		"  var err error\n" +
+		"  if err = p.dbConn.Close(); err != nil {\n" +
+		"    return err\n" +
+		"  }\n" +
+		"  p.dbConn = nil\n" +
+		"\n" +
+		"  if err = p.dropTestDB(); err != nil {\n" +
+		"    return err\n" +
+		"  }\n" +
+		"\n" +
+		"  return os.Remove(p.pgPassFile)\n" +
+		"}\n" +
+		"\n" +
+		"func (p *pgTester) conn() (*sql.DB, error) {\n" +
+		"  if p.dbConn != nil {\n" +
+		"    return p.dbConn, nil\n" +
+		"  }\n" +
+		"\n" +
+		"  var err error\n" +
+		"  p.dbConn, err = sql.Open(\"postgres\", drivers.PostgresBuildQueryString(p.user, p.pass, p.testDBName, p.host, p.port, p.sslmode))\n" +
+		"  if err != nil {\n" +
+		"    return nil, err\n" +
+		"  }\n" +
+		"\n" +
+		"  return p.dbConn, nil\n" +
+		"}\n" +
+		"\n",
+	"templates_test/main_test/tx_main.tpl": "{{- $builder := \"MSSQL\" -}}\n" +
+		"{{- if eq .DriverName \"postgres\"}}{{$builder = \"Postgres\"}}{{else if eq .DriverName \"mysql\"}}{{$builder = \"MySQL\"}}{{end -}}\n" +
+		"type txTester struct {\n" +
+		"\tdbConn *sql.DB\n" +
+		"}\n" +
+		"\n" +
+		"func init() {\n" +
+		"\tdbMain = &txTester{}\n" +
+		"}\n" +
+		"\n" +
+		"// setup only checks that the database can be reached. The tests run on the\n" +
+		"// configured database as it is, each in a transaction that it rolls back, so\n" +
+		"// there is no copy of the schema to make and the foreign keys stay enforced.\n" +
+		"// Rows already in the tables are left alone, the tests count theirs on top of\n" +
+		"// them, but foreign keys to them can keep QueryDeleteAll from deleting.\n" +
+		"func (t *txTester) setup() error {\n" +
+		"\tconn, err := t.conn()\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn conn.Ping()\n" +
+		"}\n" +
+		"\n" +
+		"// teardown closes the connection, the tests leave nothing behind\n" +
+		"func (t *txTester) teardown() error {\n" +
+		"\tif t.dbConn == nil {\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\terr := t.dbConn.Close()\n" +
+		"\tt.dbConn = nil\n" +
+		"\treturn err\n" +
+		"}\n" +
+		"\n" +
+		"// conn connects to {{.DriverName}}.testdbname, or {{.DriverName}}.dbname when it\n" +
+		"// is not set, so the tests can use another database than the one the package\n" +
+		"// was generated from.\n" +
+		"func (t *txTester) conn() (*sql.DB, error) {\n" +
+		"\tif t.dbConn != nil {\n" +
+		"\t\treturn t.dbConn, nil\n" +
+		"\t}\n" +
+		"\n" +
+		"\tdbName := viper.GetString(\"{{.DriverName}}.testdbname\")\n" +
+		"\tif len(dbName) == 0 {\n" +
+		"\t\tdbName = viper.GetString(\"{{.DriverName}}.dbname\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\tt.dbConn, err = sql.Open(\"{{.DriverName}}\", drivers.{{$builder}}BuildQueryString(\n" +
+		"\t\tviper.GetString(\"{{.DriverName}}.user\"),\n" +
+		"\t\tviper.GetString(\"{{.DriverName}}.pass\"),\n" +
+		"\t\tdbName,\n" +
+		"\t\tviper.GetString(\"{{.DriverName}}.host\"),\n" +
+		"\t\tviper.GetInt(\"{{.DriverName}}.port\"),\n" +
+		"\t\tviper.GetString(\"{{.DriverName}}.sslmode\"),\n" +
+		"\t))\n" +
+		"\tif err != nil {\n" +
+		"\t\treturn nil, err\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn t.dbConn, nil\n" +
+		"}\n",
+	"templates_test/marshal.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}Marshal(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\trandom{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, random{{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t/////////////////////// Test Models\n" +
+		"\n" +
+		"\t// Ignore default ID exclusion, testing MarshalJSONStruct rather than json.Marshal\n" +
+		"\tmarshalFilter{{$varNameSingular}} := []byte{}\n" +
+		"\tif marshalFilter{{$varNameSingular}}, err = random{{$varNameSingular}}.MarshalJSONFilter(nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to marshal struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Unmarshal with ID\n" +
+		"\tunmarshalFilter{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\terr = json.Unmarshal(marshalFilter{{$varNameSingular}}, &unmarshalFilter{{$varNameSingular}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Errorf(\"Couldn't unmarshal struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Check if Unmarshaled struct is equal to original\n" +
+		"\tif !reflect.DeepEqual(unmarshalFilter{{$varNameSingular}}, random{{$varNameSingular}}){\n" +
+		"\t\tt.Errorf(\"Unmarshaled struct is not equal to original: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t/////////////////////// Test Embedded Structs \n" +
+		"\n" +
+		"\tembedded{{$varNameSingular}} := &embedded{{$tableNameSingular}}{\n" +
+		"\t\t{{$tableNameSingular}}: unmarshalFilter{{$varNameSingular}},\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Marshal embedded struct\n" +
+		"\tmarshalEmbedded{{$varNameSingular}} := []byte{}\n" +
+		"\tmarshalEmbedded{{$varNameSingular}}, err = json.Marshal(embedded{{$varNameSingular}})\n" +
+		"\n" +
+		"\t// Unmarshal embedded struct\n" +
+		"\tunmarshalEmbedded{{$varNameSingular}} := &embedded{{$tableNameSingular}}{}\n" +
+		"\tjson.Unmarshal(marshalEmbedded{{$varNameSingular}}, unmarshalEmbedded{{$varNameSingular}})\n" +
+		"\n" +
+		"\tif !reflect.DeepEqual(embedded{{$varNameSingular}} , unmarshalEmbedded{{$varNameSingular}}) {\n" +
+		"\t\tt.Errorf(\"Unmarshaled embedded object is not equal: %+v != %+v\", embedded{{$varNameSingular}}.{{$tableNameSingular}}, unmarshalEmbedded{{$varNameSingular}}.{{$tableNameSingular}})\n" +
+		"\t}\n" +
+		"\n" +
+		"}\n" +
+		"\n" +
+		"///////////////////// Test embedding models\n" +
+		"\n" +
+		"type embedded{{$tableNameSingular}} struct{\n" +
+		"\t*{{$tableNameSingular}}\n" +
+		"}\n" +
+		"\n" +
+		"func (o embedded{{$tableNameSingular}}) MarshalJSON() ([]byte, error) {\n" +
+		"\treturn marshal.ToJSON(o, nil)\n" +
+		"}\n" +
+		"\n" +
+		"func (o embedded{{$tableNameSingular}}) UnmarshalJSON(data []byte) error {\n" +
+		"\treturn marshal.UnmarshalWrapper(o, data, nil)\n" +
+		"}\n",
+	"templates_test/relationship_one_to_one.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.ToOneRelationships -}}\n" +
+		"\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t{{- else -}}\n" +
+		"\t\t{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $varNameSingular := .Table | singular | camelCase -}}\n" +
+		"\t\t{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase}}\n" +
+		"func test{{$txt.LocalTable.NameGo}}OneToOne{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar foreign {{$txt.ForeignTable.NameGo}}\n" +
+		"\tvar local {{$txt.LocalTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err := randomize.Struct(seed, &foreign, {{$foreignVarNameSingular}}DBTypes, true, {{$foreignVarNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$txt.ForeignTable.NameGo}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err := randomize.Struct(seed, &local, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$txt.LocalTable.NameGo}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\t{{if .ForeignNullable -}}\n" +
+		"\tforeign.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\t{{end -}}\n" +
+		"\t{{if .LocalNullable -}}\n" +
+		"\tlocal.{{.LocalColumnGo}}.Valid = true\n" +
+		"\t{{end -}}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\tif err := {{$varNameSingular}}Parents(tx, &local); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := local.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\tforeign.{{.ForeignAssignment}} = local.{{.LocalAssignment}}\n" +
+		"\t{{end -}}\n" +
+		"\tif err := {{$foreignVarNameSingular}}Parents(tx, &foreign); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := foreign.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcheck, err := local.{{$txt.Function.Name}}(tx).One()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\t{{if .UsesBytes -}}\n" +
+		"\tif 0 != bytes.Compare(check.{{.ForeignAssignment}}, foreign.{{.ForeignAssignment}}) {\n" +
+		"\t{{else -}}\n" +
+		"\tif check.{{.ForeignAssignment}} != foreign.{{.ForeignAssignment}} {\n" +
+		"\t{{end -}}\n" +
+		"\t\tt.Errorf(\"want: %v, got %v\", foreign.{{.ForeignAssignment}}, check.{{.ForeignAssignment}})\n" +
+		"\t}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\tslice := {{$txt.LocalTable.NameGo}}Slice{&local}\n" +
+		"\tif err = local.L.Load{{$txt.Function.Name}}(tx, false, (*[]*{{$txt.LocalTable.NameGo}})(&slice)); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif local.R.{{$txt.Function.Name}} == nil {\n" +
+		"\t\tt.Error(\"struct should have been eager loaded\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tlocal.R.{{$txt.Function.Name}} = nil\n" +
+		"\tif err = local.L.Load{{$txt.Function.Name}}(tx, true, &local); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif local.R.{{$txt.Function.Name}} == nil {\n" +
+		"\t\tt.Error(\"struct should have been eager loaded\")\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"{{end -}}{{/* if foreign view */}}\n" +
+		"{{- end -}}{{/* range */}}\n" +
+		"{{- end -}}{{/* join table */}}\n",
+	"templates_test/relationship_one_to_one_setops.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.ToOneRelationships -}}\n" +
+		"\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t{{- else -}}\n" +
+		"\t\t{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table .}}\n" +
+		"{{- $varNameSingular := .Table | singular | camelCase -}}\n" +
+		"{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase -}}\n" +
+		"{{- $foreignPKeyCols := (getTable $dot.Tables .ForeignTable).PKey.Columns}}\n" +
+		"func test{{$txt.LocalTable.NameGo}}OneToOneSetOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar a {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar b, c {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, &a, {{$varNameSingular}}DBTypes, false, strmangle.SetComplement({{$varNameSingular}}PrimaryKeyColumns, {{$varNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = randomize.Struct(seed, &b, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = randomize.Struct(seed, &c, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := a.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = b.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$foreignVarNameSingular}}Parents(tx, &c); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor i, x := range []*{{$txt.ForeignTable.NameGo}}{&b, &c} {\n" +
+		"\t\t{{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, i != 0, x)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tif a.R.{{$txt.Function.Name}} != x {\n" +
+		"\t\t\tt.Error(\"relationship struct not set to correct value\")\n" +
+		"\t\t}\n" +
+		"\t\tif x.R.{{$txt.Function.ForeignName}} != &a {\n" +
+		"\t\t\tt.Error(\"failed to append to foreign relationship struct\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\t{{if .UsesBytes -}}\n" +
+		"\t\tif 0 != bytes.Compare(a.{{.LocalAssignment}}, x.{{.ForeignAssignment}}) {\n" +
+		"\t\t{{else -}}\n" +
+		"\t\tif a.{{.LocalAssignment}} != x.{{.ForeignAssignment}} {\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}})\n" +
+		"\t\t}\n" +
+		"\t\t{{end}}\n" +
+		"\n" +
+		"\t\t{{if setInclude .ForeignColumn $foreignPKeyCols -}}\n" +
+		"\t\tif exists, err := {{$txt.ForeignTable.NameGo}}Exists(tx, x.{{$foreignPKeyCols | stringMap $dot.StringFuncs.titleCase | join \", x.\"}}); err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t} else if !exists {\n" +
+		"\t\t\tt.Error(\"want 'x' to exist\")\n" +
+		"\t\t}\n" +
+		"\t\t{{else -}}\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\treflect.Indirect(reflect.ValueOf(&x.{{.ForeignAssignment}})).Set(reflect.Zero(reflect.TypeOf(x.{{.ForeignAssignment}})))\n" +
+		"\t\t{{end}}\n" +
+		"\t\tif err = x.Reload(tx); err != nil {\n" +
+		"\t\t\tt.Fatal(\"failed to reload\", err)\n" +
+		"\t\t}\n" +
+		"\t\t{{- end}}\n" +
+		"\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\t{{if .UsesBytes -}}\n" +
+		"\t\tif 0 != bytes.Compare(a.{{.LocalAssignment}}, x.{{.ForeignAssignment}}) {\n" +
+		"\t\t{{else -}}\n" +
+		"\t\tif a.{{.LocalAssignment}} != x.{{.ForeignAssignment}} {\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, x.{{.ForeignAssignment}})\n" +
+		"\t\t}\n" +
+		"\t\t{{end}}\n" +
+		"\n" +
+		"\t\tif {{if $dot.RowsAffected}}_, {{end}}err = x.Delete(tx); err != nil {\n" +
+		"\t\t\tt.Fatal(\"failed to delete x\", err)\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{- if .ForeignColumnNullable}}\n" +
+		"\n" +
+		"func test{{$txt.LocalTable.NameGo}}OneToOneRemoveOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar a {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar b {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, &a, {{$varNameSingular}}DBTypes, false, strmangle.SetComplement({{$varNameSingular}}PrimaryKeyColumns, {{$varNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = randomize.Struct(seed, &b, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = a.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif {{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, true, &b); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tif rowsAff, err := a.Remove{{$txt.Function.Name}}(tx, &b); err != nil {\n" +
+		"\t\tt.Error(\"failed to remove relationship\")\n" +
+		"\t} else if rowsAff != 1 {\n" +
+		"\t\tt.Error(\"should have removed one relationship, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = a.Remove{{$txt.Function.Name}}(tx, &b); err != nil {\n" +
+		"\t\tt.Error(\"failed to remove relationship\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tcount, err := a.{{$txt.Function.Name}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif count != 0 {\n" +
+		"\t\tt.Error(\"want no relationships remaining\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif a.R.{{$txt.Function.Name}} != nil {\n" +
+		"\t\tt.Error(\"R struct entry should be nil\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\tif b.{{.ForeignColumnGo}}.Valid {\n" +
+		"\t\tt.Error(\"foreign key column should be nil\")\n" +
+		"\t}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\tif b.R.{{$txt.Function.ForeignName}} != nil {\n" +
+		"\t\tt.Error(\"failed to remove a from b's relationships\")\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{end -}}{{/* end if foreign key nullable */}}\n" +
+		"{{- end -}}{{/* if foreign view */}}\n" +
+		"{{- end -}}{{/* range */}}\n" +
+		"{{- end -}}{{/* join table */}}\n",
+	"templates_test/relationship_to_many.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . }}\n" +
+		"\t{{- $table := .Table }}\n" +
+		"\t{{- range .Table.ToManyRelationships -}}\n" +
+		"\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t{{- else -}}\n" +
+		"\t{{- $txt := txtsFromToMany $dot.Tables $table .}}\n" +
+		"\t{{- $varNameSingular := .Table | singular | camelCase -}}\n" +
+		"\t{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase -}}\n" +
+		"func test{{$txt.LocalTable.NameGo}}ToMany{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\tvar err error\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar a {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar b, c {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, &a, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$txt.LocalTable.NameGo}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := a.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\trandomize.Struct(seed, &b, {{$foreignVarNameSingular}}DBTypes, false, {{$foreignVarNameSingular}}ColumnsWithDefault...)\n" +
+		"\trandomize.Struct(seed, &c, {{$foreignVarNameSingular}}DBTypes, false, {{$foreignVarNameSingular}}ColumnsWithDefault...)\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\t{{if .LocalNullable -}}\n" +
+		"\ta.{{.LocalColumnGo}}.Valid = true\n" +
+		"\t{{end -}}\n" +
+		"\t{{if .ForeignNullable -}}\n" +
+		"\tb.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\tc.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\t{{end -}}\n" +
+		"\t{{end -}}\n" +
+		"\t{{if not .ToJoinTable -}}\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\tb.{{.ForeignAssignment}} = a.{{.LocalAssignment}}\n" +
+		"\tc.{{.ForeignAssignment}} = a.{{.LocalAssignment}}\n" +
+		"\t{{end -}}\n" +
+		"\t{{- end}}\n" +
+		"\tif err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = b.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$foreignVarNameSingular}}Parents(tx, &c); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = c.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .ToJoinTable -}}\n" +
+		"\t_, err = tx.Exec(\"insert into {{.JoinTable | $dot.SchemaTableIn \"tx\"}} ({{.JoinLocalColumn | $dot.Quotes}}, {{.JoinForeignColumn | $dot.Quotes}}) values {{if $dot.Dialect.IndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}\", a.{{$txt.LocalTable.ColumnNameGo}}, b.{{$txt.ForeignTable.ColumnNameGo}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\t_, err = tx.Exec(\"insert into {{.JoinTable | $dot.SchemaTableIn \"tx\"}} ({{.JoinLocalColumn | $dot.Quotes}}, {{.JoinForeignColumn | $dot.Quotes}}) values {{if $dot.Dialect.IndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}\", a.{{$txt.LocalTable.ColumnNameGo}}, c.{{$txt.ForeignTable.ColumnNameGo}})\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\t{{$varname := .ForeignTable | singular | camelCase -}}\n" +
+		"\t{{$varname}}, err := a.{{$txt.Function.Name}}(tx).All()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tbFound, cFound := false, false\n" +
+		"\tfor _, v := range {{$varname}} {\n" +
+		"\t\tif {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(v.{{$col.ForeignAssignment}}, b.{{$col.ForeignAssignment}}){{else}}v.{{$col.ForeignAssignment}} == b.{{$col.ForeignAssignment}}{{end}}{{end}} {\n" +
+		"\t\t\tbFound = true\n" +
+		"\t\t}\n" +
+		"\t\tif {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(v.{{$col.ForeignAssignment}}, c.{{$col.ForeignAssignment}}){{else}}v.{{$col.ForeignAssignment}} == c.{{$col.ForeignAssignment}}{{end}}{{end}} {\n" +
+		"\t\t\tcFound = true\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif !bFound {\n" +
+		"\t\tt.Error(\"expected to find b\")\n" +
+		"\t}\n" +
+		"\tif !cFound {\n" +
+		"\t\tt.Error(\"expected to find c\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tslice := {{$txt.LocalTable.NameGo}}Slice{&a}\n" +
+		"\tif err = a.L.Load{{$txt.Function.Name}}(tx, false, (*[]*{{$txt.LocalTable.NameGo}})(&slice)); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif got := len(a.R.{{$txt.Function.Name}}); got != 2 {\n" +
+		"\t\tt.Error(\"number of eager loaded records wrong, got:\", got)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ta.R.{{$txt.Function.Name}} = nil\n" +
+		"\tif err = a.L.Load{{$txt.Function.Name}}(tx, true, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif got := len(a.R.{{$txt.Function.Name}}); got != 2 {\n" +
+		"\t\tt.Error(\"number of eager loaded records wrong, got:\", got)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif t.Failed() {\n" +
+		"\t\tt.Logf(\"%#v\", {{$varname}})\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"{{end -}}{{- /* if foreign view */ -}}\n" +
+		"{{- end -}}{{- /* range */ -}}\n" +
+		"{{- end -}}{{- /* outer if join table */ -}}\n",
+	"templates_test/relationship_to_many_setops.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- $table := .Table -}}\n" +
+		"\t{{- range .Table.ToManyRelationships -}}\n" +
+		"\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t{{- else -}}\n" +
+		"\t{{- $varNameSingular := .Table | singular | camelCase -}}\n" +
+		"\t{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase -}}\n" +
+		"\t{{- $txt := txtsFromToMany $dot.Tables $table .}}\n" +
+		"func test{{$txt.LocalTable.NameGo}}ToManyAddOp{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar a {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar b, c, d, e {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, &a, {{$varNameSingular}}DBTypes, false, strmangle.SetComplement({{$varNameSingular}}PrimaryKeyColumns, {{$varNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tforeigners := []*{{$txt.ForeignTable.NameGo}}{&b, &c, &d, &e}\n" +
+		"\tfor _, x := range foreigners {\n" +
+		"\t\tif err = randomize.Struct(seed, x, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\t\tif err = {{$foreignVarNameSingular}}Parents(tx, x); err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := a.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = b.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = c.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tforeignersSplitByInsertion := [][]*{{$txt.ForeignTable.NameGo}}{\n" +
+		"\t\t{&b, &c},\n" +
+		"\t\t{&d, &e},\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor i, x := range foreignersSplitByInsertion {\n" +
+		"\t\t{{if $dot.RowsAffected}}_, {{end}}err = a.Add{{$txt.Function.Name}}(tx, i != 0, x...)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tfirst := x[0]\n" +
+		"\t\tsecond := x[1]\n" +
+		"\t\t{{- if .ToJoinTable}}\n" +
+		"\n" +
+		"\t\tif first.R.{{$txt.Function.ForeignName}}[0] != &a {\n" +
+		"\t\t\tt.Error(\"relationship was not added properly to the slice\")\n" +
+		"\t\t}\n" +
+		"\t\tif second.R.{{$txt.Function.ForeignName}}[0] != &a {\n" +
+		"\t\t\tt.Error(\"relationship was not added properly to the slice\")\n" +
+		"\t\t}\n" +
+		"\t\t{{- else}}\n" +
+		"\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\t{{if .UsesBytes -}}\n" +
+		"\t\tif 0 != bytes.Compare(a.{{.LocalAssignment}}, first.{{.ForeignAssignment}}) {\n" +
+		"\t\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, first.{{.ForeignAssignment}})\n" +
+		"\t\t}\n" +
+		"\t\tif 0 != bytes.Compare(a.{{.LocalAssignment}}, second.{{.ForeignAssignment}}) {\n" +
+		"\t\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, second.{{.ForeignAssignment}})\n" +
+		"\t\t}\n" +
+		"\t\t{{else -}}\n" +
+		"\t\tif a.{{.LocalAssignment}} != first.{{.ForeignAssignment}} {\n" +
+		"\t\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, first.{{.ForeignAssignment}})\n" +
+		"\t\t}\n" +
+		"\t\tif a.{{.LocalAssignment}} != second.{{.ForeignAssignment}} {\n" +
+		"\t\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, second.{{.ForeignAssignment}})\n" +
+		"\t\t}\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t{{- end}}\n" +
+		"\n" +
+		"\t\tif first.R.{{$txt.Function.ForeignName}} != &a {\n" +
+		"\t\t\tt.Error(\"relationship was not added properly to the foreign slice\")\n" +
+		"\t\t}\n" +
+		"\t\tif second.R.{{$txt.Function.ForeignName}} != &a {\n" +
+		"\t\t\tt.Error(\"relationship was not added properly to the foreign slice\")\n" +
+		"\t\t}\n" +
+		"\t\t{{- end}}\n" +
+		"\n" +
+		"\t\tif a.R.{{$txt.Function.Name}}[i*2] != first {\n" +
+		"\t\t\tt.Error(\"relationship struct slice not set to correct value\")\n" +
+		"\t\t}\n" +
+		"\t\tif a.R.{{$txt.Function.Name}}[i*2+1] != second {\n" +
+		"\t\t\tt.Error(\"relationship struct slice not set to correct value\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tcount, err := a.{{$txt.Function.Name}}(tx).Count()\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\t\tif want := int64((i+1)*2); count != want {\n" +
+		"\t\t\tt.Error(\"want\", want, \"got\", count)\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{- if (or .ForeignColumnNullable .ToJoinTable)}}\n" +
+		"\n" +
+		"func test{{$txt.LocalTable.NameGo}}ToManySetOp{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar a {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar b, c, d, e {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, &a, {{$varNameSingular}}DBTypes, false, strmangle.SetComplement({{$varNameSingular}}PrimaryKeyColumns, {{$varNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tforeigners := []*{{$txt.ForeignTable.NameGo}}{&b, &c, &d, &e}\n" +
+		"\tfor _, x := range foreigners {\n" +
+		"\t\tif err = randomize.Struct(seed, x, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\t\tif err = {{$foreignVarNameSingular}}Parents(tx, x); err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = a.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = b.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = c.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, false, &b, &c)\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err := a.{{$txt.Function.Name}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif count != 2 {\n" +
+		"\t\tt.Error(\"count was wrong:\", count)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, true, &d, &e)\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err = a.{{$txt.Function.Name}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif count != 2 {\n" +
+		"\t\tt.Error(\"count was wrong:\", count)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{- if .ToJoinTable}}\n" +
+		"\n" +
+		"\t// The following checks cannot be implemented since we have no handle\n" +
+		"\t// to these when we call Set(). Leaving them here as wishful thinking\n" +
+		"\t// and to let people know there's dragons.\n" +
+		"\t//\n" +
+		"\t// if len(b.R.{{$txt.Function.ForeignName}}) != 0 {\n" +
+		"\t// \tt.Error(\"relationship was not removed properly from the slice\")\n" +
+		"\t// }\n" +
+		"\t// if len(c.R.{{$txt.Function.ForeignName}}) != 0 {\n" +
+		"\t// \tt.Error(\"relationship was not removed properly from the slice\")\n" +
+		"\t// }\n" +
+		"\tif d.R.{{$txt.Function.ForeignName}}[0] != &a {\n" +
+		"\t\tt.Error(\"relationship was not added properly to the slice\")\n" +
+		"\t}\n" +
+		"\tif e.R.{{$txt.Function.ForeignName}}[0] != &a {\n" +
+		"\t\tt.Error(\"relationship was not added properly to the slice\")\n" +
+		"\t}\n" +
+		"\t{{- else}}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\tif b.{{.ForeignColumnGo}}.Valid {\n" +
+		"\t\tt.Error(\"want b's foreign key value to be nil\")\n" +
+		"\t}\n" +
+		"\tif c.{{.ForeignColumnGo}}.Valid {\n" +
+		"\t\tt.Error(\"want c's foreign key value to be nil\")\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\t{{if .UsesBytes -}}\n" +
+		"\tif 0 != bytes.Compare(a.{{.LocalAssignment}}, d.{{.ForeignAssignment}}) {\n" +
+		"\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, d.{{.ForeignAssignment}})\n" +
+		"\t}\n" +
+		"\tif 0 != bytes.Compare(a.{{.LocalAssignment}}, e.{{.ForeignAssignment}}) {\n" +
+		"\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, e.{{.ForeignAssignment}})\n" +
+		"\t}\n" +
+		"\t{{else -}}\n" +
+		"\tif a.{{.LocalAssignment}} != d.{{.ForeignAssignment}} {\n" +
+		"\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, d.{{.ForeignAssignment}})\n" +
+		"\t}\n" +
+		"\tif a.{{.LocalAssignment}} != e.{{.ForeignAssignment}} {\n" +
+		"\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, e.{{.ForeignAssignment}})\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif b.R.{{$txt.Function.ForeignName}} != nil {\n" +
+		"\t\tt.Error(\"relationship was not removed properly from the foreign struct\")\n" +
+		"\t}\n" +
+		"\tif c.R.{{$txt.Function.ForeignName}} != nil {\n" +
+		"\t\tt.Error(\"relationship was not removed properly from the foreign struct\")\n" +
+		"\t}\n" +
+		"\tif d.R.{{$txt.Function.ForeignName}} != &a {\n" +
+		"\t\tt.Error(\"relationship was not added properly to the foreign struct\")\n" +
+		"\t}\n" +
+		"\tif e.R.{{$txt.Function.ForeignName}} != &a {\n" +
+		"\t\tt.Error(\"relationship was not added properly to the foreign struct\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif a.R.{{$txt.Function.Name}}[0] != &d {\n" +
+		"\t\tt.Error(\"relationship struct slice not set to correct value\")\n" +
+		"\t}\n" +
+		"\tif a.R.{{$txt.Function.Name}}[1] != &e {\n" +
+		"\t\tt.Error(\"relationship struct slice not set to correct value\")\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$txt.LocalTable.NameGo}}ToManyRemoveOp{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar a {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar b, c, d, e {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, &a, {{$varNameSingular}}DBTypes, false, strmangle.SetComplement({{$varNameSingular}}PrimaryKeyColumns, {{$varNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tforeigners := []*{{$txt.ForeignTable.NameGo}}{&b, &c, &d, &e}\n" +
+		"\tfor _, x := range foreigners {\n" +
+		"\t\tif err = randomize.Struct(seed, x, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\t\tif err = {{$foreignVarNameSingular}}Parents(tx, x); err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := a.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tif rowsAff, err := a.Add{{$txt.Function.Name}}(tx, true, foreigners...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t} else if rowsAff != 4 {\n" +
+		"\t\tt.Error(\"should have added 4 relationships, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\terr = a.Add{{$txt.Function.Name}}(tx, true, foreigners...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tcount, err := a.{{$txt.Function.Name}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif count != 4 {\n" +
+		"\t\tt.Error(\"count was wrong:\", count)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tif rowsAff, err := a.Remove{{$txt.Function.Name}}(tx, foreigners[:2]...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t} else if rowsAff != 2 {\n" +
+		"\t\tt.Error(\"should have removed 2 relationships, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\terr = a.Remove{{$txt.Function.Name}}(tx, foreigners[:2]...)\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tcount, err = a.{{$txt.Function.Name}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif count != 2 {\n" +
+		"\t\tt.Error(\"count was wrong:\", count)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{- if .ToJoinTable}}\n" +
+		"\n" +
+		"\tif len(b.R.{{$txt.Function.ForeignName}}) != 0 {\n" +
+		"\t\tt.Error(\"relationship was not removed properly from the slice\")\n" +
+		"\t}\n" +
+		"\tif len(c.R.{{$txt.Function.ForeignName}}) != 0 {\n" +
+		"\t\tt.Error(\"relationship was not removed properly from the slice\")\n" +
+		"\t}\n" +
+		"\tif d.R.{{$txt.Function.ForeignName}}[0] != &a {\n" +
+		"\t\tt.Error(\"relationship was not added properly to the foreign struct\")\n" +
+		"\t}\n" +
+		"\tif e.R.{{$txt.Function.ForeignName}}[0] != &a {\n" +
+		"\t\tt.Error(\"relationship was not added properly to the foreign struct\")\n" +
+		"\t}\n" +
+		"\t{{- else}}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\tif b.{{.ForeignColumnGo}}.Valid {\n" +
+		"\t\tt.Error(\"want b's foreign key value to be nil\")\n" +
+		"\t}\n" +
+		"\tif c.{{.ForeignColumnGo}}.Valid {\n" +
+		"\t\tt.Error(\"want c's foreign key value to be nil\")\n" +
+		"\t}\n" +
+		"\t{{end -}}\n" +
+		"\n" +
+		"\tif b.R.{{$txt.Function.ForeignName}} != nil {\n" +
+		"\t\tt.Error(\"relationship was not removed properly from the foreign struct\")\n" +
+		"\t}\n" +
+		"\tif c.R.{{$txt.Function.ForeignName}} != nil {\n" +
+		"\t\tt.Error(\"relationship was not removed properly from the foreign struct\")\n" +
+		"\t}\n" +
+		"\tif d.R.{{$txt.Function.ForeignName}} != &a {\n" +
+		"\t\tt.Error(\"relationship to a should have been preserved\")\n" +
+		"\t}\n" +
+		"\tif e.R.{{$txt.Function.ForeignName}} != &a {\n" +
+		"\t\tt.Error(\"relationship to a should have been preserved\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tif len(a.R.{{$txt.Function.Name}}) != 2 {\n" +
+		"\t\tt.Error(\"should have preserved two relationships\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Removal doesn't do a stable deletion for performance so we have to flip the order\n" +
+		"\tif a.R.{{$txt.Function.Name}}[1] != &d {\n" +
+		"\t\tt.Error(\"relationship to d should have been preserved\")\n" +
+		"\t}\n" +
+		"\tif a.R.{{$txt.Function.Name}}[0] != &e {\n" +
+		"\t\tt.Error(\"relationship to e should have been preserved\")\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{end -}}\n" +
+		"{{- end -}}{{- /* if foreign view */ -}}\n" +
+		"{{- end -}}{{- /* range relationships */ -}}\n" +
+		"{{- end -}}{{- /* outer if join table */ -}}\n",
+	"templates_test/relationship_to_one.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.FKeys -}}\n" +
+		"\t\t{{- $txt := txtsFromFKey $dot.Tables $dot.Table . -}}\n" +
+		"\t\t{{- $varNameSingular := .Table | singular | camelCase -}}\n" +
+		"\t\t{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase}}\n" +
+		"func test{{$txt.LocalTable.NameGo}}ToOne{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar local {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar foreign {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err := randomize.Struct(seed, &local, {{$varNameSingular}}DBTypes, {{if .Nullable}}true{{else}}false{{end}}, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$txt.LocalTable.NameGo}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err := randomize.Struct(seed, &foreign, {{$foreignVarNameSingular}}DBTypes, {{if .ForeignColumnNullable}}true{{else}}false{{end}}, {{$foreignVarNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$txt.ForeignTable.NameGo}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\t{{if .LocalNullable -}}\n" +
+		"\tlocal.{{.LocalColumnGo}}.Valid = true\n" +
+		"\t{{end -}}\n" +
+		"\t{{if .ForeignNullable -}}\n" +
+		"\tforeign.{{.ForeignColumnGo}}.Valid = true\n" +
+		"\t{{end -}}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\tif err := {{$foreignVarNameSingular}}Parents(tx, &foreign); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := foreign.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\tlocal.{{.LocalAssignment}} = foreign.{{.ForeignAssignment}}\n" +
+		"\t{{end -}}\n" +
+		"\tif err := {{$varNameSingular}}Parents(tx, &local); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := local.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcheck, err := local.{{$txt.Function.Name}}(tx).One()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\t{{if .UsesBytes -}}\n" +
+		"\tif 0 != bytes.Compare(check.{{.ForeignAssignment}}, foreign.{{.ForeignAssignment}}) {\n" +
+		"\t{{else -}}\n" +
+		"\tif check.{{.ForeignAssignment}} != foreign.{{.ForeignAssignment}} {\n" +
+		"\t{{end -}}\n" +
+		"\t\tt.Errorf(\"want: %v, got %v\", foreign.{{.ForeignAssignment}}, check.{{.ForeignAssignment}})\n" +
+		"\t}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\tslice := {{$txt.LocalTable.NameGo}}Slice{&local}\n" +
+		"\tif err = local.L.Load{{$txt.Function.Name}}(tx, false, (*[]*{{$txt.LocalTable.NameGo}})(&slice)); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif local.R.{{$txt.Function.Name}} == nil {\n" +
+		"\t\tt.Error(\"struct should have been eager loaded\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tlocal.R.{{$txt.Function.Name}} = nil\n" +
+		"\tif err = local.L.Load{{$txt.Function.Name}}(tx, true, &local); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif local.R.{{$txt.Function.Name}} == nil {\n" +
+		"\t\tt.Error(\"struct should have been eager loaded\")\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"{{end -}}{{/* range */}}\n" +
+		"{{- end -}}{{/* join table */}}\n",
+	"templates_test/relationship_to_one_setops.tpl": "{{- if .Table.IsJoinTable -}}\n" +
+		"{{- else -}}\n" +
+		"\t{{- $dot := . -}}\n" +
+		"\t{{- range .Table.FKeys -}}\n" +
+		"\t\t{{- $txt := txtsFromFKey $dot.Tables $dot.Table .}}\n" +
+		"{{- $varNameSingular := .Table | singular | camelCase -}}\n" +
+		"{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase}}\n" +
+		"func test{{$txt.LocalTable.NameGo}}ToOneSetOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar a {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar b, c {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, &a, {{$varNameSingular}}DBTypes, false, strmangle.SetComplement({{$varNameSingular}}PrimaryKeyColumns, {{$varNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = randomize.Struct(seed, &b, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = randomize.Struct(seed, &c, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err := a.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = b.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$foreignVarNameSingular}}Parents(tx, &c); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor i, x := range []*{{$txt.ForeignTable.NameGo}}{&b, &c} {\n" +
+		"\t\t{{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, i != 0, x)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tif a.R.{{$txt.Function.Name}} != x {\n" +
+		"\t\t\tt.Error(\"relationship struct not set to correct value\")\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\t{{if .Unique -}}\n" +
+		"\t\tif x.R.{{$txt.Function.ForeignName}} != &a {\n" +
+		"\t\t\tt.Error(\"failed to append to foreign relationship struct\")\n" +
+		"\t\t}\n" +
+		"\t\t{{else -}}\n" +
+		"\t\tif x.R.{{$txt.Function.ForeignName}}[0] != &a {\n" +
+		"\t\t\tt.Error(\"failed to append to foreign relationship struct\")\n" +
+		"\t\t}\n" +
+		"\t\t{{end -}}\n" +
+		"\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\t{{if .UsesBytes -}}\n" +
+		"\t\tif 0 != bytes.Compare(a.{{.LocalAssignment}}, x.{{.ForeignAssignment}}) {\n" +
+		"\t\t{{else -}}\n" +
+		"\t\tif a.{{.LocalAssignment}} != x.{{.ForeignAssignment}} {\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}})\n" +
+		"\t\t}\n" +
+		"\t\t{{end}}\n" +
+		"\n" +
+		"\t\t{{if setInclude .Column $dot.Table.PKey.Columns -}}\n" +
+		"\t\tif exists, err := {{$txt.LocalTable.NameGo}}Exists(tx, a.{{$dot.Table.PKey.Columns | stringMap $dot.StringFuncs.titleCase | join \", a.\"}}); err != nil {\n" +
+		"\t\t\tt.Fatal(err)\n" +
+		"\t\t} else if !exists {\n" +
+		"\t\t\tt.Error(\"want 'a' to exist\")\n" +
+		"\t\t}\n" +
+		"\t\t{{else -}}\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\treflect.Indirect(reflect.ValueOf(&a.{{.LocalAssignment}})).Set(reflect.Zero(reflect.TypeOf(a.{{.LocalAssignment}})))\n" +
+		"\t\t{{end}}\n" +
+		"\t\tif err = a.Reload(tx); err != nil {\n" +
+		"\t\t\tt.Fatal(\"failed to reload\", err)\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\t{{range $txt.Columns -}}\n" +
+		"\t\t{{if .UsesBytes -}}\n" +
+		"\t\tif 0 != bytes.Compare(a.{{.LocalAssignment}}, x.{{.ForeignAssignment}}) {\n" +
+		"\t\t{{else -}}\n" +
+		"\t\tif a.{{.LocalAssignment}} != x.{{.ForeignAssignment}} {\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t\tt.Error(\"foreign key was wrong value\", a.{{.LocalAssignment}}, x.{{.ForeignAssignment}})\n" +
+		"\t\t}\n" +
+		"\t\t{{end -}}\n" +
+		"\t\t{{- end}}\n" +
+		"\t}\n" +
+		"}\n" +
+		"{{- if .Nullable}}\n" +
+		"\n" +
+		"func test{{$txt.LocalTable.NameGo}}ToOneRemoveOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}(t *testing.T) {\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\n" +
+		"\tvar a {{$txt.LocalTable.NameGo}}\n" +
+		"\tvar b {{$txt.ForeignTable.NameGo}}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tif err = randomize.Struct(seed, &a, {{$varNameSingular}}DBTypes, false, strmangle.SetComplement({{$varNameSingular}}PrimaryKeyColumns, {{$varNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = randomize.Struct(seed, &b, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &a); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = a.Insert(tx); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif {{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, true, &b); err != nil {\n" +
+		"\t\tt.Fatal(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if $dot.RowsAffected -}}\n" +
+		"\tif rowsAff, err := a.Remove{{$txt.Function.Name}}(tx, &b); err != nil {\n" +
+		"\t\tt.Error(\"failed to remove relationship\")\n" +
+		"\t} else if rowsAff != 1 {\n" +
+		"\t\tt.Error(\"should have removed one relationship, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = a.Remove{{$txt.Function.Name}}(tx, &b); err != nil {\n" +
+		"\t\tt.Error(\"failed to remove relationship\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\n" +
+		"\tcount, err := a.{{$txt.Function.Name}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif count != 0 {\n" +
+		"\t\tt.Error(\"want no relationships remaining\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif a.R.{{$txt.Function.Name}} != nil {\n" +
+		"\t\tt.Error(\"R struct entry should be nil\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{range $txt.Columns -}}\n" +
+		"\tif a.{{.LocalColumnGo}}.Valid {\n" +
+		"\t\tt.Error(\"foreign key value should be nil\")\n" +
+		"\t}\n" +
+		"\t{{end}}\n" +
+		"\n" +
+		"\t{{if .Unique -}}\n" +
+		"\tif b.R.{{$txt.Function.ForeignName}} != nil {\n" +
+		"\t\tt.Error(\"failed to remove a from b's relationships\")\n" +
+		"\t}\n" +
+		"\t{{else -}}\n" +
+		"\tif len(b.R.{{$txt.Function.ForeignName}}) != 0 {\n" +
+		"\t\tt.Error(\"failed to remove a from b's relationships\")\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"{{end -}}{{/* end if foreign key nullable */}}\n" +
+		"{{- end -}}{{/* range */}}\n" +
+		"{{- end -}}{{/* join table */}}\n",
+	"templates_test/reload.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}Reload(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}.Reload(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}ReloadAll(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tslice := {{$tableNameSingular}}Slice{{\"{\"}}{{$varNameSingular}}{{\"}\"}}\n" +
+		"\n" +
+		"\tif err = slice.ReloadAll(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/select.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}Select(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tslice, err := {{$tableNamePlural}}(tx).All()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif len(slice) != int(before)+1 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+1, len(slice))\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/singleton/boil_main_test.tpl": "var flagDebugMode = flag.Bool(\"test.sqldebug\", false, \"Turns on debug mode for SQL statements\")\n" +
+		"var flagConfigFile = flag.String(\"test.config\", \"\", \"Overrides the default config\")\n" +
+		"\n" +
+		"var (\n" +
+		"\tdbMain tester\n" +
+		")\n" +
+		"\n" +
+		"// errTestHook is returned by the hooks the tests make fail\n" +
+		"var errTestHook = errors.New(\"test hook failed\")\n" +
+		"\n" +
+		"type tester interface {\n" +
+		"\tsetup() error\n" +
+		"\tconn() (*sql.DB, error)\n" +
+		"\tteardown() error\n" +
+		"}\n" +
+		"\n" +
+		"func TestMain(m *testing.M) {\n" +
+		"\tif dbMain == nil {\n" +
+		"\t\tfmt.Println(\"no dbMain tester interface was ready\")\n" +
+		"\t\tos.Exit(-1)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Seeded like randomize, so a failing run can be repeated with its seed\n" +
+		"\trand.Seed(randomize.DefaultSeed())\n" +
+		"\n" +
+		"\tflag.Parse()\n" +
+		"\n" +
+		"\tvar err error\n" +
+		"\n" +
+		"\t// Load configuration\n" +
+		"\terr = initViper()\n" +
+		"\tif err != nil {\n" +
+		"\t\tfmt.Println(\"unable to load config file\")\n" +
+		"\t\tos.Exit(-2)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tsetConfigDefaults()\n" +
+		"\tif err := validateConfig(\"{{.DriverName}}\"); err != nil {\n" +
+		"\t\tfmt.Println(\"failed to validate config\", err)\n" +
+		"\t\tos.Exit(-3)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Set DebugMode so we can see generated sql statements\n" +
+		"\tboil.DebugMode = *flagDebugMode\n" +
+		"\n" +
+		"\tif err = dbMain.setup(); err != nil {\n" +
+		"\t\tfmt.Println(\"Unable to execute setup:\", err)\n" +
+		"\t\tos.Exit(-4)\n" +
+		"\t}\n" +
+		"\n" +
+		"  conn, err := dbMain.conn()\n" +
+		"  if err != nil {\n" +
+		"    fmt.Println(\"failed to get connection:\", err)\n" +
+		"  }\n" +
+		"\n" +
+		"\tvar code int\n" +
+		"\tboil.SetDB(conn)\n" +
+		"\tcode = m.Run()\n" +
+		"\n" +
+		"\tif code != 0 {\n" +
+		"\t\tfmt.Printf(\"randomize seed: %d, rerun with %s=%d to repeat the same values\\n\", randomize.DefaultSeed(), randomize.SeedEnv, randomize.DefaultSeed())\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = dbMain.teardown(); err != nil {\n" +
+		"\t\tfmt.Println(\"Unable to execute teardown:\", err)\n" +
+		"\t\tos.Exit(-5)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tos.Exit(code)\n" +
+		"}\n" +
+		"\n" +
+		"func initViper() error {\n" +
+		" \tif flagConfigFile != nil && *flagConfigFile != \"\" {\n" +
+		"\t\tviper.SetConfigFile(*flagConfigFile)\n" +
+		"\t\tif err := viper.ReadInConfig(); err != nil {\n" +
+		"\t\t\treturn err\n" +
+		"\t\t}\n" +
+		"\t\treturn nil\n" +
+		"\t}\n" +
+		"\n" +
+		"  var err error\n" +
+		"\n" +
+		"\tviper.SetConfigName(\"sqlboiler\")\n" +
+		"\n" +
+		"\tconfigHome := os.Getenv(\"XDG_CONFIG_HOME\")\n" +
+		"\thomePath := os.Getenv(\"HOME\")\n" +
+		"\twd, err := os.Getwd()\n" +
+		"\tif err != nil {\n" +
+		"\t\twd = \"../\"\n" +
+		"\t} else {\n" +
+		"\t\twd = wd + \"/..\"\n" +
+		"\t}\n" +
+		"\n" +
+		"\tconfigPaths := []string{wd}\n" +
+		"\tif len(configHome) > 0 {\n" +
+		"\t\tconfigPaths = append(configPaths, filepath.Join(configHome, \"sqlboiler\"))\n" +
+		"\t} else {\n" +
+		"\t\tconfigPaths = append(configPaths, filepath.Join(homePath, \".config/sqlboiler\"))\n" +
+		"\t}\n" +
+		"\n" +
+		"\tfor _, p := range configPaths {\n" +
+		"\t\tviper.AddConfigPath(p)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Ignore errors here, fall back to defaults and validation to provide errs\n" +
+		"\t_ = viper.ReadInConfig()\n" +
+		"\tviper.AutomaticEnv()\n" +
+		"\n" +
+		"\treturn nil\n" +
+		"}\n" +
+		"\n" +
+		"// setConfigDefaults is only necessary because of bugs in viper, noted in main\n" +
+		"func setConfigDefaults() {\n" +
+		"\tif viper.GetString(\"postgres.sslmode\") == \"\" {\n" +
+		"\t\tviper.Set(\"postgres.sslmode\", \"require\")\n" +
+		"\t}\n" +
+		"\tif viper.GetInt(\"postgres.port\") == 0 {\n" +
+		"\t\tviper.Set(\"postgres.port\", 5432)\n" +
+		"\t}\n" +
+		"\tif viper.GetString(\"mysql.sslmode\") == \"\" {\n" +
+		"\t\tviper.Set(\"mysql.sslmode\", \"true\")\n" +
+		"\t}\n" +
+		"\tif viper.GetInt(\"mysql.port\") == 0 {\n" +
+		"\t\tviper.Set(\"mysql.port\", 3306)\n" +
+		"\t}\n" +
+		"\tif viper.GetString(\"mssql.sslmode\") == \"\" {\n" +
+		"\t\tviper.Set(\"mssql.sslmode\", \"true\")\n" +
+		"\t}\n" +
+		"\tif viper.GetInt(\"mssql.port\") == 0 {\n" +
+		"\t\tviper.Set(\"mssql.port\", 1433)\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func validateConfig(driverName string) error {\n" +
+		"\tif driverName == \"postgres\" {\n" +
+		"\t\treturn vala.BeginValidation().Validate(\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"postgres.user\"), \"postgres.user\"),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"postgres.host\"), \"postgres.host\"),\n" +
+		"\t\t\tvala.Not(vala.Equals(viper.GetInt(\"postgres.port\"), 0, \"postgres.port\")),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"postgres.dbname\"), \"postgres.dbname\"),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"postgres.sslmode\"), \"postgres.sslmode\"),\n" +
+		"\t\t).Check()\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif driverName == \"mysql\" {\n" +
+		"\t\treturn vala.BeginValidation().Validate(\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"mysql.user\"), \"mysql.user\"),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"mysql.host\"), \"mysql.host\"),\n" +
+		"\t\t\tvala.Not(vala.Equals(viper.GetInt(\"mysql.port\"), 0, \"mysql.port\")),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"mysql.dbname\"), \"mysql.dbname\"),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"mysql.sslmode\"), \"mysql.sslmode\"),\n" +
+		"\t\t).Check()\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif driverName == \"mssql\" {\n" +
+		"\t\treturn vala.BeginValidation().Validate(\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"mssql.user\"), \"mssql.user\"),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"mssql.host\"), \"mssql.host\"),\n" +
+		"\t\t\tvala.Not(vala.Equals(viper.GetInt(\"mssql.port\"), 0, \"mssql.port\")),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"mssql.dbname\"), \"mssql.dbname\"),\n" +
+		"\t\t\tvala.StringNotEmpty(viper.GetString(\"mssql.sslmode\"), \"mssql.sslmode\"),\n" +
+		"\t\t).Check()\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn errors.New(\"not a valid driver name\")\n" +
+		"}\n",
+	"templates_test/singleton/boil_queries_test.tpl": "var dbNameRand *rand.Rand\n" +
+		"\n" +
+		"func MustTx(transactor boil.Transactor, err error) boil.Transactor {\n" +
+		"\tif err != nil {\n" +
+		"\t\tpanic(fmt.Sprintf(\"Cannot create a transactor: %s\", err))\n" +
+		"\t}\n" +
+		"\treturn transactor\n" +
+		"}\n" +
+		"\n" +
+		"var rgxPGFkey = regexp.MustCompile(`(?m)^ALTER TABLE ONLY .*\\n\\s+ADD CONSTRAINT .*? FOREIGN KEY .*?;\\n`)\n" +
+		"var rgxMySQLkey = regexp.MustCompile(`(?m)((,\\n)?\\s+CONSTRAINT.*?FOREIGN KEY.*?\\n)+`)\n" +
+		"var rgxMSSQLkey = regexp.MustCompile(`(?m)^ALTER TABLE .*ADD\\s+CONSTRAINT .* FOREIGN KEY.*?.*\\n?REFERENCES.*`)\n" +
+		"\n" +
+		"func newFKeyDestroyer(regex *regexp.Regexp, reader io.Reader) io.Reader {\n" +
+		"\treturn &fKeyDestroyer{\n" +
+		"\t\treader: reader,\n" +
+		"    rgx:    regex,\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"type fKeyDestroyer struct {\n" +
+		"\treader io.Reader\n" +
+		"\tbuf    *bytes.Buffer\n" +
+		"  rgx    *regexp.Regexp\n" +
+		"}\n" +
+		"\n" +
+		"func (f *fKeyDestroyer) Read(b []byte) (int, error) {\n" +
+		"\tif f.buf == nil {\n" +
+		"\t\tall, err := ioutil.ReadAll(f.reader)\n" +
+		"\t\tif err != nil {\n" +
+		"\t\t\treturn 0, err\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tf.buf = bytes.NewBuffer(f.rgx.ReplaceAll(all, []byte{}))\n" +
+		"\t}\n" +
+		"\n" +
+		"\treturn f.buf.Read(b)\n" +
+		"}\n" +
+		"\n",
+	"templates_test/singleton/boil_suites_test.tpl": "{{- $dot := .}}\n" +
+		"// This test suite runs each operation test in parallel.\n" +
+		"// Example, if your database has 3 tables, the suite will run:\n" +
+		"// table1, table2 and table3 Delete in parallel\n" +
+		"// table1, table2 and table3 Insert in parallel, and so forth.\n" +
+		"// It does NOT run each operation group in parallel.\n" +
+		"// Separating the tests thusly grants avoidance of Postgres deadlocks.\n" +
+		"func TestParent(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}})\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestDelete(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Delete)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestQueryDeleteAll(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}QueryDeleteAll)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestSliceDeleteAll(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}SliceDeleteAll)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestExists(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Exists)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestFactory(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Factory)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestFind(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Find)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestFindByUniqueKey(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  {{- range $ukey := $table.UKeys -}}\n" +
+		"  {{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join \"And\" -}}\n" +
+		"  t.Run(\"{{$tableName}}By{{$keyName}}\", test{{$tableName}}FindBy{{$keyName}})\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestBind(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Bind)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestOne(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}One)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestAll(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}All)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestCount(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Count)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"{{if not .NoHooks -}}\n" +
+		"func TestHooks(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Hooks)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"{{- end}}\n" +
+		"\n" +
+		"func TestInsert(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Insert)\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}InsertWhitelist)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestToOne tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestToOne(t *testing.T) {\n" +
+		"{{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"    {{- range $table.FKeys -}}\n" +
+		"      {{- $txt := txtsFromFKey $dot.Tables $table . -}}\n" +
+		"  t.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}ToOne{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})\n" +
+		"    {{end -}}{{- /* fkey range */ -}}\n" +
+		"  {{- end -}}{{- /* if join table */ -}}\n" +
+		"{{- end -}}{{- /* tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestOneToOne tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestOneToOne(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"\t{{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"\t{{- else -}}\n" +
+		"\t  {{- range $table.ToOneRelationships -}}\n" +
+		"\t\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\t{{- $txt := txtsFromOneToOne $dot.Tables $table . -}}\n" +
+		"  t.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}OneToOne{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})\n" +
+		"\t\t{{end -}}{{- /* if foreign view */ -}}\n" +
+		"\t  {{- end -}}{{- /* range */ -}}\n" +
+		"\t{{- end -}}{{- /* outer if join table */ -}}\n" +
+		"  {{- end -}}{{- /* outer tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestToMany tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestToMany(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"    {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"    {{- else -}}\n" +
+		"      {{- range $table.ToManyRelationships -}}\n" +
+		"        {{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"        {{- else -}}\n" +
+		"        {{- $txt := txtsFromToMany $dot.Tables $table . -}}\n" +
+		"  t.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}ToMany{{$txt.Function.Name}})\n" +
+		"        {{end -}}{{- /* if foreign view */ -}}\n" +
+		"      {{- end -}}{{- /* range */ -}}\n" +
+		"    {{- end -}}{{- /* outer if join table */ -}}\n" +
+		"  {{- end -}}{{- /* outer tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestToOneSet tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestToOneSet(t *testing.T) {\n" +
+		"{{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"    {{- range $table.FKeys -}}\n" +
+		"      {{- $txt := txtsFromFKey $dot.Tables $table . -}}\n" +
+		"  t.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}ToOneSetOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})\n" +
+		"    {{end -}}{{- /* fkey range */ -}}\n" +
+		"  {{- end -}}{{- /* if join table */ -}}\n" +
+		"{{- end -}}{{- /* tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestToOneRemove tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestToOneRemove(t *testing.T) {\n" +
+		"{{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"    {{- range $table.FKeys -}}\n" +
+		"      {{- $txt := txtsFromFKey $dot.Tables $table . -}}\n" +
+		"      {{- if $txt.ForeignKey.Nullable -}}\n" +
+		"  t.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}ToOneRemoveOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})\n" +
+		"      {{end -}}{{- /* if foreign key nullable */ -}}\n" +
+		"    {{- end -}}{{- /* fkey range */ -}}\n" +
+		"  {{- end -}}{{- /* if join table */ -}}\n" +
+		"{{- end -}}{{- /* tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestOneToOneSet tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestOneToOneSet(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"\t{{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"\t{{- else -}}\n" +
+		"\t  {{- range $table.ToOneRelationships -}}\n" +
+		"\t\t{{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"\t\t{{- else -}}\n" +
+		"\t\t  {{- $txt := txtsFromOneToOne $dot.Tables $table . -}}\n" +
+		"\tt.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}OneToOneSetOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})\n" +
+		"\t\t{{end -}}{{- /* if foreign view */ -}}\n" +
+		"\t  {{- end -}}{{- /* range to one relationships */ -}}\n" +
+		"\t{{- end -}}{{- /* outer if join table */ -}}\n" +
+		"  {{- end -}}{{- /* outer tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestOneToOneRemove tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestOneToOneRemove(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"\t{{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"\t{{- else -}}\n" +
+		"\t  {{- range $table.ToOneRelationships -}}\n" +
+		"\t\t{{- if and .ForeignColumnNullable (not (getTable $dot.Tables .ForeignTable).IsView) -}}\n" +
+		"\t\t  {{- $txt := txtsFromOneToOne $dot.Tables $table . -}}\n" +
+		"\tt.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}OneToOneRemoveOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})\n" +
+		"\t\t{{end -}}{{- /* if foreign column nullable */ -}}\n" +
+		"\t  {{- end -}}{{- /* range */ -}}\n" +
+		"\t{{- end -}}{{- /* outer if join table */ -}}\n" +
+		"  {{- end -}}{{- /* outer tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestToManyAdd tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestToManyAdd(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"    {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"    {{- else -}}\n" +
+		"      {{- range $table.ToManyRelationships -}}\n" +
+		"        {{- if (getTable $dot.Tables .ForeignTable).IsView -}}\n" +
+		"        {{- else -}}\n" +
+		"        {{- $txt := txtsFromToMany $dot.Tables $table . -}}\n" +
+		"  t.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}ToManyAddOp{{$txt.Function.Name}})\n" +
+		"        {{end -}}{{- /* if foreign view */ -}}\n" +
+		"      {{- end -}}{{- /* range */ -}}\n" +
+		"    {{- end -}}{{- /* outer if join table */ -}}\n" +
+		"  {{- end -}}{{- /* outer tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestToManySet tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestToManySet(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"    {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"    {{- else -}}\n" +
+		"      {{- range $table.ToManyRelationships -}}\n" +
+		"        {{- if or (not (or .ForeignColumnNullable .ToJoinTable)) (getTable $dot.Tables .ForeignTable).IsView}}\n" +
+		"        {{- else -}}\n" +
+		"          {{- $txt := txtsFromToMany $dot.Tables $table . -}}\n" +
+		"    t.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}ToManySetOp{{$txt.Function.Name}})\n" +
+		"        {{end -}}{{- /* if foreign column nullable */ -}}\n" +
+		"      {{- end -}}{{- /* range */ -}}\n" +
+		"    {{- end -}}{{- /* outer if join table */ -}}\n" +
+		"  {{- end -}}{{- /* outer tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"// TestToManyRemove tests cannot be run in parallel\n" +
+		"// or deadlocks can occur.\n" +
+		"func TestToManyRemove(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"    {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"    {{- else -}}\n" +
+		"      {{- range $table.ToManyRelationships -}}\n" +
+		"        {{- if or (not (or .ForeignColumnNullable .ToJoinTable)) (getTable $dot.Tables .ForeignTable).IsView}}\n" +
+		"        {{- else -}}\n" +
+		"          {{- $txt := txtsFromToMany $dot.Tables $table . -}}\n" +
+		"    t.Run(\"{{$txt.LocalTable.NameGo}}To{{$txt.Function.Name}}\", test{{$txt.LocalTable.NameGo}}ToManyRemoveOp{{$txt.Function.Name}})\n" +
+		"        {{end -}}{{- /* if foreign column nullable */ -}}\n" +
+		"      {{- end -}}{{- /* range */ -}}\n" +
+		"    {{- end -}}{{- /* outer if join table */ -}}\n" +
+		"  {{- end -}}{{- /* outer tables range */ -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestReload(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Reload)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestReloadAll(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}ReloadAll)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestValidate(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Validate)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestSelect(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Select)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestUpdate(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Update)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestUpdateChanged(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}UpdateChanged)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestSliceUpdateAll(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}SliceUpdateAll)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestUpsert(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}Upsert)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n" +
+		"\n" +
+		"func TestSQLSnapshot(t *testing.T) {\n" +
+		"  {{- range $index, $table := .Tables}}\n" +
+		"  {{- if or $table.IsJoinTable $table.IsView -}}\n" +
+		"  {{- else -}}\n" +
+		"  {{- $tableName := $table.Name | plural | titleCase -}}\n" +
+		"  t.Run(\"{{$tableName}}\", test{{$tableName}}SQLSnapshot)\n" +
+		"  {{end -}}\n" +
+		"  {{- end -}}\n" +
+		"}\n",
+	"templates_test/sql_snapshot.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}SQLSnapshot(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\to := &{{$tableNameSingular}}{}\n" +
+		"\tif err := randomize.Struct(seed, o, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\te := boiltest.New()\n" +
+		"\tdefer e.Close()\n" +
+		"\te.ExpectRegexp(\".\").ReturnModels(o)\n" +
+		"\texec := boil.SkipHooks(e)\n" +
+		"\n" +
+		"\t// Errors are ignored, only the statements each operation builds matter\n" +
+		"\tfound, inserted, updated, upserted := *o, *o, *o, *o\n" +
+		"\t_, _ = Find{{$tableNameSingular}}(exec, {{.Table.PKey.Columns | stringMap .StringFuncs.titleCase | prefixStringSlice \"found.\" | join \", \"}})\n" +
+		"\t_ = inserted.Insert(exec)\n" +
+		"\t{{if .RowsAffected}}_, {{end}}_ = updated.Update(exec)\n" +
+		"\t_ = upserted.Upsert(exec, {{if eq .DriverName \"postgres\"}}true, nil, {{end}}nil)\n" +
+		"\t{{if .RowsAffected}}_, {{end}}_ = o.Delete(exec)\n" +
+		"\n" +
+		"\t// The arguments are random, so only the statements are snapshotted\n" +
+		"\tbuf := &bytes.Buffer{}\n" +
+		"\tfor _, call := range e.Calls() {\n" +
+		"\t\tbuf.WriteString(call.SQL)\n" +
+		"\t\tbuf.WriteByte('\\n')\n" +
+		"\t}\n" +
+		"\n" +
+		"\tboiltest.Golden(t, \"testdata/{{.DriverName}}/{{.Table.Name}}.sql\", buf.String())\n" +
+		"}\n",
+	"templates_test/types.tpl": "var (\n" +
+		"\t_ = bytes.MinRead\n" +
+		"\t_ = queries.Raw\n" +
+		")\n",
+	"templates_test/update.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}Update(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tif len({{$varNameSingular}}Columns) == len({{$varNameSingular}}PrimaryKeyColumns) {\n" +
+		"\t\tt.Skip(\"Skipping table with only primary key columns\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif count != before+1 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+1, count)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tif rowsAff, err := {{$varNameSingular}}.Update(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t} else if rowsAff != 1 {\n" +
+		"\t\tt.Error(\"should only affect one row, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = {{$varNameSingular}}.Update(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}UpdateChanged(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tif len({{$varNameSingular}}Columns) == len({{$varNameSingular}}PrimaryKeyColumns) {\n" +
+		"\t\tt.Skip(\"Skipping table with only primary key columns\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif changed := {{$varNameSingular}}.Changed(); len(changed) != len({{$varNameSingular}}Columns) {\n" +
+		"\t\tt.Error(\"want every column changed before insert, got:\", changed)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif changed := {{$varNameSingular}}.Changed(); len(changed) != 0 {\n" +
+		"\t\tt.Error(\"want no changed columns after insert, got:\", changed)\n" +
+		"\t}\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tif rowsAff, err := {{$varNameSingular}}.Update(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t} else if rowsAff != 0 {\n" +
+		"\t\tt.Error(\"should not update anything when nothing changed, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = {{$varNameSingular}}.Update(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\tif changed := {{$varNameSingular}}.Changed(); len(changed) != 0 {\n" +
+		"\t\tt.Error(\"want no changed columns after an update that wrote nothing, got:\", changed)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tblacklist := append({{$varNameSingular}}ColumnsWithDefault, {{$varNameSingular}}PrimaryKeyColumns...)\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, blacklist...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tif rowsAff, err := {{$varNameSingular}}.Update(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t} else if rowsAff != 1 {\n" +
+		"\t\tt.Error(\"should only update the changed row, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = {{$varNameSingular}}.Update(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"\tif changed := {{$varNameSingular}}.Changed(); len(changed) != 0 {\n" +
+		"\t\tt.Error(\"want no changed columns after update, got:\", changed)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}.Reload(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif changed := {{$varNameSingular}}.Changed(); len(changed) != 0 {\n" +
+		"\t\tt.Error(\"want no changed columns after reload, got:\", changed)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, blacklist...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tchanged := {{$varNameSingular}}.Changed()\n" +
+		"\tif len(changed) < 2 {\n" +
+		"\t\treturn\n" +
+		"\t}\n" +
+		"\t{{if .RowsAffected}}_, {{end}}err = {{$varNameSingular}}.Update(tx, changed[0])\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif unwritten := strmangle.SetComplement(changed[1:], {{$varNameSingular}}.Changed()); len(unwritten) != 0 {\n" +
+		"\t\tt.Error(\"want the columns left out of the whitelist still changed, got:\", {{$varNameSingular}}.Changed())\n" +
+		"\t}\n" +
+		"}\n" +
+		"\n" +
+		"func test{{$tableNamePlural}}SliceUpdateAll(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tif len({{$varNameSingular}}Columns) == len({{$varNameSingular}}PrimaryKeyColumns) {\n" +
+		"\t\tt.Skip(\"Skipping table with only primary key columns\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Insert(tx); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif count != before+1 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+1, count)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}PrimaryKeyColumns...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Remove Primary keys and unique columns from what we plan to update\n" +
+		"\tvar fields []string\n" +
+		"\tif strmangle.StringSliceMatch({{$varNameSingular}}Columns, {{$varNameSingular}}PrimaryKeyColumns) {\n" +
+		"\t\tfields = {{$varNameSingular}}Columns\n" +
+		"\t} else {\n" +
+		"\t\tfields = strmangle.SetComplement(\n" +
+		"\t\t\t{{$varNameSingular}}Columns,\n" +
+		"\t\t\t{{$varNameSingular}}PrimaryKeyColumns,\n" +
+		"\t\t)\n" +
+		"\t\t{{- if eq .DriverName \"mssql\"}}\n" +
+		"\t\tfields = strmangle.SetComplement(\n" +
+		"\t\t\tfields,\n" +
+		"\t\t\t{{$varNameSingular}}ColumnsWithAuto,\n" +
+		"\t\t)\n" +
+		"\t\t{{- end}}\n" +
+		"\t}\n" +
+		"\n" +
+		"\tvalue := reflect.Indirect(reflect.ValueOf({{$varNameSingular}}))\n" +
+		"\tupdateMap := M{}\n" +
+		"\tfor _, col := range fields {\n" +
+		"\t\tupdateMap[col] = value.FieldByName(strmangle.TitleCase(col)).Interface()\n" +
+		"\t}\n" +
+		"\n" +
+		"\tslice := {{$tableNameSingular}}Slice{{\"{\"}}{{$varNameSingular}}{{\"}\"}}\n" +
+		"\t{{if .RowsAffected -}}\n" +
+		"\tif rowsAff, err := slice.UpdateAll(tx, updateMap); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t} else if rowsAff != 1 {\n" +
+		"\t\tt.Error(\"should only affect one row, but affected:\", rowsAff)\n" +
+		"\t}\n" +
+		"\t{{- else -}}\n" +
+		"\tif err = slice.UpdateAll(tx, updateMap); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\t{{- end}}\n" +
+		"}\n",
+	"templates_test/upsert.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNamePlural := .Table.Name | plural | camelCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase -}}\n" +
+		"func test{{$tableNamePlural}}Upsert(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tif len({{$varNameSingular}}Columns) == len({{$varNameSingular}}PrimaryKeyColumns) {\n" +
+		"\t\tt.Skip(\"Skipping table with only primary key columns\")\n" +
+		"\t}\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tvar err error\n" +
+		"\t// Attempt the INSERT side of an UPSERT\n" +
+		"\t{{$varNameSingular}} := {{$tableNameSingular}}{}\n" +
+		"\tif err = randomize.Struct(seed, &{{$varNameSingular}}, {{$varNameSingular}}DBTypes, true); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\ttx := MustTx(boil.Begin())\n" +
+		"\tdefer tx.Rollback()\n" +
+		"\tbefore, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &{{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}.Upsert(tx, {{if eq .DriverName \"postgres\"}}false, nil, {{end}}nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to upsert {{$tableNameSingular}}: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err := {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif count != before+1 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+1, count)\n" +
+		"\t}\n" +
+		"\n" +
+		"\t// Attempt the UPDATE side of an UPSERT\n" +
+		"\tif err = randomize.Struct(seed, &{{$varNameSingular}}, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}PrimaryKeyColumns...); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t}\n" +
+		"\tif err = {{$varNameSingular}}Parents(tx, &{{$varNameSingular}}); err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tif err = {{$varNameSingular}}.Upsert(tx, {{if eq .DriverName \"postgres\"}}true, nil, {{end}}nil); err != nil {\n" +
+		"\t\tt.Errorf(\"Unable to upsert {{$tableNameSingular}}: %s\", err)\n" +
+		"\t}\n" +
+		"\n" +
+		"\tcount, err = {{$tableNamePlural}}(tx).Count()\n" +
+		"\tif err != nil {\n" +
+		"\t\tt.Error(err)\n" +
+		"\t}\n" +
+		"\tif count != before+1 {\n" +
+		"\t\tt.Errorf(\"want %d records, got: %d\", before+1, count)\n" +
+		"\t}\n" +
+		"}\n",
+	"templates_test/validate.tpl": "{{- $tableNameSingular := .Table.Name | singular | titleCase -}}\n" +
+		"{{- $tableNamePlural := .Table.Name | plural | titleCase -}}\n" +
+		"{{- $varNameSingular := .Table.Name | singular | camelCase}}\n" +
+		"func test{{$tableNamePlural}}Validate(t *testing.T) {\n" +
+		"\tt.Parallel()\n" +
+		"\n" +
+		"\tseed := randomize.NewSeed()\n" +
+		"\tfor i := 0; i < 10; i++ {\n" +
+		"\t\t{{$varNameSingular}} := &{{$tableNameSingular}}{}\n" +
+		"\t\tif err := randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true); err != nil {\n" +
+		"\t\t\tt.Errorf(\"Unable to randomize {{$tableNameSingular}} struct: %s\", err)\n" +
+		"\t\t}\n" +
+		"\n" +
+		"\t\tif err := {{$varNameSingular}}.Validate(); err != nil {\n" +
+		"\t\t\tt.Errorf(\"Random {{$tableNameSingular}} should be valid: %s\", err)\n" +
+		"\t\t}\n" +
+		"\t}\n" +
+		"}\n",
+}
@@ -0,0 +1,97 @@
+// +build ignore
+
+// This program generates bindata.go from the templates and templates_test
+// folders. It is invoked by go generate from the templatebin directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var roots = []string{"templates", "templates_test"}
+
+func main() {
+	files := map[string][]byte{}
+
+	for _, root := range roots {
+		err := filepath.Walk(filepath.Join("..", root), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || filepath.Ext(path) != ".tpl" {
+				return nil
+			}
+
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			rel, err := filepath.Rel("..", path)
+			if err != nil {
+				return err
+			}
+
+			files[filepath.ToSlash(rel)] = b
+			return nil
+		})
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to read templates:", err)
+			os.Exit(1)
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("// Code generated by go generate; DO NOT EDIT.\n\n")
+	buf.WriteString("package templatebin\n\n")
+	buf.WriteString("var files = map[string]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(buf, "\t%q: %s,\n", name, quote(files[name]))
+	}
+	buf.WriteString("}\n")
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to format bindata:", err)
+		os.Exit(1)
+	}
+
+	if err = ioutil.WriteFile("bindata.go", out, 0664); err != nil {
+		fmt.Fprintln(os.Stderr, "failed to write bindata:", err)
+		os.Exit(1)
+	}
+}
+
+// quote splits the file into one string literal per line so that diffs of
+// the generated file stay readable.
+func quote(b []byte) string {
+	lines := strings.SplitAfter(string(b), "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return `""`
+	}
+
+	quoted := make([]string, len(lines))
+	for i, l := range lines {
+		quoted[i] = strconv.Quote(l)
+	}
+
+	return strings.Join(quoted, " +\n")
+}
@@ -0,0 +1,46 @@
+// Package templatebin holds the default sqlboiler templates compiled into
+// the binary so that it does not need a copy of the source tree at runtime.
+package templatebin
+
+//go:generate go run gen.go
+
+import (
+	"path"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Asset returns the contents of the named file, eg: templates/00_struct.tpl
+func Asset(name string) ([]byte, error) {
+	contents, ok := files[name]
+	if !ok {
+		return nil, errors.Errorf("asset %s not found", name)
+	}
+
+	return []byte(contents), nil
+}
+
+// AssetNames returns the sorted names of all the files in the bundle.
+func AssetNames() []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// AssetDir returns the names of the files directly inside of dir. Files in
+// sub-directories of dir are not included.
+func AssetDir(dir string) []string {
+	var names []string
+	for _, name := range AssetNames() {
+		if path.Dir(name) == dir {
+			names = append(names, path.Base(name))
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,80 @@
+package templatebin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBindataUpToDate(t *testing.T) {
+	t.Parallel()
+
+	onDisk := map[string]bool{}
+	for _, root := range []string{"templates", "templates_test"} {
+		err := filepath.Walk(filepath.Join("..", root), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || filepath.Ext(path) != ".tpl" {
+				return nil
+			}
+
+			rel, err := filepath.Rel("..", path)
+			if err != nil {
+				return err
+			}
+			name := filepath.ToSlash(rel)
+			onDisk[name] = true
+
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			asset, err := Asset(name)
+			if err != nil {
+				t.Errorf("%s is missing from bindata, run go generate", name)
+				return nil
+			}
+			if string(asset) != string(b) {
+				t.Errorf("%s is out of date in bindata, run go generate", name)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range AssetNames() {
+		if !onDisk[name] {
+			t.Errorf("%s is in bindata but not on disk, run go generate", name)
+		}
+	}
+}
+
+func TestAssetDir(t *testing.T) {
+	t.Parallel()
+
+	names := AssetDir("templates_test/main_test")
+	found := false
+	for _, n := range names {
+		if n == "postgres_main.tpl" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("want postgres_main.tpl in %v", names)
+	}
+
+	for _, n := range AssetDir("templates") {
+		if n == "singleton" || filepath.Ext(n) != ".tpl" {
+			t.Errorf("did not want %s in templates", n)
+		}
+	}
+
+	if _, err := Asset("templates/nope.tpl"); err == nil {
+		t.Error("want an error for a missing asset")
+	}
+}
@@ -7,23 +7,39 @@
 {{- $modelNameCamel := $tableNameSingular | camelCase -}}
 
 // {{$modelName}} is an object representing the database table.
+{{- if .Table.Comment}}
+//
+{{docComment .Table.Comment}}
+{{- end}}
 type {{$modelName}} struct {
 	{{range $column := .Table.Columns }}
+	{{- if $column.Comment}}
+	{{docComment $column.Comment}}
+	{{- end}}
 	{{- if eq $dot.StructTagCasing "camel"}}
 	{{titleCase $column.Name}} {{$column.Type}} `{{generateTags $dot.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name | camelCase}}" yaml:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}"`
-	{{- else -}}
+	{{- else}}
 	{{titleCase $column.Name}} {{$column.Type}} `{{generateTags $dot.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name}}" yaml:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}"`
-	{{end -}}
-	{{end -}}
+	{{- end}}
+	{{- end}}
 	{{- if .Table.IsJoinTable -}}
 	{{- else}}
 	R *{{$modelNameCamel}}R `{{generateIgnoreTags $dot.Tags}}boil:"-" json:"-" toml:"-" yaml:"-"`
 	L {{$modelNameCamel}}L `{{generateIgnoreTags $dot.Tags}}boil:"-" json:"-" toml:"-" yaml:"-"`
+	{{- if not .Table.IsView}}
+
+	// snapshot is a pointer so that == still compiles for models, but loaded
+	// models each have their own and never compare equal
+	snapshot *[]interface{} `boil:"-"`
+	{{- end}}
 	{{end -}}
 }
 
 var {{$modelName}}Columns = struct {
 	{{range $column := .Table.Columns -}}
+	{{if $column.Comment -}}
+	{{docComment $column.Comment}}
+	{{end -}}
 	{{titleCase $column.Name}} string
 	{{end -}}
 }{
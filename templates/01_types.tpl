@@ -9,7 +9,16 @@ var (
 	{{end -}}
 	{{$varNameSingular}}ColumnsWithoutDefault = []string{{"{"}}{{.Table.Columns | filterColumnsByDefault false | columnNames | stringMap .StringFuncs.quoteWrap | join ","}}{{"}"}}
 	{{$varNameSingular}}ColumnsWithDefault    = []string{{"{"}}{{.Table.Columns | filterColumnsByDefault true | columnNames | stringMap .StringFuncs.quoteWrap | join ","}}{{"}"}}
+	{{if .Table.PKey -}}
 	{{$varNameSingular}}PrimaryKeyColumns     = []string{{"{"}}{{.Table.PKey.Columns | stringMap .StringFuncs.quoteWrap | join ", "}}{{"}"}}
+	{{end -}}
+	{{if not .Table.IsView -}}
+	{{$varNameSingular}}ColumnLimits = map[string]boil.ColumnLimits{
+		{{range columnLimits .Table.Columns -}}
+		{{.}},
+		{{end -}}
+	}
+	{{end -}}
 )
 
 type (
@@ -19,6 +28,12 @@ type (
 	{{if not .NoHooks -}}
 	// {{$tableNameSingular}}Hook is the signature for custom {{$tableNameSingular}} hook methods
 	{{$tableNameSingular}}Hook func(boil.Executor, *{{$tableNameSingular}}) error
+	{{- if not .Table.IsView}}
+	// {{$tableNameSingular}}BulkHook is the signature for custom {{$tableNameSingular}} hook methods
+	// run around UpdateAll and DeleteAll. The query methods pass their query and a nil slice,
+	// the slice methods a nil query and their slice. cols is nil for DeleteAll.
+	{{$tableNameSingular}}BulkHook func(exec boil.Executor, q *queries.Query, o {{$tableNameSingular}}Slice, cols M) error
+	{{- end}}
 	{{- end}}
 
 	{{$varNameSingular}}Query struct {
@@ -30,13 +45,18 @@ type (
 var (
 	{{$varNameSingular}}Type = reflect.TypeOf(&{{$tableNameSingular}}{})
 	{{$varNameSingular}}Mapping = queries.MakeStructMapping({{$varNameSingular}}Type)
+	{{if .Table.PKey -}}
 	{{$varNameSingular}}PrimaryKeyMapping, _ = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, {{$varNameSingular}}PrimaryKeyColumns)
+	{{end -}}
+	{{if not .Table.IsView -}}
+	{{$varNameSingular}}ColumnsMapping, _ = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, {{$varNameSingular}}Columns)
 	{{$varNameSingular}}InsertCacheMut sync.RWMutex
 	{{$varNameSingular}}InsertCache = make(map[string]insertCache)
 	{{$varNameSingular}}UpdateCacheMut sync.RWMutex
 	{{$varNameSingular}}UpdateCache = make(map[string]updateCache)
 	{{$varNameSingular}}UpsertCacheMut sync.RWMutex
 	{{$varNameSingular}}UpsertCache = make(map[string]insertCache)
+	{{end -}}
 )
 
 var (
@@ -44,5 +64,13 @@ var (
 	_ = time.Second
 	// Force bytes in case of primary key column that uses []byte (for relationship compares)
 	_ = bytes.MinRead
+	{{- if .Table.IsView}}
+	// Force the dependencies of the write operations and Find which views
+	// may go without.
+	_ sync.Mutex
+	_ = fmt.Sprintf
+	_ = strings.Join
+	_ = strmangle.IdentQuoteSlice
+	{{- end}}
 )
 {{end -}}
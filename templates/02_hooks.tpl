@@ -1,6 +1,9 @@
 {{- if not .NoHooks -}}
 {{- $tableNameSingular := .Table.Name | singular | titleCase -}}
 {{- $varNameSingular := .Table.Name | singular | camelCase -}}
+{{- if .Table.IsView}}
+var {{$varNameSingular}}AfterSelectHooks []{{$tableNameSingular}}Hook
+{{- else}}
 var {{$varNameSingular}}BeforeInsertHooks []{{$tableNameSingular}}Hook
 var {{$varNameSingular}}BeforeUpdateHooks []{{$tableNameSingular}}Hook
 var {{$varNameSingular}}BeforeDeleteHooks []{{$tableNameSingular}}Hook
@@ -12,8 +15,23 @@ var {{$varNameSingular}}AfterUpdateHooks []{{$tableNameSingular}}Hook
 var {{$varNameSingular}}AfterDeleteHooks []{{$tableNameSingular}}Hook
 var {{$varNameSingular}}AfterUpsertHooks []{{$tableNameSingular}}Hook
 
+var {{$varNameSingular}}BeforeUpdateAllHooks []{{$tableNameSingular}}BulkHook
+var {{$varNameSingular}}BeforeDeleteAllHooks []{{$tableNameSingular}}BulkHook
+
+var {{$varNameSingular}}AfterUpdateAllHooks []{{$tableNameSingular}}BulkHook
+var {{$varNameSingular}}AfterDeleteAllHooks []{{$tableNameSingular}}BulkHook
+
+var {{$varNameSingular}}AfterCommitHooks []{{$tableNameSingular}}Hook
+var {{$varNameSingular}}AfterRollbackHooks []{{$tableNameSingular}}Hook
+{{- end}}
+
+{{if not .Table.IsView -}}
 // doBeforeInsertHooks executes all "before insert" hooks.
 func (o *{{$tableNameSingular}}) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}BeforeInsertHooks {
 		if err := hook(exec, o); err != nil {
 			return err
@@ -25,6 +43,10 @@ func (o *{{$tableNameSingular}}) doBeforeInsertHooks(exec boil.Executor) (err er
 
 // doBeforeUpdateHooks executes all "before Update" hooks.
 func (o *{{$tableNameSingular}}) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}BeforeUpdateHooks {
 		if err := hook(exec, o); err != nil {
 			return err
@@ -36,6 +58,10 @@ func (o *{{$tableNameSingular}}) doBeforeUpdateHooks(exec boil.Executor) (err er
 
 // doBeforeDeleteHooks executes all "before Delete" hooks.
 func (o *{{$tableNameSingular}}) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}BeforeDeleteHooks {
 		if err := hook(exec, o); err != nil {
 			return err
@@ -47,6 +73,10 @@ func (o *{{$tableNameSingular}}) doBeforeDeleteHooks(exec boil.Executor) (err er
 
 // doBeforeUpsertHooks executes all "before Upsert" hooks.
 func (o *{{$tableNameSingular}}) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}BeforeUpsertHooks {
 		if err := hook(exec, o); err != nil {
 			return err
@@ -58,17 +88,27 @@ func (o *{{$tableNameSingular}}) doBeforeUpsertHooks(exec boil.Executor) (err er
 
 // doAfterInsertHooks executes all "after Insert" hooks.
 func (o *{{$tableNameSingular}}) doAfterInsertHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}AfterInsertHooks {
 		if err := hook(exec, o); err != nil {
 			return err
 		}
 	}
 
+	o.doAfterTxHooks(exec)
 	return nil
 }
 
+{{end -}}
 // doAfterSelectHooks executes all "after Select" hooks.
 func (o *{{$tableNameSingular}}) doAfterSelectHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}AfterSelectHooks {
 		if err := hook(exec, o); err != nil {
 			return err
@@ -78,42 +118,106 @@ func (o *{{$tableNameSingular}}) doAfterSelectHooks(exec boil.Executor) (err err
 	return nil
 }
 
+{{if not .Table.IsView -}}
 // doAfterUpdateHooks executes all "after Update" hooks.
 func (o *{{$tableNameSingular}}) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}AfterUpdateHooks {
 		if err := hook(exec, o); err != nil {
 			return err
 		}
 	}
 
+	o.doAfterTxHooks(exec)
 	return nil
 }
 
 // doAfterDeleteHooks executes all "after Delete" hooks.
 func (o *{{$tableNameSingular}}) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}AfterDeleteHooks {
 		if err := hook(exec, o); err != nil {
 			return err
 		}
 	}
 
+	o.doAfterTxHooks(exec)
 	return nil
 }
 
 // doAfterUpsertHooks executes all "after Upsert" hooks.
 func (o *{{$tableNameSingular}}) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
 	for _, hook := range {{$varNameSingular}}AfterUpsertHooks {
 		if err := hook(exec, o); err != nil {
 			return err
 		}
 	}
 
+	o.doAfterTxHooks(exec)
 	return nil
 }
 
+// doAfterTxHooks registers the "after commit" and "after rollback" hooks to run
+// once the transaction o was written in ends, it is called once the write and
+// its "after" hooks succeeded. Their errors are dropped, the transaction being
+// over by then.
+func (o *{{$tableNameSingular}}) doAfterTxHooks(exec boil.Executor) {
+	if len({{$varNameSingular}}AfterCommitHooks) != 0 {
+		boil.AfterCommit(exec, func() {
+			for _, hook := range {{$varNameSingular}}AfterCommitHooks {
+				if err := hook(exec, o); err != nil {
+					return
+				}
+			}
+		})
+	}
+
+	if len({{$varNameSingular}}AfterRollbackHooks) != 0 {
+		boil.AfterRollback(exec, func() {
+			for _, hook := range {{$varNameSingular}}AfterRollbackHooks {
+				if err := hook(exec, o); err != nil {
+					return
+				}
+			}
+		})
+	}
+}
+
+// do{{$tableNameSingular}}BulkHooks executes the given bulk hooks, q or o is nil
+// depending on whether they run for a query or a slice.
+func do{{$tableNameSingular}}BulkHooks(hooks []{{$tableNameSingular}}BulkHook, exec boil.Executor, q *queries.Query, o {{$tableNameSingular}}Slice, cols M) error {
+	if boil.HooksAreSkipped(exec) {
+		return nil
+	}
+
+	for _, hook := range hooks {
+		if err := hook(exec, q, o, cols); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+{{end -}}
 // Add{{$tableNameSingular}}Hook registers your hook function for all future operations.
+{{- if not .Table.IsView}}
+// The boil.AfterCommitHook and boil.AfterRollbackHook hooks run once the transaction
+// ends, see boil.AfterCommit, so the errors they return are dropped.
+{{- end}}
 func Add{{$tableNameSingular}}Hook(hookPoint boil.HookPoint, {{$varNameSingular}}Hook {{$tableNameSingular}}Hook) {
 	switch hookPoint {
+		{{- if not .Table.IsView}}
 		case boil.BeforeInsertHook:
 			{{$varNameSingular}}BeforeInsertHooks = append({{$varNameSingular}}BeforeInsertHooks, {{$varNameSingular}}Hook)
 		case boil.BeforeUpdateHook:
@@ -124,14 +228,39 @@ func Add{{$tableNameSingular}}Hook(hookPoint boil.HookPoint, {{$varNameSingular}
 			{{$varNameSingular}}BeforeUpsertHooks = append({{$varNameSingular}}BeforeUpsertHooks, {{$varNameSingular}}Hook)
 		case boil.AfterInsertHook:
 			{{$varNameSingular}}AfterInsertHooks = append({{$varNameSingular}}AfterInsertHooks, {{$varNameSingular}}Hook)
+		{{- end}}
 		case boil.AfterSelectHook:
 			{{$varNameSingular}}AfterSelectHooks = append({{$varNameSingular}}AfterSelectHooks, {{$varNameSingular}}Hook)
+		{{- if not .Table.IsView}}
 		case boil.AfterUpdateHook:
 			{{$varNameSingular}}AfterUpdateHooks = append({{$varNameSingular}}AfterUpdateHooks, {{$varNameSingular}}Hook)
 		case boil.AfterDeleteHook:
 			{{$varNameSingular}}AfterDeleteHooks = append({{$varNameSingular}}AfterDeleteHooks, {{$varNameSingular}}Hook)
 		case boil.AfterUpsertHook:
 			{{$varNameSingular}}AfterUpsertHooks = append({{$varNameSingular}}AfterUpsertHooks, {{$varNameSingular}}Hook)
+		case boil.AfterCommitHook:
+			{{$varNameSingular}}AfterCommitHooks = append({{$varNameSingular}}AfterCommitHooks, {{$varNameSingular}}Hook)
+		case boil.AfterRollbackHook:
+			{{$varNameSingular}}AfterRollbackHooks = append({{$varNameSingular}}AfterRollbackHooks, {{$varNameSingular}}Hook)
+		{{- end}}
 	}
 }
+{{- if not .Table.IsView}}
+
+// Add{{$tableNameSingular}}BulkHook registers your hook function for all future UpdateAll
+// and DeleteAll operations, hookPoint is one of boil.BeforeUpdateAllHook, boil.AfterUpdateAllHook,
+// boil.BeforeDeleteAllHook and boil.AfterDeleteAllHook.
+func Add{{$tableNameSingular}}BulkHook(hookPoint boil.HookPoint, {{$varNameSingular}}BulkHook {{$tableNameSingular}}BulkHook) {
+	switch hookPoint {
+		case boil.BeforeUpdateAllHook:
+			{{$varNameSingular}}BeforeUpdateAllHooks = append({{$varNameSingular}}BeforeUpdateAllHooks, {{$varNameSingular}}BulkHook)
+		case boil.AfterUpdateAllHook:
+			{{$varNameSingular}}AfterUpdateAllHooks = append({{$varNameSingular}}AfterUpdateAllHooks, {{$varNameSingular}}BulkHook)
+		case boil.BeforeDeleteAllHook:
+			{{$varNameSingular}}BeforeDeleteAllHooks = append({{$varNameSingular}}BeforeDeleteAllHooks, {{$varNameSingular}}BulkHook)
+		case boil.AfterDeleteAllHook:
+			{{$varNameSingular}}AfterDeleteAllHooks = append({{$varNameSingular}}AfterDeleteAllHooks, {{$varNameSingular}}BulkHook)
+	}
+}
+{{- end}}
 {{- end}}
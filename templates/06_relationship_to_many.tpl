@@ -28,7 +28,7 @@ func (o *{{$txt.LocalTable.NameGo}}) {{$txt.Function.Name}}(exec boil.Executor,
 	)
 		{{else -}}
 	queryMods = append(queryMods,
-		qm.Where("{{$schemaForeignTable}}.{{.ForeignColumn | $dot.Quotes}}=?", o.{{$txt.LocalTable.ColumnNameGo}}),
+		qm.Where("{{range $i, $col := $txt.Columns}}{{if $i}} AND {{end}}{{$schemaForeignTable}}.{{$col.ForeignColumn | $dot.Quotes}}=?{{end}}"{{range $txt.Columns}}, o.{{.LocalColumnGo}}{{end}}),
 	)
 		{{end}}
 
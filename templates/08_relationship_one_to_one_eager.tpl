@@ -19,25 +19,32 @@ func ({{$varNameSingular}}L) Load{{$txt.Function.Name}}(e boil.Executor, singula
 		count = len(slice)
 	}
 
-	args := make([]interface{}, count)
+	args := make([]interface{}, 0, count{{if gt (len $txt.Columns) 1}}*{{len $txt.Columns}}{{end}})
 	if singular {
 		if object.R == nil {
 			object.R = &{{$varNameSingular}}R{}
 		}
-		args[0] = object.{{$txt.LocalTable.ColumnNameGo}}
+		args = append(args{{range $txt.Columns}}, object.{{.LocalColumnGo}}{{end}})
 	} else {
-		for i, obj := range slice {
+		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &{{$varNameSingular}}R{}
 			}
-			args[i] = obj.{{$txt.LocalTable.ColumnNameGo}}
+			args = append(args{{range $txt.Columns}}, obj.{{.LocalColumnGo}}{{end}})
 		}
 	}
 
+	{{if gt (len $txt.Columns) 1 -}}
 	query := fmt.Sprintf(
-		"select * from {{.ForeignTable | $dot.SchemaTable}} where {{.ForeignColumn | $dot.Quotes}} in (%s)",
+		"select * from {{.ForeignTable | $dot.SchemaTableIn "e"}} where %s",
+		strmangle.WhereClauseRepeated("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}{{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}"{{$col.ForeignColumn}}"{{end}}{{"}"}}, count),
+	)
+	{{- else -}}
+	query := fmt.Sprintf(
+		"select * from {{.ForeignTable | $dot.SchemaTableIn "e"}} where {{.ForeignColumn | $dot.Quotes}} in (%s)",
 		strmangle.Placeholders(dialect.IndexPlaceholders, count, 1, 1),
 	)
+	{{- end}}
 
 	if boil.DebugMode {
 		fmt.Fprintf(boil.DebugWriter, "%s\n%v\n", query, args)
@@ -75,11 +82,7 @@ func ({{$varNameSingular}}L) Load{{$txt.Function.Name}}(e boil.Executor, singula
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			{{if $txt.Function.UsesBytes -}}
-			if 0 == bytes.Compare(local.{{$txt.Function.LocalAssignment}}, foreign.{{$txt.Function.ForeignAssignment}}) {
-			{{else -}}
-			if local.{{$txt.Function.LocalAssignment}} == foreign.{{$txt.Function.ForeignAssignment}} {
-			{{end -}}
+			if {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(local.{{$col.LocalAssignment}}, foreign.{{$col.ForeignAssignment}}){{else}}local.{{$col.LocalAssignment}} == foreign.{{$col.ForeignAssignment}}{{end}}{{end}} {
 				local.R.{{$txt.Function.Name}} = foreign
 				break
 			}
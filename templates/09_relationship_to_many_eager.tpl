@@ -5,7 +5,7 @@
 		{{- $varNameSingular := $dot.Table.Name | singular | camelCase -}}
 		{{- $txt := txtsFromToMany $dot.Tables $dot.Table . -}}
 		{{- $arg := printf "maybe%s" $txt.LocalTable.NameGo -}}
-		{{- $schemaForeignTable := .ForeignTable | $dot.SchemaTable}}
+		{{- $schemaForeignTable := .ForeignTable | $dot.SchemaTableIn "e"}}
 // Load{{$txt.Function.Name}} allows an eager lookup of values, cached into the
 // loaded structs of the objects.
 func ({{$varNameSingular}}L) Load{{$txt.Function.Name}}(e boil.Executor, singular bool, {{$arg}} interface{}) error {
@@ -20,26 +20,31 @@ func ({{$varNameSingular}}L) Load{{$txt.Function.Name}}(e boil.Executor, singula
 		count = len(slice)
 	}
 
-	args := make([]interface{}, count)
+	args := make([]interface{}, 0, count{{if gt (len $txt.Columns) 1}}*{{len $txt.Columns}}{{end}})
 	if singular {
 		if object.R == nil {
 			object.R = &{{$varNameSingular}}R{}
 		}
-		args[0] = object.{{.Column | titleCase}}
+		args = append(args{{range $txt.Columns}}, object.{{.LocalColumnGo}}{{end}})
 	} else {
-		for i, obj := range slice {
+		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &{{$varNameSingular}}R{}
 			}
-			args[i] = obj.{{.Column | titleCase}}
+			args = append(args{{range $txt.Columns}}, obj.{{.LocalColumnGo}}{{end}})
 		}
 	}
 
 		{{if .ToJoinTable -}}
-			{{- $schemaJoinTable := .JoinTable | $dot.SchemaTable -}}
+			{{- $schemaJoinTable := .JoinTable | $dot.SchemaTableIn "e" -}}
 	query := fmt.Sprintf(
 		"select {{id 0 | $dot.Quotes}}.*, {{id 1 | $dot.Quotes}}.{{.JoinLocalColumn | $dot.Quotes}} from {{$schemaForeignTable}} as {{id 0 | $dot.Quotes}} inner join {{$schemaJoinTable}} as {{id 1 | $dot.Quotes}} on {{id 0 | $dot.Quotes}}.{{.ForeignColumn | $dot.Quotes}} = {{id 1 | $dot.Quotes}}.{{.JoinForeignColumn | $dot.Quotes}} where {{id 1 | $dot.Quotes}}.{{.JoinLocalColumn | $dot.Quotes}} in (%s)",
 		strmangle.Placeholders(dialect.IndexPlaceholders, count, 1, 1),
+	)
+		{{else if gt (len $txt.Columns) 1 -}}
+	query := fmt.Sprintf(
+		"select * from {{$schemaForeignTable}} where %s",
+		strmangle.WhereClauseRepeated("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}{{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}"{{$col.ForeignColumn}}"{{end}}{{"}"}}, count),
 	)
 		{{else -}}
 	query := fmt.Sprintf(
@@ -118,11 +123,7 @@ func ({{$varNameSingular}}L) Load{{$txt.Function.Name}}(e boil.Executor, singula
 	{{else -}}
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			{{if $txt.Function.UsesBytes -}}
-			if 0 == bytes.Compare(local.{{$txt.Function.LocalAssignment}}, foreign.{{$txt.Function.ForeignAssignment}}) {
-			{{else -}}
-			if local.{{$txt.Function.LocalAssignment}} == foreign.{{$txt.Function.ForeignAssignment}} {
-			{{end -}}
+			if {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(local.{{$col.LocalAssignment}}, foreign.{{$col.ForeignAssignment}}){{else}}local.{{$col.LocalAssignment}} == foreign.{{$col.ForeignAssignment}}{{end}}{{end}} {
 				local.R.{{$txt.Function.Name}} = append(local.R.{{$txt.Function.Name}}, foreign)
 				break
 			}
@@ -1,7 +1,11 @@
-{{- if .Table.IsJoinTable -}}
+{{- if or .Table.IsJoinTable .Table.IsView -}}
 {{- else -}}
 	{{- $dot := . -}}
+	{{- $ret := "error" -}}{{- $z := "" -}}{{- $aff := "" -}}
+	{{- if .RowsAffected -}}{{- $ret = "(int64, error)" -}}{{- $z = "0, " -}}{{- $aff = "rowsAff, " -}}{{- end -}}
 	{{- range .Table.FKeys -}}
+		{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+		{{- else -}}
 		{{- $txt := txtsFromFKey $dot.Tables $dot.Table . -}}
 		{{- $foreignNameSingular := .ForeignTable | singular | camelCase -}}
 		{{- $varNameSingular := .Table | singular | camelCase}}
@@ -10,7 +14,7 @@
 // Sets o.R.{{$txt.Function.Name}} to related.
 // Adds o to related.R.{{$txt.Function.ForeignName}}.
 // Uses the global database handle.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related *{{$txt.ForeignTable.NameGo}}) error {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	return o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related)
 }
 
@@ -18,54 +22,89 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, rel
 // Sets o.R.{{$txt.Function.Name}} to related.
 // Adds o to related.R.{{$txt.Function.ForeignName}}.
 // Panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Set{{$txt.Function.Name}}(exec, insert, related)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Set{{$txt.Function.Name}}(exec, insert, related); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Set{{$txt.Function.Name}}GP of the {{.Table | singular}} to the related item.
 // Sets o.R.{{$txt.Function.Name}} to related.
 // Adds o to related.R.{{$txt.Function.ForeignName}}.
 // Uses the global database handle and panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related *{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Set{{$txt.Function.Name}} of the {{.Table | singular}} to the related item.
 // Sets o.R.{{$txt.Function.Name}} to related.
 // Adds o to related.R.{{$txt.Function.ForeignName}}.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}) error {
+{{- if $dot.RowsAffected}}
+// Returns the number of rows affected by updating the {{.Table | singular}}.
+{{- end}}
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	var err error
 	if insert {
 		if err = related.Insert(exec); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			return {{$z}}errors.Wrap(err, "failed to insert into foreign table")
 		}
 	}
 
 	updateQuery := fmt.Sprintf(
 		"UPDATE {{$schemaTable}} SET %s WHERE %s",
-		strmangle.SetParamNames("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.Column}}"{{"}"}}),
-		strmangle.WhereClause("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}2{{else}}0{{end}}, {{$varNameSingular}}PrimaryKeyColumns),
+		strmangle.SetParamNames("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.Columns | join "\", \""}}"{{"}"}}),
+		strmangle.WhereClause("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}{{len .Columns}}+1{{else}}0{{end}}, {{$varNameSingular}}PrimaryKeyColumns),
 	)
-	values := []interface{}{related.{{$txt.ForeignTable.ColumnNameGo}}, o.{{$dot.Table.PKey.Columns | stringMap $dot.StringFuncs.titleCase | join ", o."}}{{"}"}}
+	values := []interface{}{{"{"}}{{range $txt.Columns}}related.{{.ForeignColumnGo}}, {{end}}o.{{$dot.Table.PKey.Columns | stringMap $dot.StringFuncs.titleCase | join ", o."}}{{"}"}}
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, updateQuery)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
 
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	{{if $dot.RowsAffected -}}
+	result, err := exec.Exec(updateQuery, values...)
+	if err != nil {
+		return 0, errors.Wrap(boil.ClassifyError(err), "failed to update local table")
 	}
 
-	o.{{$txt.Function.LocalAssignment}} = related.{{$txt.Function.ForeignAssignment}}
-	{{if .Nullable -}}
-	o.{{$txt.LocalTable.ColumnNameGo}}.Valid = true
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get rows affected by update of local table")
+	}
+	{{- else -}}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(boil.ClassifyError(err), "failed to update local table")
+	}
 	{{- end}}
 
+	{{range $txt.Columns -}}
+	o.{{.LocalAssignment}} = related.{{.ForeignAssignment}}
+	{{if .LocalNullable -}}
+	o.{{.LocalColumnGo}}.Valid = true
+	{{end -}}
+	{{end}}
+
 	if o.R == nil {
 		o.R = &{{$varNameSingular}}R{
 			{{$txt.Function.Name}}: related,
@@ -92,7 +131,7 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executo
 	}
 	{{- end}}
 
-	return nil
+	return {{$aff}}nil
 }
 
 		{{- if .Nullable}}
@@ -100,7 +139,7 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executo
 // Sets o.R.{{$txt.Function.Name}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
 // Uses the global database handle.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related *{{$txt.ForeignTable.NameGo}}) error {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	return o.Remove{{$txt.Function.Name}}(boil.GetDB(), related)
 }
 
@@ -108,48 +147,72 @@ func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related *{{$t
 // Sets o.R.{{$txt.Function.Name}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
 // Panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Remove{{$txt.Function.Name}}(exec, related)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Remove{{$txt.Function.Name}}(exec, related); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Remove{{$txt.Function.Name}}GP relationship.
 // Sets o.R.{{$txt.Function.Name}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
 // Uses the global database handle and panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related *{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Remove{{$txt.Function.Name}} relationship.
 // Sets o.R.{{$txt.Function.Name}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}) error {
+{{- if $dot.RowsAffected}}
+// Returns the number of rows affected by updating the {{.Table | singular}}.
+{{- end}}
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	var err error
+	{{- if $dot.RowsAffected}}
+	var rowsAff int64
+	{{- end}}
 
-	o.{{$txt.LocalTable.ColumnNameGo}}.Valid = false
-	if err = o.Update(exec, "{{.Column}}"); err != nil {
-		o.{{$txt.LocalTable.ColumnNameGo}}.Valid = true
-		return errors.Wrap(err, "failed to update local table")
+	{{range $txt.Columns -}}
+	o.{{.LocalColumnGo}}.Valid = false
+	{{end -}}
+	if {{$aff}}err = o.Update(exec, "{{.Columns | join "\", \""}}"); err != nil {
+		{{range $txt.Columns -}}
+		o.{{.LocalColumnGo}}.Valid = true
+		{{end -}}
+		return {{$z}}errors.Wrap(err, "failed to update local table")
 	}
 
 	o.R.{{$txt.Function.Name}} = nil
 	if related == nil || related.R == nil {
-		return nil
+		return {{$aff}}nil
 	}
 
 	{{if .Unique -}}
 	related.R.{{$txt.Function.ForeignName}} = nil
 	{{else -}}
 	for i, ri := range related.R.{{$txt.Function.ForeignName}} {
-		{{if $txt.Function.UsesBytes -}}
-		if 0 != bytes.Compare(o.{{$txt.Function.LocalAssignment}}, ri.{{$txt.Function.LocalAssignment}}) {
-		{{else -}}
-		if o.{{$txt.Function.LocalAssignment}} != ri.{{$txt.Function.LocalAssignment}} {
-		{{end -}}
+		if {{range $i, $col := $txt.Columns}}{{if $i}} || {{end}}{{if $col.UsesBytes}}0 != bytes.Compare(o.{{$col.LocalAssignment}}, ri.{{$col.LocalAssignment}}){{else}}o.{{$col.LocalAssignment}} != ri.{{$col.LocalAssignment}}{{end}}{{end}} {
 			continue
 		}
 
@@ -162,8 +225,9 @@ func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Exec
 	}
 	{{end -}}
 
-	return nil
+	return {{$aff}}nil
 }
 {{end -}}{{/* if foreignkey nullable */}}
+{{- end -}}{{/* if foreign view */}}
 {{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
@@ -1,7 +1,11 @@
-{{- if .Table.IsJoinTable -}}
+{{- if or .Table.IsJoinTable .Table.IsView -}}
 {{- else -}}
 	{{- $dot := . -}}
+	{{- $ret := "error" -}}{{- $z := "" -}}{{- $aff := "" -}}
+	{{- if .RowsAffected -}}{{- $ret = "(int64, error)" -}}{{- $z = "0, " -}}{{- $aff = "rowsAff, " -}}{{- end -}}
 	{{- range .Table.ToOneRelationships -}}
+		{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+		{{- else -}}
 		{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table . -}}
 		{{- $varNameSingular := .Table | singular | camelCase -}}
 		{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase -}}
@@ -11,7 +15,7 @@
 // Sets o.R.{{$txt.Function.Name}} to related.
 // Adds o to related.R.{{$txt.Function.ForeignName}}.
 // Uses the global database handle.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related *{{$txt.ForeignTable.NameGo}}) error {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	return o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related)
 }
 
@@ -19,58 +23,100 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, rel
 // Sets o.R.{{$txt.Function.Name}} to related.
 // Adds o to related.R.{{$txt.Function.ForeignName}}.
 // Panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Set{{$txt.Function.Name}}(exec, insert, related)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Set{{$txt.Function.Name}}(exec, insert, related); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Set{{$txt.Function.Name}}GP of the {{.Table | singular}} to the related item.
 // Sets o.R.{{$txt.Function.Name}} to related.
 // Adds o to related.R.{{$txt.Function.ForeignName}}.
 // Uses the global database handle and panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related *{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Set{{$txt.Function.Name}} of the {{.Table | singular}} to the related item.
 // Sets o.R.{{$txt.Function.Name}} to related.
 // Adds o to related.R.{{$txt.Function.ForeignName}}.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}) error {
+{{- if $dot.RowsAffected}}
+// Returns the number of rows affected by inserting or updating related.
+{{- end}}
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	var err error
+	{{- if $dot.RowsAffected}}
+	var rowsAff int64
+	{{- end}}
 
 	if insert {
-		related.{{$txt.Function.ForeignAssignment}} = o.{{$txt.Function.LocalAssignment}}
-		{{if .ForeignColumnNullable -}}
-		related.{{$txt.ForeignTable.ColumnNameGo}}.Valid = true
-		{{- end}}
+		{{range $txt.Columns -}}
+		related.{{.ForeignAssignment}} = o.{{.LocalAssignment}}
+		{{if .ForeignNullable -}}
+		related.{{.ForeignColumnGo}}.Valid = true
+		{{end -}}
+		{{end}}
 
 		if err = related.Insert(exec); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			return {{$z}}errors.Wrap(err, "failed to insert into foreign table")
 		}
+		{{- if $dot.RowsAffected}}
+		rowsAff = 1
+		{{- end}}
 	} else {
 		updateQuery := fmt.Sprintf(
 			"UPDATE {{$foreignSchemaTable}} SET %s WHERE %s",
-			strmangle.SetParamNames("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumn}}"{{"}"}}),
-			strmangle.WhereClause("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}2{{else}}0{{end}}, {{$foreignVarNameSingular}}PrimaryKeyColumns),
+			strmangle.SetParamNames("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumns | join "\", \""}}"{{"}"}}),
+			strmangle.WhereClause("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}{{len .ForeignColumns}}+1{{else}}0{{end}}, {{$foreignVarNameSingular}}PrimaryKeyColumns),
 		)
-		values := []interface{}{o.{{$txt.LocalTable.ColumnNameGo}}, related.{{$foreignPKeyCols | stringMap $dot.StringFuncs.titleCase | join ", related."}}{{"}"}}
+		values := []interface{}{{"{"}}{{range $txt.Columns}}o.{{.LocalColumnGo}}, {{end}}related.{{$foreignPKeyCols | stringMap $dot.StringFuncs.titleCase | join ", related."}}{{"}"}}
 
 		if boil.DebugMode {
 			fmt.Fprintln(boil.DebugWriter, updateQuery)
 			fmt.Fprintln(boil.DebugWriter, values)
 		}
 
-		if _, err = exec.Exec(updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+		{{if $dot.RowsAffected -}}
+		result, err := exec.Exec(updateQuery, values...)
+		if err != nil {
+			return 0, errors.Wrap(boil.ClassifyError(err), "failed to update foreign table")
 		}
 
-		related.{{$txt.Function.ForeignAssignment}} = o.{{$txt.Function.LocalAssignment}}
-		{{if .ForeignColumnNullable -}}
-		related.{{$txt.ForeignTable.ColumnNameGo}}.Valid = true
+		if rowsAff, err = result.RowsAffected(); err != nil {
+			return 0, errors.Wrap(err, "failed to get rows affected by update of foreign table")
+		}
+		{{- else -}}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(boil.ClassifyError(err), "failed to update foreign table")
+		}
 		{{- end}}
+
+		{{range $txt.Columns -}}
+		related.{{.ForeignAssignment}} = o.{{.LocalAssignment}}
+		{{if .ForeignNullable -}}
+		related.{{.ForeignColumnGo}}.Valid = true
+		{{end -}}
+		{{end}}
 	}
 
 
@@ -89,7 +135,7 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executo
 	} else {
 		related.R.{{$txt.Function.ForeignName}} = o
 	}
-	return nil
+	return {{$aff}}nil
 }
 
 		{{- if .ForeignColumnNullable}}
@@ -97,7 +143,7 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executo
 // Sets o.R.{{$txt.Function.Name}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
 // Uses the global database handle.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related *{{$txt.ForeignTable.NameGo}}) error {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	return o.Remove{{$txt.Function.Name}}(boil.GetDB(), related)
 }
 
@@ -105,42 +151,71 @@ func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related *{{$t
 // Sets o.R.{{$txt.Function.Name}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
 // Panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Remove{{$txt.Function.Name}}(exec, related)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Remove{{$txt.Function.Name}}(exec, related); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Remove{{$txt.Function.Name}}GP relationship.
 // Sets o.R.{{$txt.Function.Name}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
 // Uses the global database handle and panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related *{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related *{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Remove{{$txt.Function.Name}} relationship.
 // Sets o.R.{{$txt.Function.Name}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}) error {
+{{- if $dot.RowsAffected}}
+// Returns the number of rows affected by updating related.
+{{- end}}
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related *{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	var err error
-
-	related.{{$txt.ForeignTable.ColumnNameGo}}.Valid = false
-	if err = related.Update(exec, "{{.ForeignColumn}}"); err != nil {
-		related.{{$txt.ForeignTable.ColumnNameGo}}.Valid = true
-		return errors.Wrap(err, "failed to update local table")
+	{{- if $dot.RowsAffected}}
+	var rowsAff int64
+	{{- end}}
+
+	{{range $txt.Columns -}}
+	related.{{.ForeignColumnGo}}.Valid = false
+	{{end -}}
+	if {{$aff}}err = related.Update(exec, "{{.ForeignColumns | join "\", \""}}"); err != nil {
+		{{range $txt.Columns -}}
+		related.{{.ForeignColumnGo}}.Valid = true
+		{{end -}}
+		return {{$z}}errors.Wrap(err, "failed to update local table")
 	}
 
 	o.R.{{$txt.Function.Name}} = nil
 	if related == nil || related.R == nil {
-		return nil
+		return {{$aff}}nil
 	}
 
 	related.R.{{$txt.Function.ForeignName}} = nil
-	return nil
+	return {{$aff}}nil
 }
 {{end -}}{{/* if foreignkey nullable */}}
+{{- end -}}{{/* if foreign view */}}
 {{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
@@ -1,8 +1,12 @@
-{{- if .Table.IsJoinTable -}}
+{{- if or .Table.IsJoinTable .Table.IsView -}}
 {{- else -}}
 	{{- $dot := . -}}
+	{{- $ret := "error" -}}{{- $z := "" -}}{{- $aff := "" -}}
+	{{- if .RowsAffected -}}{{- $ret = "(int64, error)" -}}{{- $z = "0, " -}}{{- $aff = "rowsAff, " -}}{{- end -}}
 	{{- $table := .Table -}}
 	{{- range .Table.ToManyRelationships -}}
+		{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+		{{- else -}}
 		{{- $txt := txtsFromToMany $dot.Tables $table . -}}
 		{{- $varNameSingular := .Table | singular | camelCase -}}
 		{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase}}
@@ -13,7 +17,7 @@
 // Appends related to o.R.{{$txt.Function.Name}}.
 // Sets related.R.{{$txt.Function.ForeignName}} appropriately.
 // Uses the global database handle.
-func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}G(insert bool, related ...*{{$txt.ForeignTable.NameGo}}) error {
+func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}G(insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	return o.Add{{$txt.Function.Name}}(boil.GetDB(), insert, related...)
 }
 
@@ -22,10 +26,19 @@ func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}G(insert bool, rel
 // Appends related to o.R.{{$txt.Function.Name}}.
 // Sets related.R.{{$txt.Function.ForeignName}} appropriately.
 // Panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Add{{$txt.Function.Name}}(exec, insert, related...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Add{{$txt.Function.Name}}(exec, insert, related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Add{{$txt.Function.Name}}GP adds the given related objects to the existing relationships
@@ -33,50 +46,85 @@ func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}P(exec boil.Execut
 // Appends related to o.R.{{$txt.Function.Name}}.
 // Sets related.R.{{$txt.Function.ForeignName}} appropriately.
 // Uses the global database handle and panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}GP(insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}GP(insert bool, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Add{{$txt.Function.Name}}(boil.GetDB(), insert, related...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Add{{$txt.Function.Name}}(boil.GetDB(), insert, related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Add{{$txt.Function.Name}} adds the given related objects to the existing relationships
 // of the {{$table.Name | singular}}, optionally inserting them as new records.
 // Appends related to o.R.{{$txt.Function.Name}}.
 // Sets related.R.{{$txt.Function.ForeignName}} appropriately.
-func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}) error {
+{{- if $dot.RowsAffected}}
+// Returns the number of rows affected by {{if .ToJoinTable}}inserting into {{.JoinTable}}{{else}}inserting or updating related{{end}}.
+{{- end}}
+func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	var err error
+	{{- if $dot.RowsAffected}}
+	var rowsAff int64
+	{{- end}}
 	for _, rel := range related {
 		if insert {
 			{{if not .ToJoinTable -}}
-			rel.{{$txt.Function.ForeignAssignment}} = o.{{$txt.Function.LocalAssignment}}
-				{{if .ForeignColumnNullable -}}
-			rel.{{$txt.ForeignTable.ColumnNameGo}}.Valid = true
+				{{range $txt.Columns -}}
+			rel.{{.ForeignAssignment}} = o.{{.LocalAssignment}}
+					{{if .ForeignNullable -}}
+			rel.{{.ForeignColumnGo}}.Valid = true
+					{{end -}}
 				{{end -}}
 			{{end -}}
 
 			if err = rel.Insert(exec); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+				return {{$z}}errors.Wrap(err, "failed to insert into foreign table")
 			}
+			{{- if and $dot.RowsAffected (not .ToJoinTable)}}
+			rowsAff++
+			{{- end}}
 		}{{if not .ToJoinTable}} else {
 			updateQuery := fmt.Sprintf(
 				"UPDATE {{$foreignSchemaTable}} SET %s WHERE %s",
-				strmangle.SetParamNames("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumn}}"{{"}"}}),
-				strmangle.WhereClause("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}2{{else}}0{{end}}, {{$foreignVarNameSingular}}PrimaryKeyColumns),
+				strmangle.SetParamNames("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumns | join "\", \""}}"{{"}"}}),
+				strmangle.WhereClause("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}{{len .ForeignColumns}}+1{{else}}0{{end}}, {{$foreignVarNameSingular}}PrimaryKeyColumns),
 			)
-			values := []interface{}{o.{{$txt.LocalTable.ColumnNameGo}}, rel.{{$foreignPKeyCols | stringMap $dot.StringFuncs.titleCase | join ", rel."}}{{"}"}}
+			values := []interface{}{{"{"}}{{range $txt.Columns}}o.{{.LocalColumnGo}}, {{end}}rel.{{$foreignPKeyCols | stringMap $dot.StringFuncs.titleCase | join ", rel."}}{{"}"}}
 
 			if boil.DebugMode {
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
 				fmt.Fprintln(boil.DebugWriter, values)
 			}
 
+			{{if $dot.RowsAffected -}}
+			result, err := exec.Exec(updateQuery, values...)
+			if err != nil {
+				return 0, errors.Wrap(boil.ClassifyError(err), "failed to update foreign table")
+			}
+
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return 0, errors.Wrap(err, "failed to get rows affected by update of foreign table")
+			}
+			rowsAff += affected
+			{{- else -}}
 			if _, err = exec.Exec(updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+				return errors.Wrap(boil.ClassifyError(err), "failed to update foreign table")
 			}
+			{{- end}}
 
-			rel.{{$txt.Function.ForeignAssignment}} = o.{{$txt.Function.LocalAssignment}}
-			{{if .ForeignColumnNullable -}}
-			rel.{{$txt.ForeignTable.ColumnNameGo}}.Valid = true
+			{{range $txt.Columns -}}
+			rel.{{.ForeignAssignment}} = o.{{.LocalAssignment}}
+				{{if .ForeignNullable -}}
+			rel.{{.ForeignColumnGo}}.Valid = true
+				{{end -}}
 			{{end -}}
 		}{{end -}}
 	}
@@ -91,10 +139,23 @@ func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}(exec boil.Executo
 			fmt.Fprintln(boil.DebugWriter, values)
 		}
 
+		{{if $dot.RowsAffected -}}
+		result, err := exec.Exec(query, values...)
+		if err != nil {
+			return 0, errors.Wrap(boil.ClassifyError(err), "failed to insert into join table")
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get rows affected by insert into join table")
+		}
+		rowsAff += affected
+		{{- else -}}
 		_, err = exec.Exec(query, values...)
 		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
+			return errors.Wrap(boil.ClassifyError(err), "failed to insert into join table")
 		}
+		{{- end}}
 	}
 	{{end -}}
 
@@ -128,7 +189,7 @@ func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}(exec boil.Executo
 	}
 	{{end -}}
 
-	return nil
+	return {{$aff}}nil
 }
 
 			{{- if (or .ForeignColumnNullable .ToJoinTable)}}
@@ -139,7 +200,7 @@ func (o *{{$txt.LocalTable.NameGo}}) Add{{$txt.Function.Name}}(exec boil.Executo
 // Replaces o.R.{{$txt.Function.Name}} with related.
 // Sets related.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.
 // Uses the global database handle.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related ...*{{$txt.ForeignTable.NameGo}}) error {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	return o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related...)
 }
 
@@ -150,10 +211,19 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}G(insert bool, rel
 // Replaces o.R.{{$txt.Function.Name}} with related.
 // Sets related.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.
 // Panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Set{{$txt.Function.Name}}(exec, insert, related...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Set{{$txt.Function.Name}}(exec, insert, related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Set{{$txt.Function.Name}}GP removes all previously related items of the
@@ -163,10 +233,19 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}P(exec boil.Execut
 // Replaces o.R.{{$txt.Function.Name}} with related.
 // Sets related.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.
 // Uses the global database handle and panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Set{{$txt.Function.Name}}(boil.GetDB(), insert, related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Set{{$txt.Function.Name}} removes all previously related items of the
@@ -175,23 +254,41 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}GP(insert bool, re
 // Sets o.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.
 // Replaces o.R.{{$txt.Function.Name}} with related.
 // Sets related.R.{{$txt.Function.ForeignName}}'s {{$txt.Function.Name}} accordingly.
-func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}) error {
+{{- if $dot.RowsAffected}}
+// Returns the number of rows affected by removing the previous relationships and adding the new ones.
+{{- end}}
+func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executor, insert bool, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	{{if .ToJoinTable -}}
 	query := "delete from {{.JoinTable | $dot.SchemaTable}} where {{.JoinLocalColumn | $dot.Quotes}} = {{if $dot.Dialect.IndexPlaceholders}}$1{{else}}?{{end}}"
 	values := []interface{}{{"{"}}o.{{$txt.LocalTable.ColumnNameGo}}}
 	{{else -}}
-	query := "update {{.ForeignTable | $dot.SchemaTable}} set {{.ForeignColumn | $dot.Quotes}} = null where {{.ForeignColumn | $dot.Quotes}} = {{if $dot.Dialect.IndexPlaceholders}}$1{{else}}?{{end}}"
-	values := []interface{}{{"{"}}o.{{$txt.LocalTable.ColumnNameGo}}}
+	query := fmt.Sprintf(
+		"update {{.ForeignTable | $dot.SchemaTable}} set {{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}{{$col.ForeignColumn | $dot.Quotes}} = null{{end}} where %s",
+		strmangle.WhereClause("{{$dot.LQ}}", "{{$dot.RQ}}", {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{.ForeignColumns | join "\", \""}}"{{"}"}}),
+	)
+	values := []interface{}{{"{"}}{{range $i, $col := $txt.Columns}}{{if $i}}, {{end}}o.{{$col.LocalColumnGo}}{{end}}{{"}"}}
 	{{end -}}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
 
+	{{if $dot.RowsAffected -}}
+	result, err := exec.Exec(query, values...)
+	if err != nil {
+		return 0, errors.Wrap(boil.ClassifyError(err), "failed to remove relationships before set")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get rows affected by removing relationships before set")
+	}
+	{{- else -}}
 	_, err := exec.Exec(query, values...)
 	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+		return errors.Wrap(boil.ClassifyError(err), "failed to remove relationships before set")
 	}
+	{{- end}}
 
 	{{if .ToJoinTable -}}
 	remove{{$txt.Function.Name}}From{{$txt.Function.ForeignName}}Slice(o, related)
@@ -201,7 +298,9 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executo
 	{{else -}}
 	if o.R != nil {
 		for _, rel := range o.R.{{$txt.Function.Name}} {
-			rel.{{$txt.ForeignTable.ColumnNameGo}}.Valid = false
+			{{range $txt.Columns -}}
+			rel.{{.ForeignColumnGo}}.Valid = false
+			{{end -}}
 			if rel.R == nil {
 				continue
 			}
@@ -213,14 +312,19 @@ func (o *{{$txt.LocalTable.NameGo}}) Set{{$txt.Function.Name}}(exec boil.Executo
 	}
 	{{end -}}
 
+	{{if $dot.RowsAffected -}}
+	added, err := o.Add{{$txt.Function.Name}}(exec, insert, related...)
+	return rowsAff + added, err
+	{{- else -}}
 	return o.Add{{$txt.Function.Name}}(exec, insert, related...)
+	{{- end}}
 }
 
 // Remove{{$txt.Function.Name}}G relationships from objects passed in.
 // Removes related items from R.{{$txt.Function.Name}} (uses pointer comparison, removal does not keep order)
 // Sets related.R.{{$txt.Function.ForeignName}}.
 // Uses the global database handle.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related ...*{{$txt.ForeignTable.NameGo}}) error {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {
 	return o.Remove{{$txt.Function.Name}}(boil.GetDB(), related...)
 }
 
@@ -228,27 +332,52 @@ func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}G(related ...*{
 // Removes related items from R.{{$txt.Function.Name}} (uses pointer comparison, removal does not keep order)
 // Sets related.R.{{$txt.Function.ForeignName}}.
 // Panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related ...*{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}P(exec boil.Executor, related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Remove{{$txt.Function.Name}}(exec, related...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Remove{{$txt.Function.Name}}(exec, related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Remove{{$txt.Function.Name}}GP relationships from objects passed in.
 // Removes related items from R.{{$txt.Function.Name}} (uses pointer comparison, removal does not keep order)
 // Sets related.R.{{$txt.Function.ForeignName}}.
 // Uses the global database handle and panics on error.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related ...*{{$txt.ForeignTable.NameGo}}) {
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}GP(related ...*{{$txt.ForeignTable.NameGo}}){{if $dot.RowsAffected}} int64{{end}} {
+	{{if $dot.RowsAffected -}}
+	rowsAff, err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Remove{{$txt.Function.Name}}(boil.GetDB(), related...); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Remove{{$txt.Function.Name}} relationships from objects passed in.
 // Removes related items from R.{{$txt.Function.Name}} (uses pointer comparison, removal does not keep order)
 // Sets related.R.{{$txt.Function.ForeignName}}.
-func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related ...*{{$txt.ForeignTable.NameGo}}) error {
+{{- if $dot.RowsAffected}}
+// Returns the number of rows affected by {{if .ToJoinTable}}deleting from {{.JoinTable}}{{else}}updating related{{end}}.
+{{- end}}
+func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Executor, related ...*{{$txt.ForeignTable.NameGo}}) {{$ret}} {
+	{{if $dot.RowsAffected -}}
+	var rowsAff int64
+	{{- else -}}
 	var err error
+	{{- end}}
 	{{if .ToJoinTable -}}
 	query := fmt.Sprintf(
 		"delete from {{.JoinTable | $dot.SchemaTable}} where {{.JoinLocalColumn | $dot.Quotes}} = {{if $dot.Dialect.IndexPlaceholders}}$1{{else}}?{{end}} and {{.JoinForeignColumn | $dot.Quotes}} in (%s)",
@@ -264,21 +393,42 @@ func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Exec
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
 
+	{{if $dot.RowsAffected -}}
+	result, err := exec.Exec(query, values...)
+	if err != nil {
+		return 0, errors.Wrap(boil.ClassifyError(err), "failed to remove relationships before set")
+	}
+
+	if rowsAff, err = result.RowsAffected(); err != nil {
+		return 0, errors.Wrap(err, "failed to get rows affected by removing relationships")
+	}
+	{{- else -}}
 	_, err = exec.Exec(query, values...)
 	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+		return errors.Wrap(boil.ClassifyError(err), "failed to remove relationships before set")
 	}
+	{{- end}}
 	{{else -}}
 	for _, rel := range related {
-		rel.{{$txt.ForeignTable.ColumnNameGo}}.Valid = false
+		{{range $txt.Columns -}}
+		rel.{{.ForeignColumnGo}}.Valid = false
+		{{end -}}
 		{{if not .ToJoinTable -}}
 		if rel.R != nil {
 			rel.R.{{$txt.Function.ForeignName}} = nil
 		}
 		{{end -}}
-		if err = rel.Update(exec, "{{.ForeignColumn}}"); err != nil {
+		{{if $dot.RowsAffected -}}
+		affected, err := rel.Update(exec, "{{.ForeignColumns | join "\", \""}}")
+		if err != nil {
+			return 0, err
+		}
+		rowsAff += affected
+		{{- else -}}
+		if err = rel.Update(exec, "{{.ForeignColumns | join "\", \""}}"); err != nil {
 			return err
 		}
+		{{- end}}
 	}
 	{{end -}}
 
@@ -286,7 +436,7 @@ func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Exec
 	remove{{$txt.Function.Name}}From{{$txt.Function.ForeignName}}Slice(o, related)
 	{{end -}}
 	if o.R == nil {
-		return nil
+		return {{$aff}}nil
 	}
 
 	for _, rel := range related {
@@ -304,7 +454,7 @@ func (o *{{$txt.LocalTable.NameGo}}) Remove{{$txt.Function.Name}}(exec boil.Exec
 		}
 	}
 
-	return nil
+	return {{$aff}}nil
 }
 
 				{{if .ToJoinTable -}}
@@ -333,5 +483,6 @@ func remove{{$txt.Function.Name}}From{{$txt.Function.ForeignName}}Slice(o *{{$tx
 }
 				{{end -}}{{- /* if ToJoinTable */ -}}
 			{{- end -}}{{- /* if nullable foreign key */ -}}
+		{{- end -}}{{- /* if foreign view */ -}}
 	{{- end -}}{{- /* range relationships */ -}}
 {{- end -}}{{- /* if IsJoinTable */ -}}
@@ -1,5 +1,7 @@
+{{- $dot := . -}}
 {{- $tableNameSingular := .Table.Name | singular | titleCase -}}
 {{- $varNameSingular := .Table.Name | singular | camelCase -}}
+{{- if .Table.PKey -}}
 {{- $colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}
 {{- $pkNames := $colDefs.Names | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}
 {{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", "}}
@@ -53,3 +55,131 @@ func Find{{$tableNameSingular}}P(exec boil.Executor, {{$pkArgs}}, selectCols ...
 
 	return retobj
 }
+{{- end -}}
+{{- range $ukey := .Table.UKeys -}}
+{{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join "And" -}}
+{{- $colDefs := sqlColDefinitions $dot.Table.Columns $ukey.Columns -}}
+{{- $keyNames := $colDefs.Names | stringMap $dot.StringFuncs.camelCase | stringMap $dot.StringFuncs.replaceReserved -}}
+{{- $keyArgs := joinSlices " " $keyNames $colDefs.Types | join ", " -}}
+{{- $keyType := printf "%s%sKey" $tableNameSingular $keyName}}
+
+// Find{{$tableNameSingular}}By{{$keyName}}G retrieves a single record by its unique {{$ukey.Columns | join ", "}}.
+func Find{{$tableNameSingular}}By{{$keyName}}G({{$keyArgs}}, selectCols ...string) (*{{$tableNameSingular}}, error) {
+	return Find{{$tableNameSingular}}By{{$keyName}}(boil.GetDB(), {{$keyNames | join ", "}}, selectCols...)
+}
+
+// Find{{$tableNameSingular}}By{{$keyName}}GP retrieves a single record by its unique {{$ukey.Columns | join ", "}}, and panics on error.
+func Find{{$tableNameSingular}}By{{$keyName}}GP({{$keyArgs}}, selectCols ...string) *{{$tableNameSingular}} {
+	retobj, err := Find{{$tableNameSingular}}By{{$keyName}}(boil.GetDB(), {{$keyNames | join ", "}}, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// Find{{$tableNameSingular}}By{{$keyName}} retrieves a single record by its unique {{$ukey.Columns | join ", "}}
+// with an executor. If selectCols is empty all columns are returned.
+func Find{{$tableNameSingular}}By{{$keyName}}(exec boil.Executor, {{$keyArgs}}, selectCols ...string) (*{{$tableNameSingular}}, error) {
+	{{$varNameSingular}}Obj := &{{$tableNameSingular}}{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from {{$dot.Table.Name | $dot.SchemaTable}} where {{if $dot.Dialect.IndexPlaceholders}}{{whereClause $dot.LQ $dot.RQ 1 $ukey.Columns}}{{else}}{{whereClause $dot.LQ $dot.RQ 0 $ukey.Columns}}{{end}}", sel,
+	)
+
+	q := queries.Raw(exec, query, {{$keyNames | join ", "}})
+
+	err := q.Bind({{$varNameSingular}}Obj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "{{$dot.PkgName}}: unable to select from {{$dot.Table.Name}}")
+	}
+
+	return {{$varNameSingular}}Obj, nil
+}
+
+// Find{{$tableNameSingular}}By{{$keyName}}P retrieves a single record by its unique {{$ukey.Columns | join ", "}}
+// with an executor, and panics on error.
+func Find{{$tableNameSingular}}By{{$keyName}}P(exec boil.Executor, {{$keyArgs}}, selectCols ...string) *{{$tableNameSingular}} {
+	retobj, err := Find{{$tableNameSingular}}By{{$keyName}}(exec, {{$keyNames | join ", "}}, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+{{- if gt (len $ukey.Columns) 1}}
+
+// {{$keyType}} is a value of the unique {{$ukey.Columns | join ", "}} of {{$dot.Table.Name}},
+// used to find several records at once.
+type {{$keyType}} struct {
+	{{range $col := $colDefs -}}
+	{{titleCase $col.Name}} {{$col.Type}}
+	{{end -}}
+}
+{{- else}}
+{{- $keyType = (index $colDefs 0).Type}}
+{{- end}}
+
+// Find{{$tableNameSingular}}SliceBy{{$keyName}}G retrieves the records matching any of the keys.
+func Find{{$tableNameSingular}}SliceBy{{$keyName}}G(keys ...{{$keyType}}) ({{$tableNameSingular}}Slice, error) {
+	return Find{{$tableNameSingular}}SliceBy{{$keyName}}(boil.GetDB(), keys...)
+}
+
+// Find{{$tableNameSingular}}SliceBy{{$keyName}}GP retrieves the records matching any of the keys, and panics on error.
+func Find{{$tableNameSingular}}SliceBy{{$keyName}}GP(keys ...{{$keyType}}) {{$tableNameSingular}}Slice {
+	o, err := Find{{$tableNameSingular}}SliceBy{{$keyName}}(boil.GetDB(), keys...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// Find{{$tableNameSingular}}SliceBy{{$keyName}} retrieves the records matching any of the keys with
+// an executor in a single query, keys without a record are skipped. The order of
+// the records is up to the database.
+func Find{{$tableNameSingular}}SliceBy{{$keyName}}(exec boil.Executor, keys ...{{$keyType}}) ({{$tableNameSingular}}Slice, error) {
+	if len(keys) == 0 {
+		return {{$tableNameSingular}}Slice{}, nil
+	}
+
+	args := make([]interface{}, 0, len(keys)*{{len $ukey.Columns}})
+	for _, key := range keys {
+		{{if gt (len $ukey.Columns) 1 -}}
+		args = append(args, {{$ukey.Columns | stringMap $dot.StringFuncs.titleCase | prefixStringSlice "key." | join ", "}})
+		{{- else -}}
+		args = append(args, key)
+		{{- end}}
+	}
+
+	query := fmt.Sprintf(
+		"select * from {{$dot.Table.Name | $dot.SchemaTable}} where %s",
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if $dot.Dialect.IndexPlaceholders}}1{{else}}0{{end}}, []string{ {{- $ukey.Columns | stringMap $dot.StringFuncs.quoteWrap | join ", " -}} }, len(keys)),
+	)
+
+	var o []*{{$tableNameSingular}}
+	if err := queries.Raw(exec, query, args...).Bind(&o); err != nil {
+		return nil, errors.Wrap(err, "{{$dot.PkgName}}: unable to select from {{$dot.Table.Name}}")
+	}
+
+	return o, nil
+}
+
+// Find{{$tableNameSingular}}SliceBy{{$keyName}}P retrieves the records matching any of the keys with
+// an executor, and panics on error.
+func Find{{$tableNameSingular}}SliceBy{{$keyName}}P(exec boil.Executor, keys ...{{$keyType}}) {{$tableNameSingular}}Slice {
+	o, err := Find{{$tableNameSingular}}SliceBy{{$keyName}}(exec, keys...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+{{- end -}}
@@ -1,3 +1,4 @@
+{{- if not .Table.IsView -}}
 {{- $tableNameSingular := .Table.Name | singular | titleCase -}}
 {{- $varNameSingular := .Table.Name | singular | camelCase -}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
@@ -44,6 +45,9 @@ func (o *{{$tableNameSingular}}) Insert(exec boil.Executor, whitelist ... string
 	nzDefaults := queries.NonZeroDefaultSet({{$varNameSingular}}ColumnsWithDefault, o)
 
 	key := makeCacheKey(whitelist, nzDefaults)
+	{{- if .RuntimeSchema}}
+	key = boil.SchemaOf(exec, "{{.Table.SchemaName}}") + "." + key
+	{{- end}}
 	{{$varNameSingular}}InsertCacheMut.RLock()
 	cache, cached := {{$varNameSingular}}InsertCache[key]
 	{{$varNameSingular}}InsertCacheMut.RUnlock()
@@ -61,6 +65,9 @@ func (o *{{$tableNameSingular}}) Insert(exec boil.Executor, whitelist ... string
 		if err != nil {
 			return err
 		}
+		{{- if not .NoValidate}}
+		cache.columns = wl
+		{{- end}}
 		cache.retMapping, err = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, returnColumns)
 		if err != nil {
 			return err
@@ -96,6 +103,12 @@ func (o *{{$tableNameSingular}}) Insert(exec boil.Executor, whitelist ... string
 
 	value := reflect.Indirect(reflect.ValueOf(o))
 	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	{{- if not .NoValidate}}
+
+	if err := boil.ValidateColumns("{{.Table.Name}}", cache.columns, vals, {{$varNameSingular}}ColumnLimits); err != nil {
+		return err
+	}
+	{{- end}}
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, cache.query)
@@ -110,7 +123,7 @@ func (o *{{$tableNameSingular}}) Insert(exec boil.Executor, whitelist ... string
 	_, err = exec.Exec(cache.query, vals...)
 	{{- end}}
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}}")
+		return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to insert into {{.Table.Name}}")
 	}
 	
 	{{if $canLastInsertID -}}
@@ -160,7 +173,7 @@ func (o *{{$tableNameSingular}}) Insert(exec boil.Executor, whitelist ... string
 	}
 
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to insert into {{.Table.Name}}")
+		return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to insert into {{.Table.Name}}")
 	}
 	{{end}}
 
@@ -173,9 +186,12 @@ CacheNoHooks:
 		{{$varNameSingular}}InsertCacheMut.Unlock()
 	}
 
+	o.Snapshot()
+
 	{{if not .NoHooks -}}
 	return o.doAfterInsertHooks(exec)
 	{{- else -}}
 	return nil
 	{{- end}}
 }
+{{- end -}}
@@ -1,48 +1,99 @@
+{{- if not .Table.IsView -}}
 {{- $tableNameSingular := .Table.Name | singular | titleCase -}}
 {{- $varNameSingular := .Table.Name | singular | camelCase -}}
-{{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+{{- $ret := "error" -}}{{- $z := "" -}}
+{{- if .RowsAffected -}}{{- $ret = "(int64, error)" -}}{{- $z = "0, " -}}{{- end -}}
+{{- $autoUpdatedAt := and (not .NoAutoTimestamps) (containsAny (.Table.Columns | columnNames) "updated_at")}}
 // UpdateG a single {{$tableNameSingular}} record. See Update for
 // whitelist behavior description.
-func (o *{{$tableNameSingular}}) UpdateG(whitelist ...string) error {
+func (o *{{$tableNameSingular}}) UpdateG(whitelist ...string) {{$ret}} {
 	return o.Update(boil.GetDB(), whitelist...)
 }
 
 // UpdateGP a single {{$tableNameSingular}} record.
 // UpdateGP takes a whitelist of column names that should be updated.
 // Panics on error. See Update for whitelist behavior description.
-func (o *{{$tableNameSingular}}) UpdateGP(whitelist ...string) {
+func (o *{{$tableNameSingular}}) UpdateGP(whitelist ...string){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := o.Update(boil.GetDB(), whitelist...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Update(boil.GetDB(), whitelist...); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // UpdateP uses an executor to update the {{$tableNameSingular}}, and panics on error.
 // See Update for whitelist behavior description.
-func (o *{{$tableNameSingular}}) UpdateP(exec boil.Executor, whitelist ... string) {
+func (o *{{$tableNameSingular}}) UpdateP(exec boil.Executor, whitelist ... string){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := o.Update(exec, whitelist...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	err := o.Update(exec, whitelist...)
 	if err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Update uses an executor to update the {{$tableNameSingular}}.
 // Whitelist behavior: If a whitelist is provided, only the columns given are updated.
 // No whitelist behavior: Without a whitelist, columns are inferred by the following rules:
-// - All columns are inferred to start with
+// - If the {{$tableNameSingular}} was loaded from the database, only the columns that
+//   Changed reports once the BeforeUpdate hooks ran are updated, and nothing is
+//   written if there are none{{if $autoUpdatedAt}} besides updated_at{{end}}
+// - Otherwise all columns are inferred to start with
 // - All primary keys are subtracted from this set
 // Update does not automatically update the record in case of default values. Use .Reload()
 // to refresh the records.
-func (o *{{$tableNameSingular}}) Update(exec boil.Executor, whitelist ... string) error {
-	{{- template "timestamp_update_helper" . -}}
+{{- if .RowsAffected}}
+// Update returns the number of rows affected, which is 0 if the row was not found or nothing
+// needed to be updated.
+{{- end}}
+func (o *{{$tableNameSingular}}) Update(exec boil.Executor, whitelist ... string) {{$ret}} {
+	dirty := len(whitelist) == 0 && o.snapshot != nil
+	{{- if $autoUpdatedAt}}
+	updatedAt := o.UpdatedAt
+	{{- end}}
+	{{- template "timestamp_update_helper" . }}
 
 	var err error
 	{{if not .NoHooks -}}
 	if err = o.doBeforeUpdateHooks(exec); err != nil {
-		return err
+		return {{$z}}err
 	}
+
 	{{end -}}
+	if dirty {
+		whitelist = strmangle.SetComplement(o.Changed(), {{$varNameSingular}}PrimaryKeyColumns)
+		{{- if $autoUpdatedAt}}
+		// Bumping updated_at alone is not worth a write
+		if len(strmangle.SetComplement(whitelist, []string{"updated_at"})) == 0 {
+			o.UpdatedAt = updatedAt
+			return {{$z}}nil
+		}
+		{{- else}}
+		if len(whitelist) == 0 {
+			return {{$z}}nil
+		}
+		{{- end}}
+	}
 
 	key := makeCacheKey(whitelist, nil)
+	{{- if .RuntimeSchema}}
+	key = boil.SchemaOf(exec, "{{.Table.SchemaName}}") + "." + key
+	{{- end}}
 	{{$varNameSingular}}UpdateCacheMut.RLock()
 	cache, cached := {{$varNameSingular}}UpdateCache[key]
 	{{$varNameSingular}}UpdateCacheMut.RUnlock()
@@ -62,7 +113,7 @@ func (o *{{$tableNameSingular}}) Update(exec boil.Executor, whitelist ... string
 		}
 		{{end -}}
 		if len(wl) == 0 {
-			return errors.New("{{.PkgName}}: unable to update {{.Table.Name}}, could not build whitelist")
+			return {{$z}}errors.New("{{.PkgName}}: unable to update {{.Table.Name}}, could not build whitelist")
 		}
 
 		cache.query = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s",
@@ -71,21 +122,40 @@ func (o *{{$tableNameSingular}}) Update(exec boil.Executor, whitelist ... string
 		)
 		cache.valueMapping, err = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, append(wl, {{$varNameSingular}}PrimaryKeyColumns...))
 		if err != nil {
-			return err
+			return {{$z}}err
 		}
+		cache.columns = append(wl, {{$varNameSingular}}PrimaryKeyColumns...)
 	}
 
 	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+	{{- if not .NoValidate}}
+
+	if err := boil.ValidateColumns("{{.Table.Name}}", cache.columns, values, {{$varNameSingular}}ColumnLimits); err != nil {
+		return {{$z}}err
+	}
+	{{- end}}
 
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, cache.query)
 		fmt.Fprintln(boil.DebugWriter, values)
 	}
 
+	{{if .RowsAffected -}}
+	result, err := exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to update {{.Table.Name}} row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by update for {{.Table.Name}}")
+	}
+	{{- else -}}
 	_, err = exec.Exec(cache.query, values...)
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to update {{.Table.Name}} row")
+		return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to update {{.Table.Name}} row")
 	}
+	{{- end}}
 
 	if !cached {
 		{{$varNameSingular}}UpdateCacheMut.Lock()
@@ -93,62 +163,123 @@ func (o *{{$tableNameSingular}}) Update(exec boil.Executor, whitelist ... string
 		{{$varNameSingular}}UpdateCacheMut.Unlock()
 	}
 
+	o.refreshSnapshot(cache.columns)
+
 	{{if not .NoHooks -}}
-	return o.doAfterUpdateHooks(exec)
+	return {{if .RowsAffected}}rowsAff, {{end}}o.doAfterUpdateHooks(exec)
 	{{- else -}}
-	return nil
+	return {{if .RowsAffected}}rowsAff, {{end}}nil
 	{{- end}}
 }
 
 // UpdateAllP updates all rows with matching column names, and panics on error.
-func (q {{$varNameSingular}}Query) UpdateAllP(cols M) {
+func (q {{$varNameSingular}}Query) UpdateAllP(cols M){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := q.UpdateAll(cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := q.UpdateAll(cols); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // UpdateAll updates all rows with the specified column values.
-func (q {{$varNameSingular}}Query) UpdateAll(cols M) error {
+func (q {{$varNameSingular}}Query) UpdateAll(cols M) {{$ret}} {
+	{{if not .NoHooks -}}
+	exec := queries.GetExecutor(q.Query)
+	if err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}BeforeUpdateAllHooks, exec, q.Query, nil, cols); err != nil {
+		return {{$z}}err
+	}
+
+	{{end -}}
 	queries.SetUpdate(q.Query, cols)
 
+	{{if .RowsAffected -}}
+	result, err := q.Query.Exec()
+	if err != nil {
+		return 0, errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to update all for {{.Table.Name}}")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "{{.PkgName}}: unable to retrieve rows affected for {{.Table.Name}}")
+	}
+	{{- else -}}
 	_, err := q.Query.Exec()
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to update all for {{.Table.Name}}")
+		return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to update all for {{.Table.Name}}")
+	}
+	{{- end}}
+
+	{{if not .NoHooks -}}
+	if err = do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}AfterUpdateAllHooks, exec, q.Query, nil, cols); err != nil {
+		return {{$z}}err
 	}
 
-	return nil
+	{{end -}}
+	return {{if .RowsAffected}}rowsAff, {{end}}nil
 }
 
 // UpdateAllG updates all rows with the specified column values.
-func (o {{$tableNameSingular}}Slice) UpdateAllG(cols M) error {
+func (o {{$tableNameSingular}}Slice) UpdateAllG(cols M) {{$ret}} {
 	return o.UpdateAll(boil.GetDB(), cols)
 }
 
 // UpdateAllGP updates all rows with the specified column values, and panics on error.
-func (o {{$tableNameSingular}}Slice) UpdateAllGP(cols M) {
+func (o {{$tableNameSingular}}Slice) UpdateAllGP(cols M){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := o.UpdateAll(boil.GetDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.UpdateAll(boil.GetDB(), cols); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // UpdateAllP updates all rows with the specified column values, and panics on error.
-func (o {{$tableNameSingular}}Slice) UpdateAllP(exec boil.Executor, cols M) {
+func (o {{$tableNameSingular}}Slice) UpdateAllP(exec boil.Executor, cols M){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := o.UpdateAll(exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.UpdateAll(exec, cols); err != nil {
 		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // UpdateAll updates all rows with the specified column values, using an executor.
-func (o {{$tableNameSingular}}Slice) UpdateAll(exec boil.Executor, cols M) error {
+func (o {{$tableNameSingular}}Slice) UpdateAll(exec boil.Executor, cols M) {{$ret}} {
 	ln := int64(len(o))
 	if ln == 0 {
-		return nil
+		return {{$z}}nil
 	}
 
 	if len(cols) == 0 {
-		return errors.New("{{.PkgName}}: update all requires at least one column argument")
+		return {{$z}}errors.New("{{.PkgName}}: update all requires at least one column argument")
+	}
+
+	{{if not .NoHooks -}}
+	if err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}BeforeUpdateAllHooks, exec, nil, o, cols); err != nil {
+		return {{$z}}err
 	}
 
+	{{end -}}
+
 	colNames := make([]string, len(cols))
 	args := make([]interface{}, len(cols))
 
@@ -174,10 +305,29 @@ func (o {{$tableNameSingular}}Slice) UpdateAll(exec boil.Executor, cols M) error
 		fmt.Fprintln(boil.DebugWriter, args...)
 	}
 
+	{{if .RowsAffected -}}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to update all in {{$varNameSingular}} slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "{{.PkgName}}: unable to retrieve rows affected all in update all {{$varNameSingular}}")
+	}
+	{{- else -}}
 	_, err := exec.Exec(sql, args...)
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to update all in {{$varNameSingular}} slice")
+		return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to update all in {{$varNameSingular}} slice")
 	}
+	{{- end}}
 
-	return nil
+	{{if not .NoHooks -}}
+	if err = do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}AfterUpdateAllHooks, exec, nil, o, cols); err != nil {
+		return {{$z}}err
+	}
+
+	{{end -}}
+	return {{if .RowsAffected}}rowsAff, {{end}}nil
 }
+{{- end -}}
@@ -1,3 +1,4 @@
+{{- if not .Table.IsView -}}
 {{- $tableNameSingular := .Table.Name | singular | titleCase -}}
 {{- $varNameSingular := .Table.Name | singular | camelCase -}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
@@ -39,6 +40,10 @@ func (o *{{$tableNameSingular}}) Upsert(exec boil.Executor, {{if eq .DriverName
 
 	// Build cache key in-line uglily - mysql vs postgres problems
 	buf := strmangle.GetBuffer()
+	{{- if .RuntimeSchema}}
+	buf.WriteString(boil.SchemaOf(exec, "{{.Table.SchemaName}}"))
+	buf.WriteByte('.')
+	{{- end}}
 	{{if eq .DriverName "postgres"}}
 	if updateOnConflict {
 		buf.WriteByte('t')
@@ -121,7 +126,7 @@ func (o *{{$tableNameSingular}}) Upsert(exec boil.Executor, {{if eq .DriverName
 			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
 		)
 		{{else if eq .DriverName "mssql"}}
-		cache.query = queries.BuildUpsertQueryMSSQL(dialect, "{{.Table.Name}}", {{$varNameSingular}}PrimaryKeyColumns, update, insert, ret)
+		cache.query = queries.BuildUpsertQueryMSSQL(dialect, "{{$schemaTable}}", {{$varNameSingular}}PrimaryKeyColumns, update, insert, ret)
 
 		whitelist = append({{$varNameSingular}}PrimaryKeyColumns, update...)
 		whitelist = append(whitelist, insert...)
@@ -131,6 +136,9 @@ func (o *{{$tableNameSingular}}) Upsert(exec boil.Executor, {{if eq .DriverName
 		if err != nil {
 			return err
 		}
+		{{- if not .NoValidate}}
+		cache.columns = {{if eq .DriverName "mssql"}}whitelist{{else}}insert{{end}}
+		{{- end}}
 		if len(ret) != 0 {
 			cache.retMapping, err = queries.BindMapping({{$varNameSingular}}Type, {{$varNameSingular}}Mapping, ret)
 			if err != nil {
@@ -141,6 +149,12 @@ func (o *{{$tableNameSingular}}) Upsert(exec boil.Executor, {{if eq .DriverName
 
 	value := reflect.Indirect(reflect.ValueOf(o))
 	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	{{- if not .NoValidate}}
+
+	if err := boil.ValidateColumns("{{.Table.Name}}", cache.columns, vals, {{$varNameSingular}}ColumnLimits); err != nil {
+		return err
+	}
+	{{- end}}
 	var returns []interface{}
 	if len(cache.retMapping) != 0 {
 		returns = queries.PtrsFromMapping(value, cache.retMapping)
@@ -159,7 +173,7 @@ func (o *{{$tableNameSingular}}) Upsert(exec boil.Executor, {{if eq .DriverName
 	_, err = exec.Exec(cache.query, vals...)
 	{{- end}}
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to upsert for {{.Table.Name}}")
+		return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to upsert for {{.Table.Name}}")
 	}
 
 	{{if $canLastInsertID -}}
@@ -211,7 +225,7 @@ func (o *{{$tableNameSingular}}) Upsert(exec boil.Executor, {{if eq .DriverName
 		_, err = exec.Exec(cache.query, vals...)
 	}
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to upsert {{.Table.Name}}")
+		return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to upsert {{.Table.Name}}")
 	}
 	{{- end}}
 
@@ -224,9 +238,47 @@ CacheNoHooks:
 		{{$varNameSingular}}UpsertCacheMut.Unlock()
 	}
 
+	o.Snapshot()
+
 	{{if not .NoHooks -}}
 	return o.doAfterUpsertHooks(exec)
 	{{- else -}}
 	return nil
 	{{- end}}
 }
+{{- if eq .DriverName "postgres"}}
+{{- $dot := .}}
+{{- range $ukey := .Table.UKeys -}}
+{{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join "And" -}}
+{{- $conflict := $ukey.Columns | stringMap $dot.StringFuncs.quoteWrap | join ", "}}
+
+// UpsertBy{{$keyName}}G attempts an insert, and does an update or ignore on a conflict
+// on the unique {{$ukey.Columns | join ", "}}.
+func (o *{{$tableNameSingular}}) UpsertBy{{$keyName}}G(updateOnConflict bool, updateColumns []string, whitelist ...string) error {
+	return o.Upsert(boil.GetDB(), updateOnConflict, []string{ {{- $conflict -}} }, updateColumns, whitelist...)
+}
+
+// UpsertBy{{$keyName}}GP attempts an insert, and does an update or ignore on a conflict
+// on the unique {{$ukey.Columns | join ", "}}. Panics on error.
+func (o *{{$tableNameSingular}}) UpsertBy{{$keyName}}GP(updateOnConflict bool, updateColumns []string, whitelist ...string) {
+	if err := o.Upsert(boil.GetDB(), updateOnConflict, []string{ {{- $conflict -}} }, updateColumns, whitelist...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertBy{{$keyName}} attempts an insert using an executor, and does an update or ignore
+// on a conflict on the unique {{$ukey.Columns | join ", "}}.
+func (o *{{$tableNameSingular}}) UpsertBy{{$keyName}}(exec boil.Executor, updateOnConflict bool, updateColumns []string, whitelist ...string) error {
+	return o.Upsert(exec, updateOnConflict, []string{ {{- $conflict -}} }, updateColumns, whitelist...)
+}
+
+// UpsertBy{{$keyName}}P attempts an insert using an executor, and does an update or ignore
+// on a conflict on the unique {{$ukey.Columns | join ", "}}. Panics on error.
+func (o *{{$tableNameSingular}}) UpsertBy{{$keyName}}P(exec boil.Executor, updateOnConflict bool, updateColumns []string, whitelist ...string) {
+	if err := o.Upsert(exec, updateOnConflict, []string{ {{- $conflict -}} }, updateColumns, whitelist...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+{{- end -}}
+{{- end -}}
+{{- end -}}
@@ -1,20 +1,32 @@
+{{- if not .Table.IsView -}}
 {{- $tableNameSingular := .Table.Name | singular | titleCase -}}
 {{- $varNameSingular := .Table.Name | singular | camelCase -}}
-{{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+{{- $ret := "error" -}}{{- $z := "" -}}
+{{- if .RowsAffected -}}{{- $ret = "(int64, error)" -}}{{- $z = "0, " -}}{{- end}}
 // DeleteP deletes a single {{$tableNameSingular}} record with an executor.
 // DeleteP will match against the primary key column to find the record to delete.
 // Panics on error.
-func (o *{{$tableNameSingular}}) DeleteP(exec boil.Executor) {
+func (o *{{$tableNameSingular}}) DeleteP(exec boil.Executor){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := o.Delete(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.Delete(exec); err != nil {
-	panic(boil.WrapErr(err))
+		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // DeleteG deletes a single {{$tableNameSingular}} record.
 // DeleteG will match against the primary key column to find the record to delete.
-func (o *{{$tableNameSingular}}) DeleteG() error {
+func (o *{{$tableNameSingular}}) DeleteG() {{$ret}} {
 	if o == nil {
-	return errors.New("{{.PkgName}}: no {{$tableNameSingular}} provided for deletion")
+	return {{$z}}errors.New("{{.PkgName}}: no {{$tableNameSingular}} provided for deletion")
 	}
 
 	return o.Delete(boil.GetDB())
@@ -23,22 +35,34 @@ func (o *{{$tableNameSingular}}) DeleteG() error {
 // DeleteGP deletes a single {{$tableNameSingular}} record.
 // DeleteGP will match against the primary key column to find the record to delete.
 // Panics on error.
-func (o *{{$tableNameSingular}}) DeleteGP() {
+func (o *{{$tableNameSingular}}) DeleteGP(){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := o.DeleteG()
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.DeleteG(); err != nil {
-	panic(boil.WrapErr(err))
+		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // Delete deletes a single {{$tableNameSingular}} record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *{{$tableNameSingular}}) Delete(exec boil.Executor) error {
+{{- if .RowsAffected}}
+// Delete returns the number of rows affected, which is 0 if the row was not found.
+{{- end}}
+func (o *{{$tableNameSingular}}) Delete(exec boil.Executor) {{$ret}} {
 	if o == nil {
-	return errors.New("{{.PkgName}}: no {{$tableNameSingular}} provided for delete")
+	return {{$z}}errors.New("{{.PkgName}}: no {{$tableNameSingular}} provided for delete")
 	}
 
 	{{if not .NoHooks -}}
 	if err := o.doBeforeDeleteHooks(exec); err != nil {
-	return err
+	return {{$z}}err
 	}
 	{{- end}}
 
@@ -50,80 +74,148 @@ func (o *{{$tableNameSingular}}) Delete(exec boil.Executor) error {
 	fmt.Fprintln(boil.DebugWriter, args...)
 	}
 
+	{{if .RowsAffected -}}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+	return 0, errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to delete from {{.Table.Name}}")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+	return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by delete for {{.Table.Name}}")
+	}
+	{{- else -}}
 	_, err := exec.Exec(sql, args...)
 	if err != nil {
-	return errors.Wrap(err, "{{.PkgName}}: unable to delete from {{.Table.Name}}")
+	return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to delete from {{.Table.Name}}")
 	}
+	{{- end}}
 
 	{{if not .NoHooks -}}
 	if err := o.doAfterDeleteHooks(exec); err != nil {
-	return err
+	return {{$z}}err
 	}
 	{{- end}}
 
-	return nil
+	return {{if .RowsAffected}}rowsAff, {{end}}nil
 }
 
 // DeleteAllP deletes all rows, and panics on error.
-func (q {{$varNameSingular}}Query) DeleteAllP() {
+func (q {{$varNameSingular}}Query) DeleteAllP(){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := q.DeleteAll()
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := q.DeleteAll(); err != nil {
-	panic(boil.WrapErr(err))
+		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // DeleteAll deletes all matching rows.
-func (q {{$varNameSingular}}Query) DeleteAll() error {
+func (q {{$varNameSingular}}Query) DeleteAll() {{$ret}} {
 	if q.Query == nil {
-	return errors.New("{{.PkgName}}: no {{$varNameSingular}}Query provided for delete all")
+	return {{$z}}errors.New("{{.PkgName}}: no {{$varNameSingular}}Query provided for delete all")
 	}
 
+	{{if not .NoHooks -}}
+	exec := queries.GetExecutor(q.Query)
+	if err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}BeforeDeleteAllHooks, exec, q.Query, nil, nil); err != nil {
+	return {{$z}}err
+	}
+
+	{{end -}}
 	queries.SetDelete(q.Query)
 
+	{{if .RowsAffected -}}
+	result, err := q.Query.Exec()
+	if err != nil {
+	return 0, errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to delete all from {{.Table.Name}}")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+	return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by deleteall for {{.Table.Name}}")
+	}
+	{{- else -}}
 	_, err := q.Query.Exec()
 	if err != nil {
-	return errors.Wrap(err, "{{.PkgName}}: unable to delete all from {{.Table.Name}}")
+	return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to delete all from {{.Table.Name}}")
+	}
+	{{- end}}
+
+	{{if not .NoHooks -}}
+	if err = do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}AfterDeleteAllHooks, exec, q.Query, nil, nil); err != nil {
+	return {{$z}}err
 	}
 
-	return nil
+	{{end -}}
+	return {{if .RowsAffected}}rowsAff, {{end}}nil
 }
 
 // DeleteAllGP deletes all rows in the slice, and panics on error.
-func (o {{$tableNameSingular}}Slice) DeleteAllGP() {
+func (o {{$tableNameSingular}}Slice) DeleteAllGP(){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := o.DeleteAllG()
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.DeleteAllG(); err != nil {
-	panic(boil.WrapErr(err))
+		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // DeleteAllG deletes all rows in the slice.
-func (o {{$tableNameSingular}}Slice) DeleteAllG() error {
+func (o {{$tableNameSingular}}Slice) DeleteAllG() {{$ret}} {
 	if o == nil {
-	return errors.New("{{.PkgName}}: no {{$tableNameSingular}} slice provided for delete all")
+	return {{$z}}errors.New("{{.PkgName}}: no {{$tableNameSingular}} slice provided for delete all")
 	}
 	return o.DeleteAll(boil.GetDB())
 }
 
 // DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
-func (o {{$tableNameSingular}}Slice) DeleteAllP(exec boil.Executor) {
+func (o {{$tableNameSingular}}Slice) DeleteAllP(exec boil.Executor){{if .RowsAffected}} int64{{end}} {
+	{{if .RowsAffected -}}
+	rowsAff, err := o.DeleteAll(exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+	{{- else -}}
 	if err := o.DeleteAll(exec); err != nil {
-	panic(boil.WrapErr(err))
+		panic(boil.WrapErr(err))
 	}
+	{{- end}}
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o {{$tableNameSingular}}Slice) DeleteAll(exec boil.Executor) error {
+func (o {{$tableNameSingular}}Slice) DeleteAll(exec boil.Executor) {{$ret}} {
 	if o == nil {
-		return errors.New("{{.PkgName}}: no {{$tableNameSingular}} slice provided for delete all")
+		return {{$z}}errors.New("{{.PkgName}}: no {{$tableNameSingular}} slice provided for delete all")
 	}
 
 	if len(o) == 0 {
-		return nil
+		return {{$z}}nil
 	}
 
 	{{if not .NoHooks -}}
+	if err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}BeforeDeleteAllHooks, exec, nil, o, nil); err != nil {
+		return {{$z}}err
+	}
+
 	if len({{$varNameSingular}}BeforeDeleteHooks) != 0 {
 		for _, obj := range o {
 			if err := obj.doBeforeDeleteHooks(exec); err != nil {
-				return err
+				return {{$z}}err
 			}
 		}
 	}
@@ -143,20 +235,37 @@ func (o {{$tableNameSingular}}Slice) DeleteAll(exec boil.Executor) error {
 		fmt.Fprintln(boil.DebugWriter, args)
 	}
 
+	{{if .RowsAffected -}}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to delete all from {{$varNameSingular}} slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by deleteall for {{.Table.Name}}")
+	}
+	{{- else -}}
 	_, err := exec.Exec(sql, args...)
 	if err != nil {
-		return errors.Wrap(err, "{{.PkgName}}: unable to delete all from {{$varNameSingular}} slice")
+		return errors.Wrap(boil.ClassifyError(err), "{{.PkgName}}: unable to delete all from {{$varNameSingular}} slice")
 	}
+	{{- end}}
 
 	{{if not .NoHooks -}}
-	if len({{$varNameSingular}}AfterDeleteHooks) != 0 {
+	if len({{$varNameSingular}}AfterDeleteHooks) != 0 || len({{$varNameSingular}}AfterCommitHooks) != 0 || len({{$varNameSingular}}AfterRollbackHooks) != 0 {
 		for _, obj := range o {
 			if err := obj.doAfterDeleteHooks(exec); err != nil {
-				return err
+				return {{$z}}err
 			}
 		}
 	}
+
+	if err := do{{$tableNameSingular}}BulkHooks({{$varNameSingular}}AfterDeleteAllHooks, exec, nil, o, nil); err != nil {
+		return {{$z}}err
+	}
 	{{- end}}
 
-	return nil
+	return {{if .RowsAffected}}rowsAff, {{end}}nil
 }
+{{- end -}}
@@ -1,4 +1,6 @@
+{{- $dot := . -}}
 {{- $tableNameSingular := .Table.Name | singular | titleCase -}}
+{{- if .Table.PKey -}}
 {{- $colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}
 {{- $pkNames := $colDefs.Names | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}
 {{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", " -}}
@@ -51,3 +53,64 @@ func {{$tableNameSingular}}ExistsP(exec boil.Executor, {{$pkArgs}}) bool {
 
 	return e
 }
+{{- end -}}
+{{- range $ukey := .Table.UKeys -}}
+{{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join "And" -}}
+{{- $colDefs := sqlColDefinitions $dot.Table.Columns $ukey.Columns -}}
+{{- $keyNames := $colDefs.Names | stringMap $dot.StringFuncs.camelCase | stringMap $dot.StringFuncs.replaceReserved -}}
+{{- $keyArgs := joinSlices " " $keyNames $colDefs.Types | join ", " -}}
+{{- $schemaTable := $dot.Table.Name | $dot.SchemaTable}}
+
+// {{$tableNameSingular}}ExistsBy{{$keyName}} checks if a {{$tableNameSingular}} row with the unique
+// {{$ukey.Columns | join ", "}} exists.
+func {{$tableNameSingular}}ExistsBy{{$keyName}}(exec boil.Executor, {{$keyArgs}}) (bool, error) {
+	var exists bool
+	{{if eq $dot.DriverName "mssql" -}}
+	sql := "select case when exists(select top(1) 1 from {{$schemaTable}} where {{if $dot.Dialect.IndexPlaceholders}}{{whereClause $dot.LQ $dot.RQ 1 $ukey.Columns}}{{else}}{{whereClause $dot.LQ $dot.RQ 0 $ukey.Columns}}{{end}}) then 1 else 0 end"
+	{{- else -}}
+	sql := "select exists(select 1 from {{$schemaTable}} where {{if $dot.Dialect.IndexPlaceholders}}{{whereClause $dot.LQ $dot.RQ 1 $ukey.Columns}}{{else}}{{whereClause $dot.LQ $dot.RQ 0 $ukey.Columns}}{{end}} limit 1)"
+	{{- end}}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, {{$keyNames | join ", "}})
+	}
+
+	row := exec.QueryRow(sql, {{$keyNames | join ", "}})
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "{{$dot.PkgName}}: unable to check if {{$dot.Table.Name}} exists")
+	}
+
+	return exists, nil
+}
+
+// {{$tableNameSingular}}ExistsBy{{$keyName}}G checks if a {{$tableNameSingular}} row with the unique
+// {{$ukey.Columns | join ", "}} exists.
+func {{$tableNameSingular}}ExistsBy{{$keyName}}G({{$keyArgs}}) (bool, error) {
+	return {{$tableNameSingular}}ExistsBy{{$keyName}}(boil.GetDB(), {{$keyNames | join ", "}})
+}
+
+// {{$tableNameSingular}}ExistsBy{{$keyName}}GP checks if a {{$tableNameSingular}} row with the unique
+// {{$ukey.Columns | join ", "}} exists. Panics on error.
+func {{$tableNameSingular}}ExistsBy{{$keyName}}GP({{$keyArgs}}) bool {
+	e, err := {{$tableNameSingular}}ExistsBy{{$keyName}}(boil.GetDB(), {{$keyNames | join ", "}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// {{$tableNameSingular}}ExistsBy{{$keyName}}P checks if a {{$tableNameSingular}} row with the unique
+// {{$ukey.Columns | join ", "}} exists. Panics on error.
+func {{$tableNameSingular}}ExistsBy{{$keyName}}P(exec boil.Executor, {{$keyArgs}}) bool {
+	e, err := {{$tableNameSingular}}ExistsBy{{$keyName}}(exec, {{$keyNames | join ", "}})
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+{{- end -}}
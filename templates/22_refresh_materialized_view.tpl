@@ -0,0 +1,42 @@
+{{- if .Table.IsMaterializedView -}}
+{{- $tableNamePlural := .Table.Name | plural | titleCase -}}
+{{- $schemaTable := .Table.Name | .SchemaTable}}
+// Refresh{{$tableNamePlural}}G refreshes the contents of the materialized view.
+func Refresh{{$tableNamePlural}}G(concurrently bool) error {
+	return Refresh{{$tableNamePlural}}(boil.GetDB(), concurrently)
+}
+
+// Refresh{{$tableNamePlural}}GP refreshes the contents of the materialized view, and panics on error.
+func Refresh{{$tableNamePlural}}GP(concurrently bool) {
+	if err := Refresh{{$tableNamePlural}}(boil.GetDB(), concurrently); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Refresh{{$tableNamePlural}}P refreshes the contents of the materialized view with an executor, and panics on error.
+func Refresh{{$tableNamePlural}}P(exec boil.Executor, concurrently bool) {
+	if err := Refresh{{$tableNamePlural}}(exec, concurrently); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Refresh{{$tableNamePlural}} refreshes the contents of the materialized view with an executor.
+// A concurrent refresh doesn't lock out selects while it runs but requires
+// the view to have a unique index.
+func Refresh{{$tableNamePlural}}(exec boil.Executor, concurrently bool) error {
+	query := "REFRESH MATERIALIZED VIEW {{$schemaTable}}"
+	if concurrently {
+		query = "REFRESH MATERIALIZED VIEW CONCURRENTLY {{$schemaTable}}"
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+	}
+
+	if _, err := exec.Exec(query); err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to refresh {{.Table.Name}}")
+	}
+
+	return nil
+}
+{{- end -}}
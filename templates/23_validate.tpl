@@ -0,0 +1,20 @@
+{{- if not .Table.IsView -}}
+{{- $tableNameSingular := .Table.Name | singular | titleCase -}}
+{{- $varNameSingular := .Table.Name | singular | camelCase}}
+
+// ColumnLimits returns the limits the database puts on the columns of {{.Table.Name}}.
+func (o *{{$tableNameSingular}}) ColumnLimits() map[string]boil.ColumnLimits {
+	return {{$varNameSingular}}ColumnLimits
+}
+
+// Validate checks every column of the {{$tableNameSingular}} against the limits the
+// database puts on it: NOT NULL, lengths, decimal digits and simple CHECK constraints.
+// It returns a *boil.ValidationError listing each offending column.
+{{- if not .NoValidate}}
+// Insert, Update and Upsert check the columns they write the same way.
+{{- end}}
+func (o *{{$tableNameSingular}}) Validate() error {
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}ColumnsMapping)
+	return boil.ValidateColumns("{{.Table.Name}}", {{$varNameSingular}}Columns, values, {{$varNameSingular}}ColumnLimits)
+}
+{{- end}}
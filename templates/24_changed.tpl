@@ -0,0 +1,35 @@
+{{- if not .Table.IsView -}}
+{{- $tableNameSingular := .Table.Name | singular | titleCase -}}
+{{- $varNameSingular := .Table.Name | singular | camelCase}}
+
+// Snapshot records the current values of the columns of the {{$tableNameSingular}} as the
+// ones it was loaded with, Changed compares against them. Bind, Find, Insert, Upsert
+// and Reload call it, and Update records the columns it wrote, it only needs to be
+// called for a {{$tableNameSingular}} brought in line with the database some other way.
+func (o *{{$tableNameSingular}}) Snapshot() {
+	snapshot := queries.SnapshotFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}ColumnsMapping)
+	o.snapshot = &snapshot
+}
+
+// refreshSnapshot records the current values of columns as the ones the
+// {{$tableNameSingular}} was loaded with, after only they were written. A
+// {{$tableNameSingular}} that was never loaded is left that way.
+func (o *{{$tableNameSingular}}) refreshSnapshot(columns []string) {
+	if o.snapshot == nil {
+		return
+	}
+
+	snapshot := queries.RefreshSnapshotFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}ColumnsMapping, {{$varNameSingular}}Columns, *o.snapshot, columns)
+	o.snapshot = &snapshot
+}
+
+// Changed returns the columns whose values changed since the {{$tableNameSingular}} was
+// loaded from the database. All columns are returned if it was never loaded.
+func (o *{{$tableNameSingular}}) Changed() []string {
+	if o.snapshot == nil {
+		return append([]string(nil), {{$varNameSingular}}Columns...)
+	}
+
+	return queries.ChangedFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$varNameSingular}}ColumnsMapping, {{$varNameSingular}}Columns, *o.snapshot)
+}
+{{- end}}
@@ -0,0 +1,94 @@
+// factorySeed is shared by every factory, so the values of the rows they build
+// stay unique across tables and calls.
+var factorySeed = randomize.NewSeed()
+
+// factoryMaxDepth bounds the chain of parents a factory creates, a cycle of
+// required foreign keys then fails to insert rather than recursing forever.
+const factoryMaxDepth = 16
+
+// modelFactories builds valid random rows through the package level Factory,
+// e.g. Factory.Pilot().Create(tx). Values are random but unique and within the
+// types, enums and lengths of their columns.
+type modelFactories struct{}
+
+// Factory builds random rows of every table, see modelFactories.
+var Factory modelFactories
+
+// factoryIsZero reports whether a foreign key column was left unset
+func factoryIsZero(v interface{}) bool {
+	return reflect.DeepEqual(v, reflect.Zero(reflect.TypeOf(v)).Interface())
+}
+{{- range $table := .Tables}}
+{{- if or $table.IsJoinTable $table.IsView}}
+{{- else}}
+{{- $tableNameSingular := $table.Name | singular | titleCase}}
+{{- $varNameSingular := $table.Name | singular | camelCase}}
+
+// {{$varNameSingular}}DBTypes are the database types of the columns of {{$table.Name}}, for randomize
+var {{$varNameSingular}}DBTypes = map[string]string{{"{"}}{{$table.Columns | columnDBTypes | makeStringMap}}{{"}"}}
+
+// {{$varNameSingular}}Factory builds {{$tableNameSingular}} rows, see modelFactories.
+type {{$varNameSingular}}Factory struct {
+	overrides []func(o *{{$tableNameSingular}})
+}
+
+// {{$tableNameSingular}} returns a factory of {{$tableNameSingular}} rows that applies the
+// overrides, in order, to each row once its random values are chosen.
+func (modelFactories) {{$tableNameSingular}}(overrides ...func(o *{{$tableNameSingular}})) {{$varNameSingular}}Factory {
+	return {{$varNameSingular}}Factory{overrides: overrides}
+}
+
+// Build returns a random {{$tableNameSingular}} without inserting it. Columns with
+// defaults and foreign keys are left zero (or null) unless overridden.
+func (f {{$varNameSingular}}Factory) Build() (*{{$tableNameSingular}}, error) {
+	o := &{{$tableNameSingular}}{}
+	blacklist := append([]string{ {{- range $table.FKeys}}{{range .Columns}}"{{.}}", {{end}}{{end -}} }, {{$varNameSingular}}ColumnsWithDefault...)
+	if err := randomize.Struct(factorySeed, o, {{$varNameSingular}}DBTypes, false, blacklist...); err != nil {
+		return nil, err
+	}
+
+	for _, override := range f.overrides {
+		override(o)
+	}
+
+	return o, nil
+}
+
+// Create builds a {{$tableNameSingular}} and inserts it with exec. A parent row is
+// created for each required foreign key the overrides leave unset, set one to
+// reuse an existing parent instead.
+func (f {{$varNameSingular}}Factory) Create(exec boil.Executor) (*{{$tableNameSingular}}, error) {
+	return f.create(exec, 0)
+}
+
+func (f {{$varNameSingular}}Factory) create(exec boil.Executor, depth int) (*{{$tableNameSingular}}, error) {
+	o, err := f.Build()
+	if err != nil {
+		return nil, err
+	}
+	{{- range $table.FKeys -}}
+	{{- $foreignTable := getTable $.Tables .ForeignTable -}}
+	{{- if or .Nullable (eq .ForeignTable $table.Name) $foreignTable.IsJoinTable $foreignTable.IsView -}}
+	{{- else -}}
+	{{- $txt := txtsFromFKey $.Tables $table .}}
+
+	if depth < factoryMaxDepth && {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}factoryIsZero(o.{{$col.LocalColumnGo}}){{end}} {
+		parent, err := Factory.{{$txt.ForeignTable.NameGo}}().create(exec, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		{{range $txt.Columns -}}
+		o.{{.LocalAssignment}} = parent.{{.ForeignAssignment}}
+		{{end -}}
+	}
+	{{- end -}}
+	{{- end}}
+
+	if err = o.Insert(exec); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+{{- end}}
+{{- end}}
@@ -0,0 +1,73 @@
+// FixtureTables describes the tables of the package for the fixtures package
+var FixtureTables = []fixtures.Table{
+	{{- range $table := .Tables}}
+	{{- if not $table.IsView}}
+	{{- $alias := $table.Name | singular | titleCase}}
+	{
+		Name: "{{$table.Name}}",
+		{{- if $table.FKeys}}
+		ForeignKeys: []fixtures.ForeignKey{
+			{{- range $fkey := $table.FKeys}}
+			{
+				Columns: []string{ {{- $fkey.Columns | stringMap $.StringFuncs.quoteWrap | join ", " -}} }, ForeignTable: "{{$fkey.ForeignTable}}", ForeignColumns: []string{ {{- $fkey.ForeignColumns | stringMap $.StringFuncs.quoteWrap | join ", " -}} },
+				{{- if $fkey.IsComposite}}
+				Ref: "{{$fkey.ForeignTable | singular}}",
+				{{- end}}
+			},
+			{{- end}}
+		},
+		{{- end}}
+		{{- if $table.IsJoinTable}}
+		Insert: func(exec boil.Executor, row fixtures.Row) (fixtures.Row, error) {
+			return fixtures.InsertRaw(exec, dialect, "{{$.SchemaTable $table.Name}}", row)
+		},
+		Clean: func(exec boil.Executor) error {
+			_, err := exec.Exec("DELETE FROM {{$.SchemaTable $table.Name}}")
+			return err
+		},
+		{{- else}}
+		Insert: func(exec boil.Executor, row fixtures.Row) (fixtures.Row, error) {
+			o := &{{$alias}}{}
+			if err := fixtures.Bind(row, o); err != nil {
+				return nil, err
+			}
+			if err := o.Insert(exec); err != nil {
+				return nil, err
+			}
+			return fixtures.Values(o), nil
+		},
+		Clean: func(exec boil.Executor) error {
+			{{- if $.RowsAffected}}
+			_, err := {{$table.Name | plural | titleCase}}(exec).DeleteAll()
+			return err
+			{{- else}}
+			return {{$table.Name | plural | titleCase}}(exec).DeleteAll()
+			{{- end}}
+		},
+		{{- end}}
+	},
+	{{- end}}
+	{{- end}}
+}
+
+// LoadFixtures inserts the rows of the fixture files at paths, and returns
+// them by label by table.
+func LoadFixtures(exec boil.Executor, paths ...string) (fixtures.Loaded, error) {
+	set, err := fixtures.Read(paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	return set.Load(exec, FixtureTables)
+}
+
+// CleanFixtures deletes all the rows of the tables the fixture files at paths
+// have rows for.
+func CleanFixtures(exec boil.Executor, paths ...string) error {
+	set, err := fixtures.Read(paths...)
+	if err != nil {
+		return err
+	}
+
+	return set.Clean(exec, FixtureTables)
+}
@@ -19,3 +19,18 @@ func NewQuery(exec boil.Executor, mods ...qm.QueryMod) *queries.Query {
 
 	return q
 }
+{{- if .RuntimeSchema}}
+
+// schemaTable quotes a table along with the schema of exec, which is the
+// schema the table was generated from unless exec was bound to another one
+// with boil.WithSchema.
+func schemaTable(exec boil.Executor, schema, table string) string {
+	schema = boil.SchemaOf(exec, schema)
+	{{- if and (ne .DriverName "mssql") .DriverSchema}}
+	if schema == "{{.DriverSchema}}" {
+		return string(dialect.LQ) + table + string(dialect.RQ)
+	}
+	{{- end}}
+	return string(dialect.LQ) + schema + string(dialect.RQ) + "." + string(dialect.LQ) + table + string(dialect.RQ)
+}
+{{- end}}
@@ -4,6 +4,6 @@ var TableNames = struct {
 	{{end -}}
 }{
 	{{range $table := .Tables -}}
-	{{titleCase $table.Name}}: "{{$table.Name}}",
+	{{titleCase $table.Name}}: "{{$table.SQLName}}",
 	{{end -}}
 }
@@ -11,11 +11,15 @@ type insertCache struct {
 	retQuery     string
 	valueMapping []uint64
 	retMapping   []uint64
+	{{- if not .NoValidate}}
+	columns      []string
+	{{- end}}
 }
 
 type updateCache struct {
 	query        string
 	valueMapping []uint64
+	columns      []string
 }
 
 func makeCacheKey(wl, nzDefaults []string) string {
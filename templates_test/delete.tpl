@@ -14,21 +14,36 @@ func test{{$tableNamePlural}}Delete(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
 
+	{{if .RowsAffected -}}
+	if rowsAff, err := {{$varNameSingular}}.Delete(tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+	{{- else -}}
 	if err = {{$varNameSingular}}.Delete(tx); err != nil {
 		t.Error(err)
 	}
+	{{- end}}
 
 	count, err := {{$tableNamePlural}}(tx).Count()
 	if err != nil {
 		t.Error(err)
 	}
 
-	if count != 0 {
-		t.Error("want zero records, got:", count)
+	if count != before {
+		t.Errorf("want %d records, got: %d", before, count)
 	}
 }
 
@@ -44,13 +59,30 @@ func test{{$tableNamePlural}}QueryDeleteAll(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	{{if .RowsAffected -}}
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
 
+	{{if .RowsAffected -}}
+	if rowsAff, err := {{$tableNamePlural}}(tx).DeleteAll(); err != nil {
+		t.Error(err)
+	} else if rowsAff != before+1 {
+		t.Errorf("should have deleted %d rows, but affected: %d", before+1, rowsAff)
+	}
+	{{- else -}}
 	if err = {{$tableNamePlural}}(tx).DeleteAll(); err != nil {
 		t.Error(err)
 	}
+	{{- end}}
 
 	count, err := {{$tableNamePlural}}(tx).Count()
 	if err != nil {
@@ -74,22 +106,37 @@ func test{{$tableNamePlural}}SliceDeleteAll(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
 
 	slice := {{$tableNameSingular}}Slice{{"{"}}{{$varNameSingular}}{{"}"}}
 
+	{{if .RowsAffected -}}
+	if rowsAff, err := slice.DeleteAll(tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+	{{- else -}}
 	if err = slice.DeleteAll(tx); err != nil {
 		t.Error(err)
 	}
+	{{- end}}
 
 	count, err := {{$tableNamePlural}}(tx).Count()
 	if err != nil {
 		t.Error(err)
 	}
 
-	if count != 0 {
-		t.Error("want zero records, got:", count)
+	if count != before {
+		t.Errorf("want %d records, got: %d", before, count)
 	}
 }
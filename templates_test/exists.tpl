@@ -14,6 +14,9 @@ func test{{$tableNamePlural}}Exists(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
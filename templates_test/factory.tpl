@@ -0,0 +1,74 @@
+{{- $dot := . -}}
+{{- $tableNameSingular := .Table.Name | singular | titleCase -}}
+{{- $tableNamePlural := .Table.Name | plural | titleCase -}}
+{{- $varNameSingular := .Table.Name | singular | camelCase -}}
+// {{$varNameSingular}}Parents points the foreign keys of a randomized {{$tableNameSingular}}
+// at rows that exist before the tests write it. A required key that refers to
+// no row gets a parent from the factories, a nullable one is set to null.
+{{- if not .TestTransactions}}
+// The test database is a copy of the schema without foreign keys, so there
+// is nothing to do.
+{{- end}}
+func {{$varNameSingular}}Parents(exec boil.Executor, o *{{$tableNameSingular}}) error {
+	{{- $checked := false}}
+	{{- if .TestTransactions}}
+	{{- range .Table.FKeys}}
+	{{- $foreignTable := getTable $dot.Tables .ForeignTable}}
+	{{- if or $foreignTable.IsJoinTable $foreignTable.IsView (and (eq .ForeignTable $dot.Table.Name) (not .Nullable))}}
+	{{- else}}
+	{{- $txt := txtsFromFKey $dot.Tables $dot.Table .}}
+	{{- $checked = true}}
+	if exists, err := o.{{$txt.Function.Name}}(exec).Exists(); err != nil {
+		return err
+	} else if !exists {
+		{{- if .Nullable}}
+		{{- range $txt.Columns}}
+		{{- if .LocalNullable}}
+		o.{{.LocalColumnGo}}.Valid = false
+		{{- end}}
+		{{- end}}
+		{{- else}}
+		parent, err := Factory.{{$txt.ForeignTable.NameGo}}().Create(exec)
+		if err != nil {
+			return err
+		}
+		{{- range $txt.Columns}}
+		o.{{.LocalAssignment}} = parent.{{.ForeignAssignment}}
+		{{- end}}
+		{{- end}}
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	{{- if $checked}}
+	{{end}}
+	return nil
+}
+
+func test{{$tableNamePlural}}Factory(t *testing.T) {
+	t.Parallel()
+
+	tx := MustTx(boil.Begin())
+	defer tx.Rollback()
+
+	o, err := Factory.{{$tableNameSingular}}().Create(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exists, err := {{$tableNameSingular}}Exists(tx, {{.Table.PKey.Columns | stringMap .StringFuncs.titleCase | prefixStringSlice "o." | join ", "}})
+	if err != nil {
+		t.Error(err)
+	}
+	if !exists {
+		t.Error("want the created {{$tableNameSingular}} to exist")
+	}
+
+	built, err := Factory.{{$tableNameSingular}}(func(o *{{$tableNameSingular}}) { *o = {{$tableNameSingular}}{} }).Build()
+	if err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(built, &{{$tableNameSingular}}{}) {
+		t.Errorf("want the override applied last, got: %#v", built)
+	}
+}
@@ -1,3 +1,4 @@
+{{- $dot := . -}}
 {{- $tableNameSingular := .Table.Name | singular | titleCase -}}
 {{- $tableNamePlural := .Table.Name | plural | titleCase -}}
 {{- $varNamePlural := .Table.Name | plural | camelCase -}}
@@ -14,6 +15,9 @@ func test{{$tableNamePlural}}Find(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -27,3 +31,59 @@ func test{{$tableNamePlural}}Find(t *testing.T) {
 		t.Error("want a record, got nil")
 	}
 }
+{{range $ukey := .Table.UKeys -}}
+{{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join "And" -}}
+{{- $keyArgs := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | prefixStringSlice (printf "%s." $varNameSingular) | join ", "}}
+
+func test{{$tableNamePlural}}FindBy{{$keyName}}(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	{{$varNameSingular}} := &{{$tableNameSingular}}{}
+	if err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
+	}
+
+	tx := MustTx(boil.Begin())
+	defer tx.Rollback()
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}.Insert(tx); err != nil {
+		t.Error(err)
+	}
+
+	{{$varNameSingular}}Found, err := Find{{$tableNameSingular}}By{{$keyName}}(tx, {{$keyArgs}})
+	if err != nil {
+		t.Error(err)
+	}
+	if {{$varNameSingular}}Found == nil {
+		t.Error("want a record, got nil")
+	}
+
+	e, err := {{$tableNameSingular}}ExistsBy{{$keyName}}(tx, {{$keyArgs}})
+	if err != nil {
+		t.Error(err)
+	}
+	if !e {
+		t.Error("want {{$tableNameSingular}}ExistsBy{{$keyName}} to return true, but got false.")
+	}
+
+	{{if gt (len $ukey.Columns) 1 -}}
+	slice, err := Find{{$tableNameSingular}}SliceBy{{$keyName}}(tx, {{$tableNameSingular}}{{$keyName}}Key{
+		{{range $col := $ukey.Columns -}}
+		{{titleCase $col}}: {{$varNameSingular}}.{{titleCase $col}},
+		{{end -}}
+	})
+	{{- else -}}
+	slice, err := Find{{$tableNameSingular}}SliceBy{{$keyName}}(tx, {{$keyArgs}})
+	{{- end}}
+	if err != nil {
+		t.Error(err)
+	}
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+{{end}}
@@ -14,6 +14,9 @@ func test{{$tableNamePlural}}Bind(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -35,6 +38,9 @@ func test{{$tableNamePlural}}One(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -62,9 +68,19 @@ func test{{$tableNamePlural}}All(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}One); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}One.Insert(tx); err != nil {
 		t.Error(err)
 	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}Two); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}Two.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -74,8 +90,8 @@ func test{{$tableNamePlural}}All(t *testing.T) {
 		t.Error(err)
 	}
 
-	if len(slice) != 2 {
-		t.Error("want 2 records, got:", len(slice))
+	if len(slice) != int(before)+2 {
+		t.Errorf("want %d records, got: %d", before+2, len(slice))
 	}
 }
 
@@ -95,9 +111,19 @@ func test{{$tableNamePlural}}Count(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}One); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}One.Insert(tx); err != nil {
 		t.Error(err)
 	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}Two); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}Two.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -107,7 +133,7 @@ func test{{$tableNamePlural}}Count(t *testing.T) {
 		t.Error(err)
 	}
 
-	if count != 2 {
-		t.Error("want 2 records, got:", count)
+	if count != before+2 {
+		t.Errorf("want %d records, got: %d", before+2, count)
 	}
 }
@@ -48,6 +48,11 @@ func {{$varNameSingular}}AfterUpsertHook(e boil.Executor, o *{{$tableNameSingula
 	return nil
 }
 
+func {{$varNameSingular}}BulkHook(e boil.Executor, q *queries.Query, o {{$tableNameSingular}}Slice, cols M) error {
+	cols["hooked"] = len(o)
+	return nil
+}
+
 func test{{$tableNamePlural}}Hooks(t *testing.T) {
 	t.Parallel()
 
@@ -62,6 +67,12 @@ func test{{$tableNamePlural}}Hooks(t *testing.T) {
 	}
 
 	Add{{$tableNameSingular}}Hook(boil.BeforeInsertHook, {{$varNameSingular}}BeforeInsertHook)
+	if err = o.doBeforeInsertHooks(boil.SkipHooks(nil)); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to be skipped, but got: %#v", o)
+	}
 	if err = o.doBeforeInsertHooks(nil); err != nil {
 		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
 	}
@@ -141,5 +152,92 @@ func test{{$tableNamePlural}}Hooks(t *testing.T) {
 		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
 	}
 	{{$varNameSingular}}AfterUpsertHooks = []{{$tableNameSingular}}Hook{}
+
+	committed, rolledBack := false, false
+	Add{{$tableNameSingular}}Hook(boil.AfterCommitHook, func(e boil.Executor, o *{{$tableNameSingular}}) error {
+		committed = true
+		return nil
+	})
+	Add{{$tableNameSingular}}Hook(boil.AfterRollbackHook, func(e boil.Executor, o *{{$tableNameSingular}}) error {
+		rolledBack = true
+		return nil
+	})
+	if err = o.doAfterInsertHooks(nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !committed || rolledBack {
+		t.Errorf("Expected only AfterCommitHook to run outside a transaction, but got commit: %t, rollback: %t", committed, rolledBack)
+	}
+
+	committed = false
+	tx := MustTx(boil.Begin())
+	if err = o.doAfterInsertHooks(tx); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if err = tx.Rollback(); err != nil {
+		t.Error(err)
+	}
+	if committed || rolledBack {
+		t.Errorf("Expected no transaction hooks to run in a transaction that does not collect them, but got commit: %t, rollback: %t", committed, rolledBack)
+	}
+
+	cbTx := boil.NewCallbackTx(MustTx(boil.Begin()))
+	if err = o.doAfterInsertHooks(cbTx); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if committed || rolledBack {
+		t.Errorf("Expected no transaction hooks to run before the transaction ends, but got commit: %t, rollback: %t", committed, rolledBack)
+	}
+	if err = cbTx.Rollback(); err != nil {
+		t.Error(err)
+	}
+	if committed || !rolledBack {
+		t.Errorf("Expected only AfterRollbackHook to run once the transaction rolls back, but got commit: %t, rollback: %t", committed, rolledBack)
+	}
+
+	rolledBack = false
+	Add{{$tableNameSingular}}Hook(boil.AfterInsertHook, func(e boil.Executor, o *{{$tableNameSingular}}) error {
+		return errTestHook
+	})
+	cbTx = boil.NewCallbackTx(MustTx(boil.Begin()))
+	if err = o.doAfterInsertHooks(cbTx); err != errTestHook {
+		t.Errorf("Expected doAfterInsertHooks to fail, but got: %v", err)
+	}
+	if err = cbTx.Rollback(); err != nil {
+		t.Error(err)
+	}
+	if committed || rolledBack {
+		t.Errorf("Expected no transaction hooks to run after a failed AfterInsertHook, but got commit: %t, rollback: %t", committed, rolledBack)
+	}
+	{{$varNameSingular}}AfterInsertHooks = []{{$tableNameSingular}}Hook{}
+	{{$varNameSingular}}AfterCommitHooks = []{{$tableNameSingular}}Hook{}
+	{{$varNameSingular}}AfterRollbackHooks = []{{$tableNameSingular}}Hook{}
+
+	bulkHooks := []*[]{{$tableNameSingular}}BulkHook{
+		&{{$varNameSingular}}BeforeUpdateAllHooks,
+		&{{$varNameSingular}}AfterUpdateAllHooks,
+		&{{$varNameSingular}}BeforeDeleteAllHooks,
+		&{{$varNameSingular}}AfterDeleteAllHooks,
+	}
+	bulkPoints := []boil.HookPoint{boil.BeforeUpdateAllHook, boil.AfterUpdateAllHook, boil.BeforeDeleteAllHook, boil.AfterDeleteAllHook}
+	for i, hookPoint := range bulkPoints {
+		Add{{$tableNameSingular}}BulkHook(hookPoint, {{$varNameSingular}}BulkHook)
+
+		cols := M{}
+		if err = do{{$tableNameSingular}}BulkHooks(*bulkHooks[i], boil.SkipHooks(nil), nil, {{$tableNameSingular}}Slice{o}, cols); err != nil {
+			t.Errorf("Unable to execute do{{$tableNameSingular}}BulkHooks: %s", err)
+		}
+		if len(cols) != 0 {
+			t.Errorf("Expected bulk hook %d to be skipped, but got: %#v", hookPoint, cols)
+		}
+
+		if err = do{{$tableNameSingular}}BulkHooks(*bulkHooks[i], nil, nil, {{$tableNameSingular}}Slice{o}, cols); err != nil {
+			t.Errorf("Unable to execute do{{$tableNameSingular}}BulkHooks: %s", err)
+		}
+		if cols["hooked"] != 1 {
+			t.Errorf("Expected bulk hook %d to be run with the slice, but got: %#v", hookPoint, cols)
+		}
+		*bulkHooks[i] = []{{$tableNameSingular}}BulkHook{}
+	}
 }
 {{- end}}
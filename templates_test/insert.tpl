@@ -15,6 +15,13 @@ func test{{$tableNamePlural}}Insert(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -24,8 +31,8 @@ func test{{$tableNamePlural}}Insert(t *testing.T) {
 		t.Error(err)
 	}
 
-	if count != 1 {
-		t.Error("want one record, got:", count)
+	if count != before+1 {
+		t.Errorf("want %d records, got: %d", before+1, count)
 	}
 }
 
@@ -41,6 +48,13 @@ func test{{$tableNamePlural}}InsertWhitelist(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx, {{$varNameSingular}}ColumnsWithoutDefault...); err != nil {
 		t.Error(err)
 	}
@@ -50,7 +64,7 @@ func test{{$tableNamePlural}}InsertWhitelist(t *testing.T) {
 		t.Error(err)
 	}
 
-	if count != 1 {
-		t.Error("want one record, got:", count)
+	if count != before+1 {
+		t.Errorf("want %d records, got: %d", before+1, count)
 	}
 }
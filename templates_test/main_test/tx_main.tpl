@@ -0,0 +1,63 @@
+{{- $builder := "MSSQL" -}}
+{{- if eq .DriverName "postgres"}}{{$builder = "Postgres"}}{{else if eq .DriverName "mysql"}}{{$builder = "MySQL"}}{{end -}}
+type txTester struct {
+	dbConn *sql.DB
+}
+
+func init() {
+	dbMain = &txTester{}
+}
+
+// setup only checks that the database can be reached. The tests run on the
+// configured database as it is, each in a transaction that it rolls back, so
+// there is no copy of the schema to make and the foreign keys stay enforced.
+// Rows already in the tables are left alone, the tests count theirs on top of
+// them, but foreign keys to them can keep QueryDeleteAll from deleting.
+func (t *txTester) setup() error {
+	conn, err := t.conn()
+	if err != nil {
+		return err
+	}
+
+	return conn.Ping()
+}
+
+// teardown closes the connection, the tests leave nothing behind
+func (t *txTester) teardown() error {
+	if t.dbConn == nil {
+		return nil
+	}
+
+	err := t.dbConn.Close()
+	t.dbConn = nil
+	return err
+}
+
+// conn connects to {{.DriverName}}.testdbname, or {{.DriverName}}.dbname when it
+// is not set, so the tests can use another database than the one the package
+// was generated from.
+func (t *txTester) conn() (*sql.DB, error) {
+	if t.dbConn != nil {
+		return t.dbConn, nil
+	}
+
+	dbName := viper.GetString("{{.DriverName}}.testdbname")
+	if len(dbName) == 0 {
+		dbName = viper.GetString("{{.DriverName}}.dbname")
+	}
+
+	var err error
+	t.dbConn, err = sql.Open("{{.DriverName}}", drivers.{{$builder}}BuildQueryString(
+		viper.GetString("{{.DriverName}}.user"),
+		viper.GetString("{{.DriverName}}.pass"),
+		dbName,
+		viper.GetString("{{.DriverName}}.host"),
+		viper.GetInt("{{.DriverName}}.port"),
+		viper.GetString("{{.DriverName}}.sslmode"),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return t.dbConn, nil
+}
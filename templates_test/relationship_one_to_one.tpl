@@ -2,6 +2,8 @@
 {{- else -}}
 	{{- $dot := . -}}
 	{{- range .Table.ToOneRelationships -}}
+	{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+	{{- else -}}
 		{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table . -}}
 		{{- $varNameSingular := .Table | singular | camelCase -}}
 		{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase}}
@@ -20,18 +22,28 @@ func test{{$txt.LocalTable.NameGo}}OneToOne{{$txt.ForeignTable.NameGo}}Using{{$t
 		t.Errorf("Unable to randomize {{$txt.LocalTable.NameGo}} struct: %s", err)
 	}
 
-	{{if .ForeignColumnNullable -}}
-	foreign.{{$txt.ForeignTable.ColumnNameGo}}.Valid = true
-	{{- end}}
-	{{if .Nullable -}}
-	local.{{$txt.LocalTable.ColumnNameGo}}.Valid = true
-	{{- end}}
+	{{range $txt.Columns -}}
+	{{if .ForeignNullable -}}
+	foreign.{{.ForeignColumnGo}}.Valid = true
+	{{end -}}
+	{{if .LocalNullable -}}
+	local.{{.LocalColumnGo}}.Valid = true
+	{{end -}}
+	{{end}}
 
+	if err := {{$varNameSingular}}Parents(tx, &local); err != nil {
+		t.Fatal(err)
+	}
 	if err := local.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
 
-	foreign.{{$txt.Function.ForeignAssignment}} = local.{{$txt.Function.LocalAssignment}}
+	{{range $txt.Columns -}}
+	foreign.{{.ForeignAssignment}} = local.{{.LocalAssignment}}
+	{{end -}}
+	if err := {{$foreignVarNameSingular}}Parents(tx, &foreign); err != nil {
+		t.Fatal(err)
+	}
 	if err := foreign.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
@@ -41,13 +53,15 @@ func test{{$txt.LocalTable.NameGo}}OneToOne{{$txt.ForeignTable.NameGo}}Using{{$t
 		t.Fatal(err)
 	}
 
-	{{if $txt.Function.UsesBytes -}}
-	if 0 != bytes.Compare(check.{{$txt.Function.ForeignAssignment}}, foreign.{{$txt.Function.ForeignAssignment}}) {
+	{{range $txt.Columns -}}
+	{{if .UsesBytes -}}
+	if 0 != bytes.Compare(check.{{.ForeignAssignment}}, foreign.{{.ForeignAssignment}}) {
 	{{else -}}
-	if check.{{$txt.Function.ForeignAssignment}} != foreign.{{$txt.Function.ForeignAssignment}} {
+	if check.{{.ForeignAssignment}} != foreign.{{.ForeignAssignment}} {
 	{{end -}}
-		t.Errorf("want: %v, got %v", foreign.{{$txt.Function.ForeignAssignment}}, check.{{$txt.Function.ForeignAssignment}})
+		t.Errorf("want: %v, got %v", foreign.{{.ForeignAssignment}}, check.{{.ForeignAssignment}})
 	}
+	{{end}}
 
 	slice := {{$txt.LocalTable.NameGo}}Slice{&local}
 	if err = local.L.Load{{$txt.Function.Name}}(tx, false, (*[]*{{$txt.LocalTable.NameGo}})(&slice)); err != nil {
@@ -66,5 +80,6 @@ func test{{$txt.LocalTable.NameGo}}OneToOne{{$txt.ForeignTable.NameGo}}Using{{$t
 	}
 }
 
-{{end -}}{{/* range */}}
+{{end -}}{{/* if foreign view */}}
+{{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
@@ -2,6 +2,8 @@
 {{- else -}}
 	{{- $dot := . -}}
 	{{- range .Table.ToOneRelationships -}}
+	{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+	{{- else -}}
 		{{- $txt := txtsFromOneToOne $dot.Tables $dot.Table .}}
 {{- $varNameSingular := .Table | singular | camelCase -}}
 {{- $foreignVarNameSingular := .ForeignTable | singular | camelCase -}}
@@ -26,15 +28,24 @@ func test{{$txt.LocalTable.NameGo}}OneToOneSetOp{{$txt.ForeignTable.NameGo}}Usin
 		t.Fatal(err)
 	}
 
+	if err = {{$varNameSingular}}Parents(tx, &a); err != nil {
+		t.Fatal(err)
+	}
 	if err := a.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
+	if err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {
+		t.Fatal(err)
+	}
 	if err = b.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
+	if err = {{$foreignVarNameSingular}}Parents(tx, &c); err != nil {
+		t.Fatal(err)
+	}
 
 	for i, x := range []*{{$txt.ForeignTable.NameGo}}{&b, &c} {
-		err = a.Set{{$txt.Function.Name}}(tx, i != 0, x)
+		{{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, i != 0, x)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -46,13 +57,15 @@ func test{{$txt.LocalTable.NameGo}}OneToOneSetOp{{$txt.ForeignTable.NameGo}}Usin
 			t.Error("failed to append to foreign relationship struct")
 		}
 
-		{{if $txt.Function.UsesBytes -}}
-		if 0 != bytes.Compare(a.{{$txt.Function.LocalAssignment}}, x.{{$txt.Function.ForeignAssignment}}) {
+		{{range $txt.Columns -}}
+		{{if .UsesBytes -}}
+		if 0 != bytes.Compare(a.{{.LocalAssignment}}, x.{{.ForeignAssignment}}) {
 		{{else -}}
-		if a.{{$txt.Function.LocalAssignment}} != x.{{$txt.Function.ForeignAssignment}} {
+		if a.{{.LocalAssignment}} != x.{{.ForeignAssignment}} {
 		{{end -}}
-			t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}})
+			t.Error("foreign key was wrong value", a.{{.LocalAssignment}})
 		}
+		{{end}}
 
 		{{if setInclude .ForeignColumn $foreignPKeyCols -}}
 		if exists, err := {{$txt.ForeignTable.NameGo}}Exists(tx, x.{{$foreignPKeyCols | stringMap $dot.StringFuncs.titleCase | join ", x."}}); err != nil {
@@ -61,23 +74,25 @@ func test{{$txt.LocalTable.NameGo}}OneToOneSetOp{{$txt.ForeignTable.NameGo}}Usin
 			t.Error("want 'x' to exist")
 		}
 		{{else -}}
-		zero := reflect.Zero(reflect.TypeOf(x.{{$txt.Function.ForeignAssignment}}))
-		reflect.Indirect(reflect.ValueOf(&x.{{$txt.Function.ForeignAssignment}})).Set(zero)
-
+		{{range $txt.Columns -}}
+		reflect.Indirect(reflect.ValueOf(&x.{{.ForeignAssignment}})).Set(reflect.Zero(reflect.TypeOf(x.{{.ForeignAssignment}})))
+		{{end}}
 		if err = x.Reload(tx); err != nil {
 			t.Fatal("failed to reload", err)
 		}
 		{{- end}}
 
-		{{if $txt.Function.UsesBytes -}}
-		if 0 != bytes.Compare(a.{{$txt.Function.LocalAssignment}}, x.{{$txt.Function.ForeignAssignment}}) {
+		{{range $txt.Columns -}}
+		{{if .UsesBytes -}}
+		if 0 != bytes.Compare(a.{{.LocalAssignment}}, x.{{.ForeignAssignment}}) {
 		{{else -}}
-		if a.{{$txt.Function.LocalAssignment}} != x.{{$txt.Function.ForeignAssignment}} {
+		if a.{{.LocalAssignment}} != x.{{.ForeignAssignment}} {
 		{{end -}}
-			t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, x.{{$txt.Function.ForeignAssignment}})
+			t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, x.{{.ForeignAssignment}})
 		}
+		{{end}}
 
-		if err = x.Delete(tx); err != nil {
+		if {{if $dot.RowsAffected}}_, {{end}}err = x.Delete(tx); err != nil {
 			t.Fatal("failed to delete x", err)
 		}
 	}
@@ -101,17 +116,31 @@ func test{{$txt.LocalTable.NameGo}}OneToOneRemoveOp{{$txt.ForeignTable.NameGo}}U
 		t.Fatal(err)
 	}
 
+	if err = {{$varNameSingular}}Parents(tx, &a); err != nil {
+		t.Fatal(err)
+	}
 	if err = a.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
+	if err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {
+		t.Fatal(err)
+	}
 
-	if err = a.Set{{$txt.Function.Name}}(tx, true, &b); err != nil {
+	if {{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, true, &b); err != nil {
 		t.Fatal(err)
 	}
 
+	{{if $dot.RowsAffected -}}
+	if rowsAff, err := a.Remove{{$txt.Function.Name}}(tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	} else if rowsAff != 1 {
+		t.Error("should have removed one relationship, but affected:", rowsAff)
+	}
+	{{- else -}}
 	if err = a.Remove{{$txt.Function.Name}}(tx, &b); err != nil {
 		t.Error("failed to remove relationship")
 	}
+	{{- end}}
 
 	count, err := a.{{$txt.Function.Name}}(tx).Count()
 	if err != nil {
@@ -125,14 +154,17 @@ func test{{$txt.LocalTable.NameGo}}OneToOneRemoveOp{{$txt.ForeignTable.NameGo}}U
 		t.Error("R struct entry should be nil")
 	}
 
-	if b.{{$txt.ForeignTable.ColumnNameGo}}.Valid {
+	{{range $txt.Columns -}}
+	if b.{{.ForeignColumnGo}}.Valid {
 		t.Error("foreign key column should be nil")
 	}
+	{{end}}
 
 	if b.R.{{$txt.Function.ForeignName}} != nil {
 		t.Error("failed to remove a from b's relationships")
 	}
 }
 {{end -}}{{/* end if foreign key nullable */}}
+{{- end -}}{{/* if foreign view */}}
 {{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
@@ -3,6 +3,8 @@
 	{{- $dot := . }}
 	{{- $table := .Table }}
 	{{- range .Table.ToManyRelationships -}}
+	{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+	{{- else -}}
 	{{- $txt := txtsFromToMany $dot.Tables $table .}}
 	{{- $varNameSingular := .Table | singular | camelCase -}}
 	{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase -}}
@@ -19,36 +21,49 @@ func test{{$txt.LocalTable.NameGo}}ToMany{{$txt.Function.Name}}(t *testing.T) {
 		t.Errorf("Unable to randomize {{$txt.LocalTable.NameGo}} struct: %s", err)
 	}
 
+	if err = {{$varNameSingular}}Parents(tx, &a); err != nil {
+		t.Fatal(err)
+	}
 	if err := a.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
 
 	randomize.Struct(seed, &b, {{$foreignVarNameSingular}}DBTypes, false, {{$foreignVarNameSingular}}ColumnsWithDefault...)
 	randomize.Struct(seed, &c, {{$foreignVarNameSingular}}DBTypes, false, {{$foreignVarNameSingular}}ColumnsWithDefault...)
-	{{if .Nullable -}}
-	a.{{.Column | titleCase}}.Valid = true
-	{{- end}}
-	{{- if .ForeignColumnNullable}}
-	b.{{.ForeignColumn | titleCase}}.Valid = true
-	c.{{.ForeignColumn | titleCase}}.Valid = true
-	{{- end}}
+	{{range $txt.Columns -}}
+	{{if .LocalNullable -}}
+	a.{{.LocalColumnGo}}.Valid = true
+	{{end -}}
+	{{if .ForeignNullable -}}
+	b.{{.ForeignColumnGo}}.Valid = true
+	c.{{.ForeignColumnGo}}.Valid = true
+	{{end -}}
+	{{end -}}
 	{{if not .ToJoinTable -}}
-	b.{{$txt.Function.ForeignAssignment}} = a.{{$txt.Function.LocalAssignment}}
-	c.{{$txt.Function.ForeignAssignment}} = a.{{$txt.Function.LocalAssignment}}
+	{{range $txt.Columns -}}
+	b.{{.ForeignAssignment}} = a.{{.LocalAssignment}}
+	c.{{.ForeignAssignment}} = a.{{.LocalAssignment}}
+	{{end -}}
 	{{- end}}
+	if err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {
+		t.Fatal(err)
+	}
 	if err = b.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
+	if err = {{$foreignVarNameSingular}}Parents(tx, &c); err != nil {
+		t.Fatal(err)
+	}
 	if err = c.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
 
 	{{if .ToJoinTable -}}
-	_, err = tx.Exec("insert into {{.JoinTable | $dot.SchemaTable}} ({{.JoinLocalColumn | $dot.Quotes}}, {{.JoinForeignColumn | $dot.Quotes}}) values {{if $dot.Dialect.IndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}", a.{{$txt.LocalTable.ColumnNameGo}}, b.{{$txt.ForeignTable.ColumnNameGo}})
+	_, err = tx.Exec("insert into {{.JoinTable | $dot.SchemaTableIn "tx"}} ({{.JoinLocalColumn | $dot.Quotes}}, {{.JoinForeignColumn | $dot.Quotes}}) values {{if $dot.Dialect.IndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}", a.{{$txt.LocalTable.ColumnNameGo}}, b.{{$txt.ForeignTable.ColumnNameGo}})
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = tx.Exec("insert into {{.JoinTable | $dot.SchemaTable}} ({{.JoinLocalColumn | $dot.Quotes}}, {{.JoinForeignColumn | $dot.Quotes}}) values {{if $dot.Dialect.IndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}", a.{{$txt.LocalTable.ColumnNameGo}}, c.{{$txt.ForeignTable.ColumnNameGo}})
+	_, err = tx.Exec("insert into {{.JoinTable | $dot.SchemaTableIn "tx"}} ({{.JoinLocalColumn | $dot.Quotes}}, {{.JoinForeignColumn | $dot.Quotes}}) values {{if $dot.Dialect.IndexPlaceholders}}($1, $2){{else}}(?, ?){{end}}", a.{{$txt.LocalTable.ColumnNameGo}}, c.{{$txt.ForeignTable.ColumnNameGo}})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -62,21 +77,12 @@ func test{{$txt.LocalTable.NameGo}}ToMany{{$txt.Function.Name}}(t *testing.T) {
 
 	bFound, cFound := false, false
 	for _, v := range {{$varname}} {
-		{{if $txt.Function.UsesBytes -}}
-		if 0 == bytes.Compare(v.{{$txt.Function.ForeignAssignment}}, b.{{$txt.Function.ForeignAssignment}}) {
-			bFound = true
-		}
-		if 0 == bytes.Compare(v.{{$txt.Function.ForeignAssignment}}, c.{{$txt.Function.ForeignAssignment}}) {
-			cFound = true
-		}
-		{{else -}}
-		if v.{{$txt.Function.ForeignAssignment}} == b.{{$txt.Function.ForeignAssignment}} {
+		if {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(v.{{$col.ForeignAssignment}}, b.{{$col.ForeignAssignment}}){{else}}v.{{$col.ForeignAssignment}} == b.{{$col.ForeignAssignment}}{{end}}{{end}} {
 			bFound = true
 		}
-		if v.{{$txt.Function.ForeignAssignment}} == c.{{$txt.Function.ForeignAssignment}} {
+		if {{range $i, $col := $txt.Columns}}{{if $i}} && {{end}}{{if $col.UsesBytes}}0 == bytes.Compare(v.{{$col.ForeignAssignment}}, c.{{$col.ForeignAssignment}}){{else}}v.{{$col.ForeignAssignment}} == c.{{$col.ForeignAssignment}}{{end}}{{end}} {
 			cFound = true
 		}
-		{{end -}}
 	}
 
 	if !bFound {
@@ -107,5 +113,6 @@ func test{{$txt.LocalTable.NameGo}}ToMany{{$txt.Function.Name}}(t *testing.T) {
 	}
 }
 
-{{end -}}{{- /* range */ -}}
+{{end -}}{{- /* if foreign view */ -}}
+{{- end -}}{{- /* range */ -}}
 {{- end -}}{{- /* outer if join table */ -}}
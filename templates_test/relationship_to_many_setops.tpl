@@ -3,6 +3,8 @@
 	{{- $dot := . -}}
 	{{- $table := .Table -}}
 	{{- range .Table.ToManyRelationships -}}
+	{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+	{{- else -}}
 	{{- $varNameSingular := .Table | singular | camelCase -}}
 	{{- $foreignVarNameSingular := .ForeignTable | singular | camelCase -}}
 	{{- $txt := txtsFromToMany $dot.Tables $table .}}
@@ -24,8 +26,14 @@ func test{{$txt.LocalTable.NameGo}}ToManyAddOp{{$txt.Function.Name}}(t *testing.
 		if err = randomize.Struct(seed, x, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
+		if err = {{$foreignVarNameSingular}}Parents(tx, x); err != nil {
+			t.Fatal(err)
+		}
 	}
 
+	if err = {{$varNameSingular}}Parents(tx, &a); err != nil {
+		t.Fatal(err)
+	}
 	if err := a.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
@@ -42,7 +50,7 @@ func test{{$txt.LocalTable.NameGo}}ToManyAddOp{{$txt.Function.Name}}(t *testing.
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.Add{{$txt.Function.Name}}(tx, i != 0, x...)
+		{{if $dot.RowsAffected}}_, {{end}}err = a.Add{{$txt.Function.Name}}(tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -59,20 +67,22 @@ func test{{$txt.LocalTable.NameGo}}ToManyAddOp{{$txt.Function.Name}}(t *testing.
 		}
 		{{- else}}
 
-		{{if $txt.Function.UsesBytes -}}
-		if 0 != bytes.Compare(a.{{$txt.Function.LocalAssignment}}, first.{{$txt.Function.ForeignAssignment}}) {
-			t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, first.{{$txt.Function.ForeignAssignment}})
+		{{range $txt.Columns -}}
+		{{if .UsesBytes -}}
+		if 0 != bytes.Compare(a.{{.LocalAssignment}}, first.{{.ForeignAssignment}}) {
+			t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, first.{{.ForeignAssignment}})
 		}
-		if 0 != bytes.Compare(a.{{$txt.Function.LocalAssignment}}, second.{{$txt.Function.ForeignAssignment}}) {
-			t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, second.{{$txt.Function.ForeignAssignment}})
+		if 0 != bytes.Compare(a.{{.LocalAssignment}}, second.{{.ForeignAssignment}}) {
+			t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, second.{{.ForeignAssignment}})
 		}
 		{{else -}}
-		if a.{{$txt.Function.LocalAssignment}} != first.{{$txt.Function.ForeignAssignment}} {
-			t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, first.{{$txt.Function.ForeignAssignment}})
+		if a.{{.LocalAssignment}} != first.{{.ForeignAssignment}} {
+			t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, first.{{.ForeignAssignment}})
 		}
-		if a.{{$txt.Function.LocalAssignment}} != second.{{$txt.Function.ForeignAssignment}} {
-			t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, second.{{$txt.Function.ForeignAssignment}})
+		if a.{{.LocalAssignment}} != second.{{.ForeignAssignment}} {
+			t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, second.{{.ForeignAssignment}})
 		}
+		{{end -}}
 		{{- end}}
 
 		if first.R.{{$txt.Function.ForeignName}} != &a {
@@ -119,8 +129,14 @@ func test{{$txt.LocalTable.NameGo}}ToManySetOp{{$txt.Function.Name}}(t *testing.
 		if err = randomize.Struct(seed, x, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
+		if err = {{$foreignVarNameSingular}}Parents(tx, x); err != nil {
+			t.Fatal(err)
+		}
 	}
 
+	if err = {{$varNameSingular}}Parents(tx, &a); err != nil {
+		t.Fatal(err)
+	}
 	if err = a.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
@@ -131,7 +147,7 @@ func test{{$txt.LocalTable.NameGo}}ToManySetOp{{$txt.Function.Name}}(t *testing.
 		t.Fatal(err)
 	}
 
-	err = a.Set{{$txt.Function.Name}}(tx, false, &b, &c)
+	{{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -144,7 +160,7 @@ func test{{$txt.LocalTable.NameGo}}ToManySetOp{{$txt.Function.Name}}(t *testing.
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.Set{{$txt.Function.Name}}(tx, true, &d, &e)
+	{{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -177,26 +193,30 @@ func test{{$txt.LocalTable.NameGo}}ToManySetOp{{$txt.Function.Name}}(t *testing.
 	}
 	{{- else}}
 
-	if b.{{$txt.ForeignTable.ColumnNameGo}}.Valid {
+	{{range $txt.Columns -}}
+	if b.{{.ForeignColumnGo}}.Valid {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if c.{{$txt.ForeignTable.ColumnNameGo}}.Valid {
+	if c.{{.ForeignColumnGo}}.Valid {
 		t.Error("want c's foreign key value to be nil")
 	}
-	{{if $txt.Function.UsesBytes -}}
-	if 0 != bytes.Compare(a.{{$txt.Function.LocalAssignment}}, d.{{$txt.Function.ForeignAssignment}}) {
-		t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, d.{{$txt.Function.ForeignAssignment}})
+	{{end -}}
+	{{range $txt.Columns -}}
+	{{if .UsesBytes -}}
+	if 0 != bytes.Compare(a.{{.LocalAssignment}}, d.{{.ForeignAssignment}}) {
+		t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, d.{{.ForeignAssignment}})
 	}
-	if 0 != bytes.Compare(a.{{$txt.Function.LocalAssignment}}, e.{{$txt.Function.ForeignAssignment}}) {
-		t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, e.{{$txt.Function.ForeignAssignment}})
+	if 0 != bytes.Compare(a.{{.LocalAssignment}}, e.{{.ForeignAssignment}}) {
+		t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, e.{{.ForeignAssignment}})
 	}
 	{{else -}}
-	if a.{{$txt.Function.LocalAssignment}} != d.{{$txt.Function.ForeignAssignment}} {
-		t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, d.{{$txt.Function.ForeignAssignment}})
+	if a.{{.LocalAssignment}} != d.{{.ForeignAssignment}} {
+		t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, d.{{.ForeignAssignment}})
 	}
-	if a.{{$txt.Function.LocalAssignment}} != e.{{$txt.Function.ForeignAssignment}} {
-		t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, e.{{$txt.Function.ForeignAssignment}})
+	if a.{{.LocalAssignment}} != e.{{.ForeignAssignment}} {
+		t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, e.{{.ForeignAssignment}})
 	}
+	{{end -}}
 	{{- end}}
 
 	if b.R.{{$txt.Function.ForeignName}} != nil {
@@ -239,16 +259,30 @@ func test{{$txt.LocalTable.NameGo}}ToManyRemoveOp{{$txt.Function.Name}}(t *testi
 		if err = randomize.Struct(seed, x, {{$foreignVarNameSingular}}DBTypes, false, strmangle.SetComplement({{$foreignVarNameSingular}}PrimaryKeyColumns, {{$foreignVarNameSingular}}ColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
+		if err = {{$foreignVarNameSingular}}Parents(tx, x); err != nil {
+			t.Fatal(err)
+		}
 	}
 
+	if err = {{$varNameSingular}}Parents(tx, &a); err != nil {
+		t.Fatal(err)
+	}
 	if err := a.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
 
+	{{if $dot.RowsAffected -}}
+	if rowsAff, err := a.Add{{$txt.Function.Name}}(tx, true, foreigners...); err != nil {
+		t.Fatal(err)
+	} else if rowsAff != 4 {
+		t.Error("should have added 4 relationships, but affected:", rowsAff)
+	}
+	{{- else -}}
 	err = a.Add{{$txt.Function.Name}}(tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
+	{{- end}}
 
 	count, err := a.{{$txt.Function.Name}}(tx).Count()
 	if err != nil {
@@ -258,10 +292,18 @@ func test{{$txt.LocalTable.NameGo}}ToManyRemoveOp{{$txt.Function.Name}}(t *testi
 		t.Error("count was wrong:", count)
 	}
 
+	{{if $dot.RowsAffected -}}
+	if rowsAff, err := a.Remove{{$txt.Function.Name}}(tx, foreigners[:2]...); err != nil {
+		t.Fatal(err)
+	} else if rowsAff != 2 {
+		t.Error("should have removed 2 relationships, but affected:", rowsAff)
+	}
+	{{- else -}}
 	err = a.Remove{{$txt.Function.Name}}(tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
+	{{- end}}
 
 	count, err = a.{{$txt.Function.Name}}(tx).Count()
 	if err != nil {
@@ -287,12 +329,14 @@ func test{{$txt.LocalTable.NameGo}}ToManyRemoveOp{{$txt.Function.Name}}(t *testi
 	}
 	{{- else}}
 
-	if b.{{$txt.ForeignTable.ColumnNameGo}}.Valid {
+	{{range $txt.Columns -}}
+	if b.{{.ForeignColumnGo}}.Valid {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if c.{{$txt.ForeignTable.ColumnNameGo}}.Valid {
+	if c.{{.ForeignColumnGo}}.Valid {
 		t.Error("want c's foreign key value to be nil")
 	}
+	{{end -}}
 
 	if b.R.{{$txt.Function.ForeignName}} != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
@@ -321,5 +365,6 @@ func test{{$txt.LocalTable.NameGo}}ToManyRemoveOp{{$txt.Function.Name}}(t *testi
 	}
 }
 {{end -}}
+{{- end -}}{{- /* if foreign view */ -}}
 {{- end -}}{{- /* range relationships */ -}}
 {{- end -}}{{- /* outer if join table */ -}}
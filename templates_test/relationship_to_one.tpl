@@ -20,18 +20,28 @@ func test{{$txt.LocalTable.NameGo}}ToOne{{$txt.ForeignTable.NameGo}}Using{{$txt.
 		t.Errorf("Unable to randomize {{$txt.ForeignTable.NameGo}} struct: %s", err)
 	}
 
-	{{if .Nullable -}}
-	local.{{$txt.LocalTable.ColumnNameGo}}.Valid = true
-	{{- end}}
-	{{if .ForeignColumnNullable -}}
-	foreign.{{$txt.ForeignTable.ColumnNameGo}}.Valid = true
-	{{- end}}
+	{{range $txt.Columns -}}
+	{{if .LocalNullable -}}
+	local.{{.LocalColumnGo}}.Valid = true
+	{{end -}}
+	{{if .ForeignNullable -}}
+	foreign.{{.ForeignColumnGo}}.Valid = true
+	{{end -}}
+	{{end}}
 
+	if err := {{$foreignVarNameSingular}}Parents(tx, &foreign); err != nil {
+		t.Fatal(err)
+	}
 	if err := foreign.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
 
-	local.{{$txt.Function.LocalAssignment}} = foreign.{{$txt.Function.ForeignAssignment}}
+	{{range $txt.Columns -}}
+	local.{{.LocalAssignment}} = foreign.{{.ForeignAssignment}}
+	{{end -}}
+	if err := {{$varNameSingular}}Parents(tx, &local); err != nil {
+		t.Fatal(err)
+	}
 	if err := local.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
@@ -41,13 +51,15 @@ func test{{$txt.LocalTable.NameGo}}ToOne{{$txt.ForeignTable.NameGo}}Using{{$txt.
 		t.Fatal(err)
 	}
 
-	{{if $txt.Function.UsesBytes -}}
-	if 0 != bytes.Compare(check.{{$txt.Function.ForeignAssignment}}, foreign.{{$txt.Function.ForeignAssignment}}) {
+	{{range $txt.Columns -}}
+	{{if .UsesBytes -}}
+	if 0 != bytes.Compare(check.{{.ForeignAssignment}}, foreign.{{.ForeignAssignment}}) {
 	{{else -}}
-	if check.{{$txt.Function.ForeignAssignment}} != foreign.{{$txt.Function.ForeignAssignment}} {
+	if check.{{.ForeignAssignment}} != foreign.{{.ForeignAssignment}} {
 	{{end -}}
-		t.Errorf("want: %v, got %v", foreign.{{$txt.Function.ForeignAssignment}}, check.{{$txt.Function.ForeignAssignment}})
+		t.Errorf("want: %v, got %v", foreign.{{.ForeignAssignment}}, check.{{.ForeignAssignment}})
 	}
+	{{end}}
 
 	slice := {{$txt.LocalTable.NameGo}}Slice{&local}
 	if err = local.L.Load{{$txt.Function.Name}}(tx, false, (*[]*{{$txt.LocalTable.NameGo}})(&slice)); err != nil {
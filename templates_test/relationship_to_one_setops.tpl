@@ -25,15 +25,24 @@ func test{{$txt.LocalTable.NameGo}}ToOneSetOp{{$txt.ForeignTable.NameGo}}Using{{
 		t.Fatal(err)
 	}
 
+	if err = {{$varNameSingular}}Parents(tx, &a); err != nil {
+		t.Fatal(err)
+	}
 	if err := a.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
+	if err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {
+		t.Fatal(err)
+	}
 	if err = b.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
+	if err = {{$foreignVarNameSingular}}Parents(tx, &c); err != nil {
+		t.Fatal(err)
+	}
 
 	for i, x := range []*{{$txt.ForeignTable.NameGo}}{&b, &c} {
-		err = a.Set{{$txt.Function.Name}}(tx, i != 0, x)
+		{{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, i != 0, x)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -52,13 +61,15 @@ func test{{$txt.LocalTable.NameGo}}ToOneSetOp{{$txt.ForeignTable.NameGo}}Using{{
 		}
 		{{end -}}
 
-		{{if $txt.Function.UsesBytes -}}
-		if 0 != bytes.Compare(a.{{$txt.Function.LocalAssignment}}, x.{{$txt.Function.ForeignAssignment}}) {
+		{{range $txt.Columns -}}
+		{{if .UsesBytes -}}
+		if 0 != bytes.Compare(a.{{.LocalAssignment}}, x.{{.ForeignAssignment}}) {
 		{{else -}}
-		if a.{{$txt.Function.LocalAssignment}} != x.{{$txt.Function.ForeignAssignment}} {
+		if a.{{.LocalAssignment}} != x.{{.ForeignAssignment}} {
 		{{end -}}
-			t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}})
+			t.Error("foreign key was wrong value", a.{{.LocalAssignment}})
 		}
+		{{end}}
 
 		{{if setInclude .Column $dot.Table.PKey.Columns -}}
 		if exists, err := {{$txt.LocalTable.NameGo}}Exists(tx, a.{{$dot.Table.PKey.Columns | stringMap $dot.StringFuncs.titleCase | join ", a."}}); err != nil {
@@ -67,20 +78,22 @@ func test{{$txt.LocalTable.NameGo}}ToOneSetOp{{$txt.ForeignTable.NameGo}}Using{{
 			t.Error("want 'a' to exist")
 		}
 		{{else -}}
-		zero := reflect.Zero(reflect.TypeOf(a.{{$txt.Function.LocalAssignment}}))
-		reflect.Indirect(reflect.ValueOf(&a.{{$txt.Function.LocalAssignment}})).Set(zero)
-
+		{{range $txt.Columns -}}
+		reflect.Indirect(reflect.ValueOf(&a.{{.LocalAssignment}})).Set(reflect.Zero(reflect.TypeOf(a.{{.LocalAssignment}})))
+		{{end}}
 		if err = a.Reload(tx); err != nil {
 			t.Fatal("failed to reload", err)
 		}
 
-		{{if $txt.Function.UsesBytes -}}
-		if 0 != bytes.Compare(a.{{$txt.Function.LocalAssignment}}, x.{{$txt.Function.ForeignAssignment}}) {
+		{{range $txt.Columns -}}
+		{{if .UsesBytes -}}
+		if 0 != bytes.Compare(a.{{.LocalAssignment}}, x.{{.ForeignAssignment}}) {
 		{{else -}}
-		if a.{{$txt.Function.LocalAssignment}} != x.{{$txt.Function.ForeignAssignment}} {
+		if a.{{.LocalAssignment}} != x.{{.ForeignAssignment}} {
 		{{end -}}
-			t.Error("foreign key was wrong value", a.{{$txt.Function.LocalAssignment}}, x.{{$txt.Function.ForeignAssignment}})
+			t.Error("foreign key was wrong value", a.{{.LocalAssignment}}, x.{{.ForeignAssignment}})
 		}
+		{{end -}}
 		{{- end}}
 	}
 }
@@ -103,17 +116,31 @@ func test{{$txt.LocalTable.NameGo}}ToOneRemoveOp{{$txt.ForeignTable.NameGo}}Usin
 		t.Fatal(err)
 	}
 
+	if err = {{$varNameSingular}}Parents(tx, &a); err != nil {
+		t.Fatal(err)
+	}
 	if err = a.Insert(tx); err != nil {
 		t.Fatal(err)
 	}
+	if err = {{$foreignVarNameSingular}}Parents(tx, &b); err != nil {
+		t.Fatal(err)
+	}
 
-	if err = a.Set{{$txt.Function.Name}}(tx, true, &b); err != nil {
+	if {{if $dot.RowsAffected}}_, {{end}}err = a.Set{{$txt.Function.Name}}(tx, true, &b); err != nil {
 		t.Fatal(err)
 	}
 
+	{{if $dot.RowsAffected -}}
+	if rowsAff, err := a.Remove{{$txt.Function.Name}}(tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	} else if rowsAff != 1 {
+		t.Error("should have removed one relationship, but affected:", rowsAff)
+	}
+	{{- else -}}
 	if err = a.Remove{{$txt.Function.Name}}(tx, &b); err != nil {
 		t.Error("failed to remove relationship")
 	}
+	{{- end}}
 
 	count, err := a.{{$txt.Function.Name}}(tx).Count()
 	if err != nil {
@@ -127,9 +154,11 @@ func test{{$txt.LocalTable.NameGo}}ToOneRemoveOp{{$txt.ForeignTable.NameGo}}Usin
 		t.Error("R struct entry should be nil")
 	}
 
-	if a.{{$txt.LocalTable.ColumnNameGo}}.Valid {
+	{{range $txt.Columns -}}
+	if a.{{.LocalColumnGo}}.Valid {
 		t.Error("foreign key value should be nil")
 	}
+	{{end}}
 
 	{{if .Unique -}}
 	if b.R.{{$txt.Function.ForeignName}} != nil {
@@ -14,6 +14,13 @@ func test{{$tableNamePlural}}Select(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -23,7 +30,7 @@ func test{{$tableNamePlural}}Select(t *testing.T) {
 		t.Error(err)
 	}
 
-	if len(slice) != 1 {
-		t.Error("want one record, got:", len(slice))
+	if len(slice) != int(before)+1 {
+		t.Errorf("want %d records, got: %d", before+1, len(slice))
 	}
 }
@@ -5,6 +5,9 @@ var (
 	dbMain tester
 )
 
+// errTestHook is returned by the hooks the tests make fail
+var errTestHook = errors.New("test hook failed")
+
 type tester interface {
 	setup() error
 	conn() (*sql.DB, error)
@@ -17,7 +20,8 @@ func TestMain(m *testing.M) {
 		os.Exit(-1)
 	}
 
-	rand.Seed(time.Now().UnixNano())
+	// Seeded like randomize, so a failing run can be repeated with its seed
+	rand.Seed(randomize.DefaultSeed())
 
 	flag.Parse()
 
@@ -53,6 +57,10 @@ func TestMain(m *testing.M) {
 	boil.SetDB(conn)
 	code = m.Run()
 
+	if code != 0 {
+		fmt.Printf("randomize seed: %d, rerun with %s=%d to repeat the same values\n", randomize.DefaultSeed(), randomize.SeedEnv, randomize.DefaultSeed())
+	}
+
 	if err = dbMain.teardown(); err != nil {
 		fmt.Println("Unable to execute teardown:", err)
 		os.Exit(-5)
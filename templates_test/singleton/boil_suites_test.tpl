@@ -7,7 +7,7 @@
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}})
@@ -17,7 +17,7 @@ func TestParent(t *testing.T) {
 
 func TestDelete(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Delete)
@@ -27,7 +27,7 @@ func TestDelete(t *testing.T) {
 
 func TestQueryDeleteAll(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}QueryDeleteAll)
@@ -37,7 +37,7 @@ func TestQueryDeleteAll(t *testing.T) {
 
 func TestSliceDeleteAll(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}SliceDeleteAll)
@@ -47,7 +47,7 @@ func TestSliceDeleteAll(t *testing.T) {
 
 func TestExists(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Exists)
@@ -55,9 +55,19 @@ func TestExists(t *testing.T) {
   {{- end -}}
 }
 
+func TestFactory(t *testing.T) {
+  {{- range $index, $table := .Tables}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
+  {{- else -}}
+  {{- $tableName := $table.Name | plural | titleCase -}}
+  t.Run("{{$tableName}}", test{{$tableName}}Factory)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestFind(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Find)
@@ -65,9 +75,22 @@ func TestFind(t *testing.T) {
   {{- end -}}
 }
 
+func TestFindByUniqueKey(t *testing.T) {
+  {{- range $index, $table := .Tables}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
+  {{- else -}}
+  {{- $tableName := $table.Name | plural | titleCase -}}
+  {{- range $ukey := $table.UKeys -}}
+  {{- $keyName := $ukey.Columns | stringMap $dot.StringFuncs.titleCase | join "And" -}}
+  t.Run("{{$tableName}}By{{$keyName}}", test{{$tableName}}FindBy{{$keyName}})
+  {{end -}}
+  {{- end -}}
+  {{- end -}}
+}
+
 func TestBind(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Bind)
@@ -77,7 +100,7 @@ func TestBind(t *testing.T) {
 
 func TestOne(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}One)
@@ -87,7 +110,7 @@ func TestOne(t *testing.T) {
 
 func TestAll(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}All)
@@ -97,7 +120,7 @@ func TestAll(t *testing.T) {
 
 func TestCount(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Count)
@@ -108,7 +131,7 @@ func TestCount(t *testing.T) {
 {{if not .NoHooks -}}
 func TestHooks(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Hooks)
@@ -119,7 +142,7 @@ func TestHooks(t *testing.T) {
 
 func TestInsert(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Insert)
@@ -132,7 +155,7 @@ func TestInsert(t *testing.T) {
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
 {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
     {{- range $table.FKeys -}}
       {{- $txt := txtsFromFKey $dot.Tables $table . -}}
@@ -146,12 +169,15 @@ func TestToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
   {{- range $index, $table := .Tables}}
-	{{- if $table.IsJoinTable -}}
+	{{- if or $table.IsJoinTable $table.IsView -}}
 	{{- else -}}
 	  {{- range $table.ToOneRelationships -}}
+		{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+		{{- else -}}
 		{{- $txt := txtsFromOneToOne $dot.Tables $table . -}}
   t.Run("{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}", test{{$txt.LocalTable.NameGo}}OneToOne{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})
-	  {{end -}}{{- /* range */ -}}
+		{{end -}}{{- /* if foreign view */ -}}
+	  {{- end -}}{{- /* range */ -}}
 	{{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -160,12 +186,15 @@ func TestOneToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
   {{- range $index, $table := .Tables}}
-    {{- if $table.IsJoinTable -}}
+    {{- if or $table.IsJoinTable $table.IsView -}}
     {{- else -}}
       {{- range $table.ToManyRelationships -}}
+        {{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+        {{- else -}}
         {{- $txt := txtsFromToMany $dot.Tables $table . -}}
   t.Run("{{$txt.LocalTable.NameGo}}To{{$txt.Function.Name}}", test{{$txt.LocalTable.NameGo}}ToMany{{$txt.Function.Name}})
-      {{end -}}{{- /* range */ -}}
+        {{end -}}{{- /* if foreign view */ -}}
+      {{- end -}}{{- /* range */ -}}
     {{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -174,7 +203,7 @@ func TestToMany(t *testing.T) {
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
 {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
     {{- range $table.FKeys -}}
       {{- $txt := txtsFromFKey $dot.Tables $table . -}}
@@ -188,7 +217,7 @@ func TestToOneSet(t *testing.T) {
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
 {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
     {{- range $table.FKeys -}}
       {{- $txt := txtsFromFKey $dot.Tables $table . -}}
@@ -204,12 +233,15 @@ func TestToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
   {{- range $index, $table := .Tables}}
-	{{- if $table.IsJoinTable -}}
+	{{- if or $table.IsJoinTable $table.IsView -}}
 	{{- else -}}
 	  {{- range $table.ToOneRelationships -}}
+		{{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+		{{- else -}}
 		  {{- $txt := txtsFromOneToOne $dot.Tables $table . -}}
 	t.Run("{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}", test{{$txt.LocalTable.NameGo}}OneToOneSetOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})
-	  {{end -}}{{- /* range to one relationships */ -}}
+		{{end -}}{{- /* if foreign view */ -}}
+	  {{- end -}}{{- /* range to one relationships */ -}}
 	{{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -218,10 +250,10 @@ func TestOneToOneSet(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneRemove(t *testing.T) {
   {{- range $index, $table := .Tables}}
-	{{- if $table.IsJoinTable -}}
+	{{- if or $table.IsJoinTable $table.IsView -}}
 	{{- else -}}
 	  {{- range $table.ToOneRelationships -}}
-		{{- if .ForeignColumnNullable -}}
+		{{- if and .ForeignColumnNullable (not (getTable $dot.Tables .ForeignTable).IsView) -}}
 		  {{- $txt := txtsFromOneToOne $dot.Tables $table . -}}
 	t.Run("{{$txt.LocalTable.NameGo}}To{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}}", test{{$txt.LocalTable.NameGo}}OneToOneRemoveOp{{$txt.ForeignTable.NameGo}}Using{{$txt.Function.Name}})
 		{{end -}}{{- /* if foreign column nullable */ -}}
@@ -234,12 +266,15 @@ func TestOneToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
   {{- range $index, $table := .Tables}}
-    {{- if $table.IsJoinTable -}}
+    {{- if or $table.IsJoinTable $table.IsView -}}
     {{- else -}}
       {{- range $table.ToManyRelationships -}}
+        {{- if (getTable $dot.Tables .ForeignTable).IsView -}}
+        {{- else -}}
         {{- $txt := txtsFromToMany $dot.Tables $table . -}}
   t.Run("{{$txt.LocalTable.NameGo}}To{{$txt.Function.Name}}", test{{$txt.LocalTable.NameGo}}ToManyAddOp{{$txt.Function.Name}})
-      {{end -}}{{- /* range */ -}}
+        {{end -}}{{- /* if foreign view */ -}}
+      {{- end -}}{{- /* range */ -}}
     {{- end -}}{{- /* outer if join table */ -}}
   {{- end -}}{{- /* outer tables range */ -}}
 }
@@ -248,10 +283,10 @@ func TestToManyAdd(t *testing.T) {
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
   {{- range $index, $table := .Tables}}
-    {{- if $table.IsJoinTable -}}
+    {{- if or $table.IsJoinTable $table.IsView -}}
     {{- else -}}
       {{- range $table.ToManyRelationships -}}
-        {{- if not (or .ForeignColumnNullable .ToJoinTable)}}
+        {{- if or (not (or .ForeignColumnNullable .ToJoinTable)) (getTable $dot.Tables .ForeignTable).IsView}}
         {{- else -}}
           {{- $txt := txtsFromToMany $dot.Tables $table . -}}
     t.Run("{{$txt.LocalTable.NameGo}}To{{$txt.Function.Name}}", test{{$txt.LocalTable.NameGo}}ToManySetOp{{$txt.Function.Name}})
@@ -265,10 +300,10 @@ func TestToManySet(t *testing.T) {
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
   {{- range $index, $table := .Tables}}
-    {{- if $table.IsJoinTable -}}
+    {{- if or $table.IsJoinTable $table.IsView -}}
     {{- else -}}
       {{- range $table.ToManyRelationships -}}
-        {{- if not (or .ForeignColumnNullable .ToJoinTable)}}
+        {{- if or (not (or .ForeignColumnNullable .ToJoinTable)) (getTable $dot.Tables .ForeignTable).IsView}}
         {{- else -}}
           {{- $txt := txtsFromToMany $dot.Tables $table . -}}
     t.Run("{{$txt.LocalTable.NameGo}}To{{$txt.Function.Name}}", test{{$txt.LocalTable.NameGo}}ToManyRemoveOp{{$txt.Function.Name}})
@@ -280,7 +315,7 @@ func TestToManyRemove(t *testing.T) {
 
 func TestReload(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Reload)
@@ -290,7 +325,7 @@ func TestReload(t *testing.T) {
 
 func TestReloadAll(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}ReloadAll)
@@ -298,9 +333,19 @@ func TestReloadAll(t *testing.T) {
   {{- end -}}
 }
 
+func TestValidate(t *testing.T) {
+  {{- range $index, $table := .Tables}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
+  {{- else -}}
+  {{- $tableName := $table.Name | plural | titleCase -}}
+  t.Run("{{$tableName}}", test{{$tableName}}Validate)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestSelect(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Select)
@@ -310,7 +355,7 @@ func TestSelect(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Update)
@@ -318,9 +363,19 @@ func TestUpdate(t *testing.T) {
   {{- end -}}
 }
 
+func TestUpdateChanged(t *testing.T) {
+  {{- range $index, $table := .Tables}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
+  {{- else -}}
+  {{- $tableName := $table.Name | plural | titleCase -}}
+  t.Run("{{$tableName}}", test{{$tableName}}UpdateChanged)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestSliceUpdateAll(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}SliceUpdateAll)
@@ -330,10 +385,20 @@ func TestSliceUpdateAll(t *testing.T) {
 
 func TestUpsert(t *testing.T) {
   {{- range $index, $table := .Tables}}
-  {{- if $table.IsJoinTable -}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
   {{- else -}}
   {{- $tableName := $table.Name | plural | titleCase -}}
   t.Run("{{$tableName}}", test{{$tableName}}Upsert)
   {{end -}}
   {{- end -}}
 }
+
+func TestSQLSnapshot(t *testing.T) {
+  {{- range $index, $table := .Tables}}
+  {{- if or $table.IsJoinTable $table.IsView -}}
+  {{- else -}}
+  {{- $tableName := $table.Name | plural | titleCase -}}
+  t.Run("{{$tableName}}", test{{$tableName}}SQLSnapshot)
+  {{end -}}
+  {{- end -}}
+}
@@ -0,0 +1,34 @@
+{{- $tableNameSingular := .Table.Name | singular | titleCase -}}
+{{- $tableNamePlural := .Table.Name | plural | titleCase -}}
+{{- $varNameSingular := .Table.Name | singular | camelCase -}}
+func test{{$tableNamePlural}}SQLSnapshot(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	o := &{{$tableNameSingular}}{}
+	if err := randomize.Struct(seed, o, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
+	}
+
+	e := boiltest.New()
+	defer e.Close()
+	e.ExpectRegexp(".").ReturnModels(o)
+	exec := boil.SkipHooks(e)
+
+	// Errors are ignored, only the statements each operation builds matter
+	found, inserted, updated, upserted := *o, *o, *o, *o
+	_, _ = Find{{$tableNameSingular}}(exec, {{.Table.PKey.Columns | stringMap .StringFuncs.titleCase | prefixStringSlice "found." | join ", "}})
+	_ = inserted.Insert(exec)
+	{{if .RowsAffected}}_, {{end}}_ = updated.Update(exec)
+	_ = upserted.Upsert(exec, {{if eq .DriverName "postgres"}}true, nil, {{end}}nil)
+	{{if .RowsAffected}}_, {{end}}_ = o.Delete(exec)
+
+	// The arguments are random, so only the statements are snapshotted
+	buf := &bytes.Buffer{}
+	for _, call := range e.Calls() {
+		buf.WriteString(call.SQL)
+		buf.WriteByte('\n')
+	}
+
+	boiltest.Golden(t, "testdata/{{.DriverName}}/{{.Table.Name}}.sql", buf.String())
+}
@@ -1,5 +1,4 @@
-{{- $varNameSingular := .Table.Name | singular | camelCase -}}
 var (
-	{{$varNameSingular}}DBTypes = map[string]string{{"{"}}{{.Table.Columns | columnDBTypes | makeStringMap}}{{"}"}}
 	_ = bytes.MinRead
+	_ = queries.Raw
 )
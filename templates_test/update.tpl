@@ -18,6 +18,13 @@ func test{{$tableNamePlural}}Update(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -27,17 +34,122 @@ func test{{$tableNamePlural}}Update(t *testing.T) {
 		t.Error(err)
 	}
 
-	if count != 1 {
-		t.Error("want one record, got:", count)
+	if count != before+1 {
+		t.Errorf("want %d records, got: %d", before+1, count)
+	}
+
+	if err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
+
+	{{if .RowsAffected -}}
+	if rowsAff, err := {{$varNameSingular}}.Update(tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row, but affected:", rowsAff)
+	}
+	{{- else -}}
+	if err = {{$varNameSingular}}.Update(tx); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+}
+
+func test{{$tableNamePlural}}UpdateChanged(t *testing.T) {
+	t.Parallel()
+
+	if len({{$varNameSingular}}Columns) == len({{$varNameSingular}}PrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
 	}
 
+	seed := randomize.NewSeed()
+	var err error
+	{{$varNameSingular}} := &{{$tableNameSingular}}{}
 	if err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
 	}
 
+	if changed := {{$varNameSingular}}.Changed(); len(changed) != len({{$varNameSingular}}Columns) {
+		t.Error("want every column changed before insert, got:", changed)
+	}
+
+	tx := MustTx(boil.Begin())
+	defer tx.Rollback()
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}.Insert(tx); err != nil {
+		t.Error(err)
+	}
+
+	if changed := {{$varNameSingular}}.Changed(); len(changed) != 0 {
+		t.Error("want no changed columns after insert, got:", changed)
+	}
+	{{if .RowsAffected -}}
+	if rowsAff, err := {{$varNameSingular}}.Update(tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 0 {
+		t.Error("should not update anything when nothing changed, but affected:", rowsAff)
+	}
+	{{- else -}}
+	if err = {{$varNameSingular}}.Update(tx); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+	if changed := {{$varNameSingular}}.Changed(); len(changed) != 0 {
+		t.Error("want no changed columns after an update that wrote nothing, got:", changed)
+	}
+
+	blacklist := append({{$varNameSingular}}ColumnsWithDefault, {{$varNameSingular}}PrimaryKeyColumns...)
+	if err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, blacklist...); err != nil {
+		t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
+
+	{{if .RowsAffected -}}
+	if rowsAff, err := {{$varNameSingular}}.Update(tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only update the changed row, but affected:", rowsAff)
+	}
+	{{- else -}}
 	if err = {{$varNameSingular}}.Update(tx); err != nil {
 		t.Error(err)
 	}
+	{{- end}}
+	if changed := {{$varNameSingular}}.Changed(); len(changed) != 0 {
+		t.Error("want no changed columns after update, got:", changed)
+	}
+
+	if err = {{$varNameSingular}}.Reload(tx); err != nil {
+		t.Error(err)
+	}
+	if changed := {{$varNameSingular}}.Changed(); len(changed) != 0 {
+		t.Error("want no changed columns after reload, got:", changed)
+	}
+
+	if err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, blacklist...); err != nil {
+		t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
+	changed := {{$varNameSingular}}.Changed()
+	if len(changed) < 2 {
+		return
+	}
+	{{if .RowsAffected}}_, {{end}}err = {{$varNameSingular}}.Update(tx, changed[0])
+	if err != nil {
+		t.Error(err)
+	}
+	if unwritten := strmangle.SetComplement(changed[1:], {{$varNameSingular}}.Changed()); len(unwritten) != 0 {
+		t.Error("want the columns left out of the whitelist still changed, got:", {{$varNameSingular}}.Changed())
+	}
 }
 
 func test{{$tableNamePlural}}SliceUpdateAll(t *testing.T) {
@@ -56,6 +168,13 @@ func test{{$tableNamePlural}}SliceUpdateAll(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Insert(tx); err != nil {
 		t.Error(err)
 	}
@@ -65,13 +184,16 @@ func test{{$tableNamePlural}}SliceUpdateAll(t *testing.T) {
 		t.Error(err)
 	}
 
-	if count != 1 {
-		t.Error("want one record, got:", count)
+	if count != before+1 {
+		t.Errorf("want %d records, got: %d", before+1, count)
 	}
 
 	if err = randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true, {{$varNameSingular}}PrimaryKeyColumns...); err != nil {
 		t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
 	}
+	if err = {{$varNameSingular}}Parents(tx, {{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 
 	// Remove Primary keys and unique columns from what we plan to update
 	var fields []string
@@ -97,7 +219,15 @@ func test{{$tableNamePlural}}SliceUpdateAll(t *testing.T) {
 	}
 
 	slice := {{$tableNameSingular}}Slice{{"{"}}{{$varNameSingular}}{{"}"}}
+	{{if .RowsAffected -}}
+	if rowsAff, err := slice.UpdateAll(tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row, but affected:", rowsAff)
+	}
+	{{- else -}}
 	if err = slice.UpdateAll(tx, updateMap); err != nil {
 		t.Error(err)
 	}
+	{{- end}}
 }
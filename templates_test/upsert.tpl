@@ -19,6 +19,13 @@ func test{{$tableNamePlural}}Upsert(t *testing.T) {
 
 	tx := MustTx(boil.Begin())
 	defer tx.Rollback()
+	before, err := {{$tableNamePlural}}(tx).Count()
+	if err != nil {
+		t.Error(err)
+	}
+	if err = {{$varNameSingular}}Parents(tx, &{{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 	if err = {{$varNameSingular}}.Upsert(tx, {{if eq .DriverName "postgres"}}false, nil, {{end}}nil); err != nil {
 		t.Errorf("Unable to upsert {{$tableNameSingular}}: %s", err)
 	}
@@ -27,14 +34,17 @@ func test{{$tableNamePlural}}Upsert(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	if count != 1 {
-		t.Error("want one record, got:", count)
+	if count != before+1 {
+		t.Errorf("want %d records, got: %d", before+1, count)
 	}
 
 	// Attempt the UPDATE side of an UPSERT
 	if err = randomize.Struct(seed, &{{$varNameSingular}}, {{$varNameSingular}}DBTypes, false, {{$varNameSingular}}PrimaryKeyColumns...); err != nil {
 		t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
 	}
+	if err = {{$varNameSingular}}Parents(tx, &{{$varNameSingular}}); err != nil {
+		t.Error(err)
+	}
 
 	if err = {{$varNameSingular}}.Upsert(tx, {{if eq .DriverName "postgres"}}true, nil, {{end}}nil); err != nil {
 		t.Errorf("Unable to upsert {{$tableNameSingular}}: %s", err)
@@ -44,7 +54,7 @@ func test{{$tableNamePlural}}Upsert(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	if count != 1 {
-		t.Error("want one record, got:", count)
+	if count != before+1 {
+		t.Errorf("want %d records, got: %d", before+1, count)
 	}
 }
@@ -0,0 +1,18 @@
+{{- $tableNameSingular := .Table.Name | singular | titleCase -}}
+{{- $tableNamePlural := .Table.Name | plural | titleCase -}}
+{{- $varNameSingular := .Table.Name | singular | camelCase}}
+func test{{$tableNamePlural}}Validate(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	for i := 0; i < 10; i++ {
+		{{$varNameSingular}} := &{{$tableNameSingular}}{}
+		if err := randomize.Struct(seed, {{$varNameSingular}}, {{$varNameSingular}}DBTypes, true); err != nil {
+			t.Errorf("Unable to randomize {{$tableNameSingular}} struct: %s", err)
+		}
+
+		if err := {{$varNameSingular}}.Validate(); err != nil {
+			t.Errorf("Random {{$tableNameSingular}} should be valid: %s", err)
+		}
+	}
+}